@@ -24,6 +24,14 @@ import (
 
 type AdminClientMock struct {
 	minioAccountInfoMock func(ctx context.Context) (madmin.AccountInfo, error)
+	minioListTiersMock   func(ctx context.Context) ([]*madmin.TierConfig, error)
+
+	minioGetBucketQuotaMock func(ctx context.Context, bucket string) (madmin.BucketQuota, error)
+	minioSetBucketQuotaMock func(ctx context.Context, bucket string, quota *madmin.BucketQuota) error
+
+	minioListRemoteTargetsMock  func(ctx context.Context, bucket, arnType string) (targets []madmin.BucketTarget, err error)
+	minioSetRemoteTargetMock    func(ctx context.Context, bucket string, target *madmin.BucketTarget) (string, error)
+	minioRemoveRemoteTargetMock func(ctx context.Context, bucket, arn string) error
 }
 
 func (ac AdminClientMock) kmsStatus(_ context.Context) (madmin.KMSStatus, error) {
@@ -33,3 +41,27 @@ func (ac AdminClientMock) kmsStatus(_ context.Context) (madmin.KMSStatus, error)
 func (ac AdminClientMock) AccountInfo(ctx context.Context) (madmin.AccountInfo, error) {
 	return ac.minioAccountInfoMock(ctx)
 }
+
+func (ac AdminClientMock) getBucketQuota(ctx context.Context, bucket string) (madmin.BucketQuota, error) {
+	return ac.minioGetBucketQuotaMock(ctx, bucket)
+}
+
+func (ac AdminClientMock) setBucketQuota(ctx context.Context, bucket string, quota *madmin.BucketQuota) error {
+	return ac.minioSetBucketQuotaMock(ctx, bucket, quota)
+}
+
+func (ac AdminClientMock) listTiers(ctx context.Context) ([]*madmin.TierConfig, error) {
+	return ac.minioListTiersMock(ctx)
+}
+
+func (ac AdminClientMock) listRemoteTargets(ctx context.Context, bucket, arnType string) (targets []madmin.BucketTarget, err error) {
+	return ac.minioListRemoteTargetsMock(ctx, bucket, arnType)
+}
+
+func (ac AdminClientMock) setRemoteTarget(ctx context.Context, bucket string, target *madmin.BucketTarget) (string, error) {
+	return ac.minioSetRemoteTargetMock(ctx, bucket, target)
+}
+
+func (ac AdminClientMock) removeRemoteTarget(ctx context.Context, bucket, arn string) error {
+	return ac.minioRemoveRemoteTargetMock(ctx, bucket, arn)
+}
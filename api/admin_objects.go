@@ -20,6 +20,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/minio/console/models"
 	"github.com/minio/mc/cmd"
 	"github.com/minio/minio-go/v7"
 )
@@ -36,15 +37,33 @@ type ObjectsRequest struct {
 	Prefix     string `json:"prefix"`
 	Date       string `json:"date"`
 	RequestID  int64  `json:"request_id"`
+	Depth      int    `json:"depth,omitempty"`
+	Refresh    bool   `json:"refresh,omitempty"`
+	// target location of diff requests
+	TargetBucket string `json:"target_bucket,omitempty"`
+	TargetPrefix string `json:"target_prefix,omitempty"`
+	// mirror job followed by mirror requests
+	JobID string `json:"job_id,omitempty"`
+	// end of the window of rewind-diff requests, which starts at Date
+	DateEnd string `json:"date_end,omitempty"`
 }
 
 type WSResponse struct {
-	RequestID  int64            `json:"request_id,omitempty"`
-	Error      *CodedAPIError   `json:"error,omitempty"`
-	RequestEnd bool             `json:"request_end,omitempty"`
-	Prefix     string           `json:"prefix,omitempty"`
-	BucketName string           `json:"bucketName,omitempty"`
-	Data       []ObjectResponse `json:"data,omitempty"`
+	RequestID      int64                 `json:"request_id,omitempty"`
+	Error          *CodedAPIError        `json:"error,omitempty"`
+	RequestEnd     bool                  `json:"request_end,omitempty"`
+	Prefix         string                `json:"prefix,omitempty"`
+	BucketName     string                `json:"bucketName,omitempty"`
+	Data           []ObjectResponse      `json:"data,omitempty"`
+	Usage          *PrefixUsageReport    `json:"usage,omitempty"`
+	Diff           []ObjectDiff          `json:"diff,omitempty"`
+	DiffSummary    *ObjectDiffSummary    `json:"diff_summary,omitempty"`
+	MirrorJob      *models.MirrorJob     `json:"mirror_job,omitempty"`
+	Changes        []ObjectChange        `json:"changes,omitempty"`
+	ChangesSummary *ObjectChangesSummary `json:"changes_summary,omitempty"`
+	// sessions idle for too long are warned of when they expire, then told they did before the socket closes
+	SessionExpiring string `json:"session_expiring,omitempty"`
+	SessionExpired  bool   `json:"session_expired,omitempty"`
 }
 
 type ObjectResponse struct {
@@ -30,7 +30,6 @@ import (
 
 	"github.com/minio/console/models"
 	"github.com/minio/madmin-go/v3"
-	"github.com/minio/minio-go/v7/pkg/credentials"
 )
 
 const globalAppName = "MinIO Console"
@@ -40,8 +39,17 @@ const globalAppName = "MinIO Console"
 // that are used within this project.
 type MinioAdmin interface {
 	AccountInfo(ctx context.Context) (madmin.AccountInfo, error)
+	// Quota
+	getBucketQuota(ctx context.Context, bucket string) (madmin.BucketQuota, error)
+	setBucketQuota(ctx context.Context, bucket string, quota *madmin.BucketQuota) error
 	// KMS
 	kmsStatus(ctx context.Context) (madmin.KMSStatus, error)
+	// Tiering
+	listTiers(ctx context.Context) ([]*madmin.TierConfig, error)
+	// Remote Targets
+	listRemoteTargets(ctx context.Context, bucket, arnType string) (targets []madmin.BucketTarget, err error)
+	setRemoteTarget(ctx context.Context, bucket string, target *madmin.BucketTarget) (string, error)
+	removeRemoteTarget(ctx context.Context, bucket, arn string) error
 }
 
 // Interface implementation
@@ -61,10 +69,35 @@ func (ac AdminClient) getBucketQuota(ctx context.Context, bucket string) (madmin
 	return ac.Client.GetBucketQuota(ctx, bucket)
 }
 
+// implements madmin.SetBucketQuota()
+func (ac AdminClient) setBucketQuota(ctx context.Context, bucket string, quota *madmin.BucketQuota) error {
+	return ac.Client.SetBucketQuota(ctx, bucket, quota)
+}
+
 func (ac AdminClient) kmsStatus(ctx context.Context) (madmin.KMSStatus, error) {
 	return ac.Client.KMSStatus(ctx)
 }
 
+// implements madmin.ListTiers()
+func (ac AdminClient) listTiers(ctx context.Context) ([]*madmin.TierConfig, error) {
+	return ac.Client.ListTiers(ctx)
+}
+
+// implements madmin.ListRemoteTargets()
+func (ac AdminClient) listRemoteTargets(ctx context.Context, bucket, arnType string) (targets []madmin.BucketTarget, err error) {
+	return ac.Client.ListRemoteTargets(ctx, bucket, arnType)
+}
+
+// implements madmin.SetRemoteTarget()
+func (ac AdminClient) setRemoteTarget(ctx context.Context, bucket string, target *madmin.BucketTarget) (string, error) {
+	return ac.Client.SetRemoteTarget(ctx, bucket, target)
+}
+
+// implements madmin.RemoveRemoteTarget()
+func (ac AdminClient) removeRemoteTarget(ctx context.Context, bucket, arn string) error {
+	return ac.Client.RemoveRemoteTarget(ctx, bucket, arn)
+}
+
 func NewMinioAdminClient(ctx context.Context, sessionClaims *models.Principal) (*madmin.AdminClient, error) {
 	clientIP := utils.ClientIPFromContext(ctx)
 	adminClient, err := newAdminFromClaims(sessionClaims, clientIP)
@@ -81,7 +114,7 @@ func newAdminFromClaims(claims *models.Principal, clientIP string) (*madmin.Admi
 	endpoint := getMinIOEndpoint()
 
 	adminClient, err := madmin.NewWithOptions(endpoint, &madmin.Options{
-		Creds:  credentials.NewStaticV4(claims.STSAccessKeyID, claims.STSSecretAccessKey, claims.STSSessionToken),
+		Creds:  getConsoleCredentialsFromSession(claims),
 		Secure: tlsEnabled,
 	})
 	if err != nil {
@@ -172,11 +205,91 @@ func getSourceIPFromHeaders(r *http.Request) string {
 	return addr
 }
 
+// getTrustedSourceIP retrieves the IP of the client from the forwarding headers of a trusted proxy. The
+// X-Forwarded-For and Forwarded headers are walked from the right skipping the trusted proxies, the
+// addresses on the left of the first one that isn't could have been sent by the client itself.
+func getTrustedSourceIP(r *http.Request) string {
+	var hops []string
+	if fwd := r.Header.Values(xForwardedFor); len(fwd) > 0 {
+		for _, values := range fwd {
+			for _, hop := range strings.Split(values, ",") {
+				hops = append(hops, forwardedHost(hop))
+			}
+		}
+	} else if fwd := r.Header.Get(xRealIP); fwd != "" {
+		hops = append(hops, forwardedHost(fwd))
+	} else if fwd := r.Header.Values(forwarded); len(fwd) > 0 {
+		for _, values := range fwd {
+			for _, element := range strings.Split(values, ",") {
+				for _, pair := range strings.Split(element, ";") {
+					if key, value, ok := strings.Cut(strings.TrimSpace(pair), "="); ok && strings.EqualFold(key, "for") {
+						hops = append(hops, forwardedHost(value))
+					}
+				}
+			}
+		}
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		// the address of the client is unknown past a hop that isn't one
+		if net.ParseIP(hops[i]) == nil {
+			return ""
+		}
+		if i == 0 || !isTrustedProxy(hops[i]) {
+			return hops[i]
+		}
+	}
+	return ""
+}
+
+// forwardedHost returns the address of a hop of a forwarding header without quotes nor port
+func forwardedHost(hop string) string {
+	hop = strings.Trim(strings.TrimSpace(hop), `"`)
+	if host, _, err := net.SplitHostPort(hop); err == nil {
+		return host
+	}
+	return strings.Trim(hop, "[]")
+}
+
+// isTrustedProxy tells whether the forwarding headers of a request sent from remoteAddr can be
+// trusted, which none are when CONSOLE_TRUSTED_PROXIES is not set
+func isTrustedProxy(remoteAddr string) bool {
+	proxies := getTrustedProxies()
+	if len(proxies) == 0 {
+		return false
+	}
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	for _, proxy := range proxies {
+		if _, cidr, err := net.ParseCIDR(proxy); err == nil {
+			if cidr.Contains(ip) {
+				return true
+			}
+		} else if proxyIP := net.ParseIP(proxy); proxyIP != nil && proxyIP.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
 // getClientIP retrieves the IP from the request headers
 // and falls back to r.RemoteAddr when necessary.
 // however returns without bracketing.
+//
+// The forwarding headers of any client are taken as they are when CONSOLE_TRUSTED_PROXIES is not set,
+// the IP only tells MinIO and the logs where requests come from. Use getLoginClientIP to throttle.
 func getClientIP(r *http.Request) string {
-	addr := getSourceIPFromHeaders(r)
+	var addr string
+	if len(getTrustedProxies()) == 0 {
+		addr = getSourceIPFromHeaders(r)
+	} else if isTrustedProxy(r.RemoteAddr) {
+		addr = getTrustedSourceIP(r)
+	}
 	if addr == "" {
 		addr = r.RemoteAddr
 	}
@@ -188,3 +301,18 @@ func getClientIP(r *http.Request) string {
 	}
 	return raddr
 }
+
+// getLoginClientIP retrieves the IP logins are throttled by, the forwarding headers are only trusted
+// from the proxies of CONSOLE_TRUSTED_PROXIES so clients can't pick the IP they're throttled by
+func getLoginClientIP(r *http.Request) string {
+	addr := r.RemoteAddr
+	if isTrustedProxy(r.RemoteAddr) {
+		if ip := getTrustedSourceIP(r); ip != "" {
+			addr = ip
+		}
+	}
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
@@ -31,12 +31,16 @@ import (
 
 	"github.com/minio/console/models"
 	"github.com/minio/console/pkg"
+	"github.com/minio/console/pkg/auth"
 	xjwt "github.com/minio/console/pkg/auth/token"
 	mc "github.com/minio/mc/cmd"
 	"github.com/minio/mc/pkg/probe"
 	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/cors"
 	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
 	"github.com/minio/minio-go/v7/pkg/notification"
+	"github.com/minio/minio-go/v7/pkg/replication"
 	"github.com/minio/minio-go/v7/pkg/tags"
 )
 
@@ -55,6 +59,8 @@ type MinioClient interface {
 	setBucketPolicyWithContext(ctx context.Context, bucketName, policy string) error
 	removeBucket(ctx context.Context, bucketName string) error
 	getBucketNotification(ctx context.Context, bucketName string) (config notification.Configuration, err error)
+	setBucketNotification(ctx context.Context, bucketName string, config notification.Configuration) error
+	getBucketVersioning(ctx context.Context, bucketName string) (minio.BucketVersioningConfiguration, error)
 	getBucketPolicy(ctx context.Context, bucketName string) (string, error)
 	listObjects(ctx context.Context, bucket string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo
 	getObjectRetention(ctx context.Context, bucketName, objectName, versionID string) (mode *minio.RetentionMode, retainUntilDate *time.Time, err error)
@@ -72,9 +78,21 @@ type MinioClient interface {
 	getBucketObjectLockConfig(ctx context.Context, bucketName string) (mode *minio.RetentionMode, validity *uint, unit *minio.ValidityUnit, err error)
 	getObjectLockConfig(ctx context.Context, bucketName string) (lock string, mode *minio.RetentionMode, validity *uint, unit *minio.ValidityUnit, err error)
 	copyObject(ctx context.Context, dst minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error)
+	composeObject(ctx context.Context, dst minio.CopyDestOptions, srcs ...minio.CopySrcOptions) (minio.UploadInfo, error)
 	GetBucketTagging(ctx context.Context, bucketName string) (*tags.Tags, error)
 	SetBucketTagging(ctx context.Context, bucketName string, tags *tags.Tags) error
 	RemoveBucketTagging(ctx context.Context, bucketName string) error
+	getLifecycleRules(ctx context.Context, bucketName string) (lifecycle *lifecycle.Configuration, err error)
+	setBucketLifecycle(ctx context.Context, bucketName string, config *lifecycle.Configuration) error
+	getBucketReplication(ctx context.Context, bucketName string) (replication.Config, error)
+	setBucketReplication(ctx context.Context, bucketName string, cfg replication.Config) error
+	removeBucketReplication(ctx context.Context, bucketName string) error
+	getBucketReplicationMetrics(ctx context.Context, bucketName string) (replication.MetricsV2, error)
+	getBucketCors(ctx context.Context, bucketName string) (*cors.Config, error)
+	setBucketCors(ctx context.Context, bucketName string, corsConfig *cors.Config) error
+	listIncompleteUploads(ctx context.Context, bucketName, prefix string, recursive bool) <-chan minio.ObjectMultipartInfo
+	listObjectParts(ctx context.Context, bucketName, objectName, uploadID string, partNumberMarker, maxParts int) (minio.ListObjectPartsResult, error)
+	abortMultipartUpload(ctx context.Context, bucketName, objectName, uploadID string) error
 }
 
 // Interface implementation
@@ -125,6 +143,11 @@ func (c minioClient) getBucketNotification(ctx context.Context, bucketName strin
 	return c.client.GetBucketNotification(ctx, bucketName)
 }
 
+// implements minio.SetBucketNotification(ctx, bucketName, config)
+func (c minioClient) setBucketNotification(ctx context.Context, bucketName string, config notification.Configuration) error {
+	return c.client.SetBucketNotification(ctx, bucketName, config)
+}
+
 // implements minio.GetBucketPolicy(bucketName)
 func (c minioClient) getBucketPolicy(ctx context.Context, bucketName string) (string, error) {
 	return c.client.GetBucketPolicy(ctx, bucketName)
@@ -199,10 +222,70 @@ func (c minioClient) getObjectLockConfig(ctx context.Context, bucketName string)
 	return c.client.GetObjectLockConfig(ctx, bucketName)
 }
 
+// implements minio.GetBucketLifecycle(ctx, bucketName)
+func (c minioClient) getLifecycleRules(ctx context.Context, bucketName string) (lifecycle *lifecycle.Configuration, err error) {
+	return c.client.GetBucketLifecycle(ctx, bucketName)
+}
+
+// implements minio.SetBucketLifecycle(ctx, bucketName, config)
+func (c minioClient) setBucketLifecycle(ctx context.Context, bucketName string, config *lifecycle.Configuration) error {
+	return c.client.SetBucketLifecycle(ctx, bucketName, config)
+}
+
+// implements minio.GetBucketReplication(ctx, bucketName)
+func (c minioClient) getBucketReplication(ctx context.Context, bucketName string) (replication.Config, error) {
+	return c.client.GetBucketReplication(ctx, bucketName)
+}
+
+// implements minio.SetBucketReplication(ctx, bucketName, cfg)
+func (c minioClient) setBucketReplication(ctx context.Context, bucketName string, cfg replication.Config) error {
+	return c.client.SetBucketReplication(ctx, bucketName, cfg)
+}
+
+// implements minio.RemoveBucketReplication(ctx, bucketName)
+func (c minioClient) removeBucketReplication(ctx context.Context, bucketName string) error {
+	return c.client.RemoveBucketReplication(ctx, bucketName)
+}
+
+// implements minio.GetBucketReplicationMetricsV2(ctx, bucketName)
+func (c minioClient) getBucketReplicationMetrics(ctx context.Context, bucketName string) (replication.MetricsV2, error) {
+	return c.client.GetBucketReplicationMetricsV2(ctx, bucketName)
+}
+
+// implements minio.GetBucketCors(ctx, bucketName)
+func (c minioClient) getBucketCors(ctx context.Context, bucketName string) (*cors.Config, error) {
+	return c.client.GetBucketCors(ctx, bucketName)
+}
+
+// implements minio.SetBucketCors(ctx, bucketName, corsConfig), a nil configuration removes it
+func (c minioClient) setBucketCors(ctx context.Context, bucketName string, corsConfig *cors.Config) error {
+	return c.client.SetBucketCors(ctx, bucketName, corsConfig)
+}
+
+// implements minio.ListIncompleteUploads(ctx, bucketName, prefix, recursive)
+func (c minioClient) listIncompleteUploads(ctx context.Context, bucketName, prefix string, recursive bool) <-chan minio.ObjectMultipartInfo {
+	return c.client.ListIncompleteUploads(ctx, bucketName, prefix, recursive)
+}
+
+// implements minio.Core.ListObjectParts(ctx, bucketName, objectName, uploadID, partNumberMarker, maxParts)
+func (c minioClient) listObjectParts(ctx context.Context, bucketName, objectName, uploadID string, partNumberMarker, maxParts int) (minio.ListObjectPartsResult, error) {
+	return minio.Core{Client: c.client}.ListObjectParts(ctx, bucketName, objectName, uploadID, partNumberMarker, maxParts)
+}
+
+// implements minio.Core.AbortMultipartUpload(ctx, bucketName, objectName, uploadID), unlike
+// RemoveIncompleteUpload it only aborts the given upload of the object
+func (c minioClient) abortMultipartUpload(ctx context.Context, bucketName, objectName, uploadID string) error {
+	return minio.Core{Client: c.client}.AbortMultipartUpload(ctx, bucketName, objectName, uploadID)
+}
+
 func (c minioClient) copyObject(ctx context.Context, dst minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error) {
 	return c.client.CopyObject(ctx, dst, src)
 }
 
+func (c minioClient) composeObject(ctx context.Context, dst minio.CopyDestOptions, srcs ...minio.CopySrcOptions) (minio.UploadInfo, error) {
+	return c.client.ComposeObject(ctx, dst, srcs...)
+}
+
 // MCClient interface with all functions to be implemented
 // by mock when testing, it should include all mc/S3Client respective api calls
 // that are used within this project.
@@ -267,6 +350,7 @@ type ConsoleCredentialsI interface {
 	Get() (credentials.Value, error)
 	Expire()
 	GetAccountAccessKey() string
+	GetSessionRenewal() *auth.SessionRenewal
 }
 
 // Interface implementation
@@ -274,12 +358,18 @@ type ConsoleCredentials struct {
 	ConsoleCredentials *credentials.Credentials
 	AccountAccessKey   string
 	CredContext        *credentials.CredContext
+	// Renewal is set when the credentials can be obtained again without the user
+	Renewal *auth.SessionRenewal
 }
 
 func (c ConsoleCredentials) GetAccountAccessKey() string {
 	return c.AccountAccessKey
 }
 
+func (c ConsoleCredentials) GetSessionRenewal() *auth.SessionRenewal {
+	return c.Renewal
+}
+
 // Get implements *Login.Get()
 func (c ConsoleCredentials) Get() (credentials.Value, error) {
 	return c.ConsoleCredentials.GetWithContext(c.CredContext)
@@ -293,22 +383,71 @@ func (c ConsoleCredentials) Expire() {
 // consoleSTSAssumeRole it's a STSAssumeRole wrapper, in general
 // there's no need to use this struct anywhere else in the project, it's only required
 // for passing a custom *http.Client to *credentials.STSAssumeRole
+//
+// The credentials of a renewable session start from the ones in the session token and
+// assume the role again once they expire, until the end of the session. The secret key
+// to assume the role with is only looked up then.
 type consoleSTSAssumeRole struct {
 	stsAssumeRole *credentials.STSAssumeRole
+	current       *credentials.Value
+	deadline      time.Time
+	secretKey     func() (string, error)
 }
 
 func (s consoleSTSAssumeRole) RetrieveWithCredContext(cc *credentials.CredContext) (credentials.Value, error) {
+	// the credentials of the session token are used until they're about to expire
+	if s.current != nil && time.Until(s.current.Expiration) > time.Minute {
+		s.stsAssumeRole.SetExpiration(s.current.Expiration, time.Minute)
+		return *s.current, nil
+	}
+	if !s.deadline.IsZero() {
+		duration, err := sessionRenewalDuration(s.deadline)
+		if err != nil {
+			return credentials.Value{}, err
+		}
+		s.stsAssumeRole.Options.DurationSeconds = int(duration.Seconds())
+	}
+	if s.secretKey != nil {
+		secretKey, err := s.secretKey()
+		if err != nil {
+			// sessions that can't assume the role again use their credentials until they expire
+			if s.current != nil && time.Now().Before(s.current.Expiration) {
+				s.stsAssumeRole.SetExpiration(s.current.Expiration, 0)
+				return *s.current, nil
+			}
+			return credentials.Value{}, err
+		}
+		s.stsAssumeRole.Options.SecretKey = secretKey
+	}
 	return s.stsAssumeRole.RetrieveWithCredContext(cc)
 }
 
 func (s consoleSTSAssumeRole) Retrieve() (credentials.Value, error) {
-	return s.stsAssumeRole.Retrieve()
+	return s.RetrieveWithCredContext(nil)
 }
 
 func (s consoleSTSAssumeRole) IsExpired() bool {
 	return s.stsAssumeRole.IsExpired()
 }
 
+// sessionRenewalDuration returns how long the STS credentials of a session renewed now should last,
+// the configured STS duration unless the session ends before
+func sessionRenewalDuration(deadline time.Time) (time.Duration, error) {
+	remaining := time.Until(deadline)
+	if remaining <= 0 {
+		return 0, ErrSessionMaxLifetime
+	}
+	duration := xjwt.GetConsoleSTSDuration()
+	if remaining < duration {
+		duration = remaining
+	}
+	// Minimum duration in S3 spec is 15 minutes
+	if duration < 900*time.Second {
+		duration = 900 * time.Second
+	}
+	return duration, nil
+}
+
 func stsCredentials(minioURL, accessKey, secretKey, location string, client *http.Client) (*credentials.Credentials, error) {
 	if accessKey == "" || secretKey == "" {
 		return nil, errors.New("credentials endpoint, access and secret key are mandatory for AssumeRoleSTS")
@@ -336,13 +475,61 @@ func NewConsoleCredentials(accessKey, secretKey, location string, client *http.C
 
 // getConsoleCredentialsFromSession returns the *consoleCredentials.Login associated to the
 // provided session token, this is useful for running the Expire() or IsExpired() operations
+//
+// The credentials of sessions logged in with access and secret keys are renewed when they expire in
+// the middle of a request if the session is renewable, with the secret key kept in the registry.
 func getConsoleCredentialsFromSession(claims *models.Principal) *credentials.Credentials {
 	if claims == nil {
 		return credentials.NewStaticV4("", "", "")
 	}
+	if sessionRenewsCredentials(claims) {
+		return credentials.New(consoleSTSAssumeRole{
+			stsAssumeRole: &credentials.STSAssumeRole{
+				Client:      GetConsoleHTTPClient(""),
+				STSEndpoint: getMinIOServer(),
+				Options: credentials.STSAssumeRoleOptions{
+					AccessKey: claims.AccountAccessKey,
+					Location:  GetMinIORegion(),
+				},
+			},
+			secretKey: func() (string, error) {
+				return sessionRenewalSecretKey(context.Background(), claims)
+			},
+			current: &credentials.Value{
+				AccessKeyID:     claims.STSAccessKeyID,
+				SecretAccessKey: claims.STSSecretAccessKey,
+				SessionToken:    claims.STSSessionToken,
+				Expiration:      time.Unix(claims.StsExpiration, 0),
+				SignerType:      credentials.SignatureV4,
+			},
+			deadline: time.Unix(claims.SessionExpiration, 0),
+		})
+	}
 	return credentials.NewStaticV4(claims.STSAccessKeyID, claims.STSSecretAccessKey, claims.STSSessionToken)
 }
 
+// sessionRenewsCredentials reports whether the clients of the session renew its STS credentials when they expire
+func sessionRenewsCredentials(claims *models.Principal) bool {
+	return GlobalSessions != nil && claims.SessionExpiration != 0 && claims.StsExpiration != 0
+}
+
+// sessionCredentialsDeadline returns when the clients of the session stop working, renewed credentials last as
+// long as the session and the others until their STS credentials expire. It is zero when that is unknown.
+func sessionCredentialsDeadline(claims *models.Principal) time.Time {
+	if sessionRenewsCredentials(claims) {
+		return time.Unix(claims.SessionExpiration, 0)
+	}
+	if claims.StsExpiration != 0 {
+		return time.Unix(claims.StsExpiration, 0)
+	}
+	if tokenClaims, err := getClaimsFromToken(claims.STSSessionToken); err == nil {
+		if exp, ok := tokenClaims["exp"].(float64); ok {
+			return time.Unix(int64(exp), 0)
+		}
+	}
+	return time.Time{}
+}
+
 // newMinioClient creates a new MinIO client based on the ConsoleCredentials extracted
 // from the provided session token
 func newMinioClient(claims *models.Principal, clientIP string) (*minio.Client, error) {
@@ -19,6 +19,8 @@ package api
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/base64"
+	"fmt"
 	"net"
 	"net/http"
 	"strconv"
@@ -278,3 +280,154 @@ func getConsoleAnimatedLogin() bool {
 func getConsoleBrowserRedirectURL() string {
 	return env.Get(ConsoleBrowserRedirectURL, "")
 }
+
+// getPrefixUsageCacheTTL returns how long bucket usage reports are cached, 0 disables the cache
+func getPrefixUsageCacheTTL() time.Duration {
+	ttl, err := time.ParseDuration(env.Get(ConsolePrefixUsageCacheTTL, "5m"))
+	if err != nil || ttl < 0 {
+		return 5 * time.Minute
+	}
+	return ttl
+}
+
+// getAccountInfoCacheTTL returns how long the account info of a session is cached, 0 disables the cache
+func getAccountInfoCacheTTL() time.Duration {
+	ttl, err := time.ParseDuration(env.Get(ConsoleAccountInfoCacheTTL, "10s"))
+	if err != nil || ttl < 0 {
+		return 10 * time.Second
+	}
+	return ttl
+}
+
+// getVersionWriterMetadata returns the metadata keys that hold who wrote an object version, the
+// first one present on a version is used
+func getVersionWriterMetadata() []string {
+	var keys []string
+	for _, key := range strings.Split(env.Get(ConsoleVersionWriterMetadata, "X-Amz-Meta-Uploaded-By"), ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// getSessionStore returns where the session registry keeps the sessions, "memory" or "bucket", sessions
+// are stateless when it's empty
+func getSessionStore() string {
+	return strings.ToLower(strings.TrimSpace(env.Get(ConsoleSessionStore, "")))
+}
+
+// getSessionStoreBucket returns the bucket the sessions are kept in with the bucket session store
+func getSessionStoreBucket() string {
+	return strings.TrimSpace(env.Get(ConsoleSessionStoreBucket, "console-sessions"))
+}
+
+// getSessionMaxLifetime returns how long after the login the STS credentials of a session can be renewed,
+// sessions end when their credentials expire when it's zero
+func getSessionMaxLifetime() time.Duration {
+	lifetime, err := time.ParseDuration(env.Get(ConsoleSessionMaxLifetime, "0s"))
+	if err != nil || lifetime < 0 {
+		return 0
+	}
+	return lifetime
+}
+
+// getSessionIdleTimeout returns how long a session can go unused before it expires, sessions don't expire for
+// being idle when it's zero
+func getSessionIdleTimeout() time.Duration {
+	timeout, err := time.ParseDuration(env.Get(ConsoleSessionIdleTimeout, "0s"))
+	if err != nil || timeout < 0 {
+		return 0
+	}
+	return timeout
+}
+
+// getLoginMaxFailures returns how many failed logins lock an access key out, logins aren't throttled
+// when it's zero
+func getLoginMaxFailures() int {
+	maxFailures, err := strconv.Atoi(env.Get(ConsoleLoginMaxFailures, "10"))
+	if err != nil || maxFailures < 0 {
+		return 10
+	}
+	return maxFailures
+}
+
+// getLoginMaxIPFailures returns how many failed logins with any access key lock a client IP out
+func getLoginMaxIPFailures() int {
+	maxFailures, err := strconv.Atoi(env.Get(ConsoleLoginMaxIPFailures, "100"))
+	if err != nil || maxFailures <= 0 {
+		return 100
+	}
+	return maxFailures
+}
+
+// getLoginLockoutDuration returns how long an access key or a client IP stays locked out
+func getLoginLockoutDuration() time.Duration {
+	lockout, err := time.ParseDuration(env.Get(ConsoleLoginLockoutDuration, "15m"))
+	if err != nil || lockout <= 0 {
+		return 15 * time.Minute
+	}
+	return lockout
+}
+
+// getTrustedProxies returns the IPs and CIDRs of the proxies whose forwarding headers tell the
+// client IP logins are throttled by
+func getTrustedProxies() []string {
+	var proxies []string
+	for _, proxy := range strings.Split(env.Get(ConsoleTrustedProxies, ""), ",") {
+		if proxy = strings.TrimSpace(proxy); proxy != "" {
+			proxies = append(proxies, proxy)
+		}
+	}
+	return proxies
+}
+
+// getSecondFactorStore returns where the second factors of the users are kept, "memory" or "bucket", second
+// factors are disabled when it's empty
+func getSecondFactorStore() string {
+	return strings.ToLower(strings.TrimSpace(env.Get(ConsoleMFAStore, "")))
+}
+
+// getSecondFactorStoreBucket returns the bucket the second factors are kept in with the bucket store
+func getSecondFactorStoreBucket() string {
+	return strings.TrimSpace(env.Get(ConsoleMFAStoreBucket, "console-mfa"))
+}
+
+// getSecondFactorStoreKey returns the key the bucket store encrypts the second factors with, base64 encoded
+func getSecondFactorStoreKey() ([]byte, error) {
+	encoded := strings.TrimSpace(env.Get(ConsoleMFAStoreKey, ""))
+	if encoded == "" {
+		return nil, fmt.Errorf("%s is required by the bucket store of the second factors", ConsoleMFAStoreKey)
+	}
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not base64 encoded: %w", ConsoleMFAStoreKey, err)
+	}
+	return key, nil
+}
+
+// getSecondFactorRequired returns whether every user has to log in with a second factor
+func getSecondFactorRequired() bool {
+	return strings.ToLower(env.Get(ConsoleMFARequired, "off")) == "on"
+}
+
+// getSecondFactorRequiredUsers returns the users that have to log in with a second factor
+func getSecondFactorRequiredUsers() []string {
+	var users []string
+	for _, user := range strings.Split(env.Get(ConsoleMFARequiredUsers, ""), ",") {
+		if user = strings.TrimSpace(user); user != "" {
+			users = append(users, user)
+		}
+	}
+	return users
+}
+
+// getSecondFactorIssuer returns the name authenticators show the second factors of console under
+func getSecondFactorIssuer() string {
+	return env.Get(ConsoleMFAIssuer, "MinIO Console")
+}
+
+// getBucketTemplatesFile returns the path of the JSON file with the named bucket templates
+func getBucketTemplatesFile() string {
+	return strings.TrimSpace(env.Get(ConsoleBucketTemplatesFile, ""))
+}
@@ -41,7 +41,6 @@ import (
 
 	"github.com/minio/console/pkg/logger"
 	"github.com/minio/console/pkg/utils"
-	"github.com/minio/minio-go/v7/pkg/credentials"
 
 	"github.com/klauspost/compress/gzhttp"
 
@@ -95,7 +94,7 @@ func configureAPI(api *operations.ConsoleAPI) http.Handler {
 			api.Logger("Unable to validate the session token %s: %v", token, err)
 			return nil, errors.New(401, "incorrect api key auth")
 		}
-		return &models.Principal{
+		principal := &models.Principal{
 			STSAccessKeyID:     claims.STSAccessKeyID,
 			STSSecretAccessKey: claims.STSSecretAccessKey,
 			STSSessionToken:    claims.STSSessionToken,
@@ -103,7 +102,16 @@ func configureAPI(api *operations.ConsoleAPI) http.Handler {
 			Hm:                 claims.HideMenu,
 			Ob:                 claims.ObjectBrowser,
 			CustomStyleOb:      claims.CustomStyleOB,
-		}, nil
+			SessionID:          claims.SessionID,
+			StsExpiration:      claims.STSExpiration,
+			SessionExpiration:  claims.SessionExpiration,
+			LastActivity:       claims.LastActivity,
+		}
+		if err = validateSession(context.Background(), principal); err != nil {
+			api.Logger("Rejected session of %s: %v", principal.AccountAccessKey, err)
+			return nil, errors.New(401, "incorrect api key auth")
+		}
+		return principal, nil
 	}
 	api.AnonymousAuth = func(_ string) (*models.Principal, error) {
 		return &models.Principal{}, nil
@@ -115,10 +123,36 @@ func configureAPI(api *operations.ConsoleAPI) http.Handler {
 	registerLogoutHandlers(api)
 	// Register bucket handlers
 	registerBucketsHandlers(api)
+	// Register Bucket Templates Handlers
+	registerBucketTemplatesHandlers(api)
 	// Register session handlers
 	registerSessionHandlers(api)
+	// Register Active Sessions Handlers
+	registerActiveSessionsHandlers(api)
+	// Register Session Renewal Handlers
+	registerSessionRenewalHandlers(api)
+	// Register Second Factor Handlers
+	registerSecondFactorHandlers(api)
 	// Register Object's Handlers
 	registerObjectsHandlers(api)
+	// Register Object Versions Handlers
+	registerObjectVersionsHandlers(api)
+	// Register Bucket Lifecycle's Handlers
+	registerBucketsLifecycleHandlers(api)
+	// Register Bucket Replication's Handlers
+	registerBucketsReplicationHandlers(api)
+	// Register Bucket CORS Handlers
+	registerBucketsCorsHandlers(api)
+	// Register Bucket Diff Handlers
+	registerBucketsDiffHandlers(api)
+	// Register Bucket Mirror Handlers
+	registerBucketsMirrorHandlers(api)
+	// Register Bucket Inventory Handlers
+	registerBucketsInventoryHandlers(api)
+	// Register Bucket Incomplete Uploads Handlers
+	registerBucketsUploadsHandlers(api)
+	// Register Bucket Configuration Handlers
+	registerBucketsConfigHandlers(api)
 	// Register Bucket Quota's Handlers
 	registerBucketQuotaHandlers(api)
 	// Register Bucket Policy's Handlers
@@ -334,6 +368,47 @@ func AuthenticationMiddleware(next http.Handler) http.Handler {
 			return
 		}
 		sessionToken, _ := auth.DecryptToken(token)
+		claims, _ := auth.ParseClaimsFromToken(string(sessionToken))
+		if claims != nil {
+			principal := &models.Principal{
+				STSAccessKeyID:    claims.STSAccessKeyID,
+				STSSessionToken:   claims.STSSessionToken,
+				AccountAccessKey:  claims.AccountAccessKey,
+				SessionID:         claims.SessionID,
+				SessionExpiration: claims.SessionExpiration,
+				LastActivity:      claims.LastActivity,
+			}
+			// a revoked session is dropped so the request goes on as anonymous and the browser forgets it
+			if err = validateSession(r.Context(), principal); err != nil {
+				expiredCookie := ExpireSessionCookie()
+				http.SetCookie(w, &expiredCookie)
+				sessionToken, claims = nil, nil
+			}
+		}
+		// sessions in use record their activity, renewable sessions get new credentials before the ones
+		// they carry expire
+		if claims != nil {
+			now := time.Now()
+			var refreshed *renewedSession
+			if sessionRenewalDue(claims, now) {
+				if refreshed, err = globalSessionRenewer.renew(r, claims); err != nil {
+					LogError("unable to renew the session of %s: %v", claims.AccountAccessKey, err)
+				}
+			}
+			touched, err := touchSession(claims, now)
+			if err != nil {
+				LogError("unable to re-issue the session of %s: %v", claims.AccountAccessKey, err)
+			}
+			if refreshed == nil {
+				refreshed = touched
+			}
+			if refreshed != nil {
+				if refreshedToken, err := auth.DecryptToken(refreshed.token); err == nil {
+					setRenewedSessionCookies(w, refreshed)
+					sessionToken = refreshedToken
+				}
+			}
+		}
 		// All handlers handle appropriately to return errors
 		// based on their swagger rules, we do not need to
 		// additionally return error here, let the next ServeHTTPs
@@ -344,7 +419,6 @@ func AuthenticationMiddleware(next http.Handler) http.Handler {
 			r.Header.Add("Authorization", fmt.Sprintf("Bearer %s", "Anonymous"))
 		}
 		ctx := r.Context()
-		claims, _ := auth.ParseClaimsFromToken(string(sessionToken))
 		if claims != nil {
 			// save user session id context
 			ctx = context.WithValue(r.Context(), utils.ContextRequestUserID, claims.STSSessionToken)
@@ -420,11 +494,6 @@ func handleSPA(w http.ResponseWriter, r *http.Request) {
 
 	// if these three parameters are present we are being asked to issue a session with these values
 	if sts != "" && stsAccessKey != "" && stsSecretKey != "" {
-		creds := credentials.NewStaticV4(stsAccessKey, stsSecretKey, sts)
-		consoleCreds := &ConsoleCredentials{
-			ConsoleCredentials: creds,
-			AccountAccessKey:   stsAccessKey,
-		}
 		sf := &auth.SessionFeatures{}
 		sf.HideMenu = true
 		sf.ObjectBrowser = true
@@ -439,7 +508,13 @@ func handleSPA(w http.ResponseWriter, r *http.Request) {
 			sf.CustomStyleOB = overridenStyles
 		}
 
-		sessionID, err := login(consoleCreds, sf)
+		session, err := authenticateSTS(r.Context(), stsAccessKey, stsSecretKey, sts, sf, GetConsoleHTTPClient(getClientIP(r)))
+		if err != nil {
+			apiErr := ErrorWithContext(r.Context(), err, ErrInvalidLogin)
+			http.Error(w, apiErr.APIError.Message, apiErr.Code)
+			return
+		}
+		sessionID, err := issueSession(r, session)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
@@ -49,6 +49,28 @@ const (
 	ConsoleDevMode                               = "CONSOLE_DEV_MODE"
 	ConsoleAnimatedLogin                         = "CONSOLE_ANIMATED_LOGIN"
 	ConsoleBrowserRedirectURL                    = "CONSOLE_BROWSER_REDIRECT_URL"
+	ConsolePrefixUsageCacheTTL                   = "CONSOLE_PREFIX_USAGE_CACHE_TTL"
+	ConsoleBucketTemplatesFile                   = "CONSOLE_BUCKET_TEMPLATES_FILE"
+	ConsoleAccountInfoCacheTTL                   = "CONSOLE_ACCOUNT_INFO_CACHE_TTL"
+	ConsoleVersionWriterMetadata                 = "CONSOLE_VERSION_WRITER_METADATA"
+	ConsoleSessionStore                          = "CONSOLE_SESSION_STORE"
+	ConsoleSessionStoreBucket                    = "CONSOLE_SESSION_STORE_BUCKET"
+	ConsoleSessionStoreAccessKey                 = "CONSOLE_SESSION_STORE_ACCESS_KEY"
+	ConsoleSessionStoreSecretKey                 = "CONSOLE_SESSION_STORE_SECRET_KEY"
+	ConsoleSessionMaxLifetime                    = "CONSOLE_SESSION_MAX_LIFETIME"
+	ConsoleSessionIdleTimeout                    = "CONSOLE_SESSION_IDLE_TIMEOUT"
+	ConsoleLoginMaxFailures                      = "CONSOLE_LOGIN_MAX_FAILURES"
+	ConsoleLoginMaxIPFailures                    = "CONSOLE_LOGIN_MAX_IP_FAILURES"
+	ConsoleLoginLockoutDuration                  = "CONSOLE_LOGIN_LOCKOUT_DURATION"
+	ConsoleTrustedProxies                        = "CONSOLE_TRUSTED_PROXIES"
+	ConsoleMFAStore                              = "CONSOLE_MFA_STORE"
+	ConsoleMFAStoreBucket                        = "CONSOLE_MFA_STORE_BUCKET"
+	ConsoleMFAStoreAccessKey                     = "CONSOLE_MFA_STORE_ACCESS_KEY"
+	ConsoleMFAStoreSecretKey                     = "CONSOLE_MFA_STORE_SECRET_KEY"
+	ConsoleMFAStoreKey                           = "CONSOLE_MFA_STORE_KEY"
+	ConsoleMFARequired                           = "CONSOLE_MFA_REQUIRED"
+	ConsoleMFARequiredUsers                      = "CONSOLE_MFA_REQUIRED_USERS"
+	ConsoleMFAIssuer                             = "CONSOLE_MFA_ISSUER"
 	LogSearchQueryAuthToken                      = "LOGSEARCH_QUERY_AUTH_TOKEN"
 	SlashSeparator                               = "/"
 	LocalAddress                                 = "127.0.0.1"
@@ -83,6 +83,29 @@ func init() {
         }
       }
     },
+    "/bucket-templates": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "List the bucket templates configured in the server",
+        "operationId": "ListBucketTemplates",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketTemplateList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
     "/buckets": {
       "get": {
         "tags": [
@@ -90,6 +113,51 @@ func init() {
         ],
         "summary": "List Buckets",
         "operationId": "ListBuckets",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "only list buckets whose name starts with prefix",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "only list buckets whose name contains search, case insensitive",
+            "name": "search",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "only list buckets with every tag of a comma separated list of key or key=value",
+            "name": "tags",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "one of name, size, objects or created, name by default",
+            "name": "sort_by",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "asc or desc, asc by default",
+            "name": "sort_order",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "description": "maximum number of buckets returned, all of them when empty",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
         "responses": {
           "200": {
             "description": "A successful response.",
@@ -160,45 +228,19 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/delete-objects": {
-      "post": {
+    "/buckets/mirror-jobs": {
+      "get": {
         "tags": [
-          "Object"
-        ],
-        "summary": "Delete Multiple Objects",
-        "operationId": "DeleteMultipleObjects",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "boolean",
-            "name": "all_versions",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "bypass",
-            "in": "query"
-          },
-          {
-            "name": "files",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/deleteFile"
-              }
-            }
-          }
+          "Bucket"
         ],
+        "summary": "List the mirror jobs started by the current user",
+        "operationId": "ListMirrorJobs",
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/mirrorJobList"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -209,57 +251,26 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects": {
+    "/buckets/mirror-jobs/{job_id}": {
       "get": {
-        "security": [
-          {
-            "key": []
-          },
-          {
-            "anonymous": []
-          }
-        ],
         "tags": [
-          "Object"
+          "Bucket"
         ],
-        "summary": "List Objects",
-        "operationId": "ListObjects",
+        "summary": "Get the progress or the final report of a mirror job",
+        "operationId": "GetMirrorJob",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "job_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "recursive",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "with_versions",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "with_metadata",
-            "in": "query"
-          },
-          {
-            "$ref": "#/parameters/limit"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listObjectsResponse"
+              "$ref": "#/definitions/mirrorJob"
             }
           },
           "default": {
@@ -272,51 +283,20 @@ func init() {
       },
       "delete": {
         "tags": [
-          "Object"
+          "Bucket"
         ],
-        "summary": "Delete Object",
-        "operationId": "DeleteObject",
+        "summary": "Cancel a running mirror job or remove a finished one",
+        "operationId": "DeleteMirrorJob",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "job_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "recursive",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "all_versions",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "non_current_versions",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "bypass",
-            "in": "query"
           }
         ],
         "responses": {
-          "200": {
+          "204": {
             "description": "A successful response."
           },
           "default": {
@@ -328,60 +308,26 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/download": {
-      "get": {
-        "security": [
-          {
-            "key": []
-          },
-          {
-            "anonymous": []
-          }
-        ],
-        "produces": [
-          "application/octet-stream"
-        ],
+    "/buckets/mirror-jobs/{job_id}/pause": {
+      "post": {
         "tags": [
-          "Object"
+          "Bucket"
         ],
-        "summary": "Download Object",
-        "operationId": "Download Object",
+        "summary": "Pause a running mirror job",
+        "operationId": "PauseMirrorJob",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "job_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "default": false,
-            "name": "preview",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "default": "",
-            "name": "override_file_name",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/mirrorJob"
             }
           },
           "default": {
@@ -393,48 +339,26 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/download-multiple": {
+    "/buckets/mirror-jobs/{job_id}/resume": {
       "post": {
-        "security": [
-          {
-            "key": []
-          },
-          {
-            "anonymous": []
-          }
-        ],
-        "produces": [
-          "application/octet-stream"
-        ],
         "tags": [
-          "Object"
+          "Bucket"
         ],
-        "summary": "Download Multiple Objects",
-        "operationId": "DownloadMultipleObjects",
+        "summary": "Resume a paused mirror job",
+        "operationId": "ResumeMirrorJob",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "job_id",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "objectList",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/mirrorJob"
             }
           },
           "default": {
@@ -446,37 +370,26 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/metadata": {
+    "/buckets/{bucket_name}/config-export": {
       "get": {
         "tags": [
-          "Object"
+          "Bucket"
         ],
-        "summary": "Gets the metadata of an object",
-        "operationId": "GetObjectMetadata",
+        "summary": "Export the full configuration of a bucket as a single document",
+        "operationId": "ExportBucketConfig",
         "parameters": [
           {
             "type": "string",
             "name": "bucket_name",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "versionID",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/metadata"
+              "$ref": "#/definitions/bucketConfigBundle"
             }
           },
           "default": {
@@ -488,13 +401,13 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/restore": {
-      "put": {
+    "/buckets/{bucket_name}/config-import": {
+      "post": {
         "tags": [
-          "Object"
+          "Bucket"
         ],
-        "summary": "Restore Object to a selected version",
-        "operationId": "PutObjectRestore",
+        "summary": "Preview or apply a bucket configuration bundle",
+        "operationId": "ImportBucketConfig",
         "parameters": [
           {
             "type": "string",
@@ -503,21 +416,20 @@ func init() {
             "required": true
           },
           {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketConfigImportRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketConfigImportResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -528,43 +440,26 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/share": {
+    "/buckets/{bucket_name}/cors": {
       "get": {
         "tags": [
-          "Object"
+          "Bucket"
         ],
-        "summary": "Shares an Object on a url",
-        "operationId": "ShareObject",
+        "summary": "Bucket CORS Configuration",
+        "operationId": "GetBucketCors",
         "parameters": [
           {
             "type": "string",
             "name": "bucket_name",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "expires",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/bucketCorsConfiguration"
             }
           },
           "default": {
@@ -574,15 +469,13 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/buckets/{bucket_name}/objects/tags": {
+      },
       "put": {
         "tags": [
-          "Object"
+          "Bucket"
         ],
-        "summary": "Put Object's tags",
-        "operationId": "PutObjectTags",
+        "summary": "Set Bucket CORS Configuration",
+        "operationId": "SetBucketCors",
         "parameters": [
           {
             "type": "string",
@@ -590,16 +483,66 @@ func init() {
             "in": "path",
             "required": true
           },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketCorsConfiguration"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Remove Bucket CORS Configuration",
+        "operationId": "DeleteBucketCors",
+        "parameters": [
           {
             "type": "string",
-            "name": "prefix",
-            "in": "query",
+            "name": "bucket_name",
+            "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
           },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/cors-test": {
+      "post": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Evaluate a cross-origin request against the Bucket CORS rules",
+        "operationId": "TestBucketCors",
+        "parameters": [
           {
             "type": "string",
-            "name": "version_id",
-            "in": "query",
+            "name": "bucket_name",
+            "in": "path",
             "required": true
           },
           {
@@ -607,13 +550,16 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/putObjectTagsRequest"
+              "$ref": "#/definitions/corsTestRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/corsTestResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -624,23 +570,13 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/upload": {
+    "/buckets/{bucket_name}/delete-objects": {
       "post": {
-        "security": [
-          {
-            "key": []
-          },
-          {
-            "anonymous": []
-          }
-        ],
-        "consumes": [
-          "multipart/form-data"
-        ],
         "tags": [
           "Object"
         ],
-        "summary": "Uploads an Object.",
+        "summary": "Delete Multiple Objects",
+        "operationId": "DeleteMultipleObjects",
         "parameters": [
           {
             "type": "string",
@@ -649,9 +585,25 @@ func init() {
             "required": true
           },
           {
-            "type": "string",
-            "name": "prefix",
+            "type": "boolean",
+            "name": "all_versions",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "bypass",
             "in": "query"
+          },
+          {
+            "name": "files",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/deleteFile"
+              }
+            }
           }
         ],
         "responses": {
@@ -667,13 +619,16 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/rewind/{date}": {
+    "/buckets/{bucket_name}/diff": {
       "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "Bucket"
         ],
-        "summary": "Get objects in a bucket for a rewind date",
-        "operationId": "GetBucketRewind",
+        "summary": "Download the differences between a bucket prefix and a target location as CSV",
+        "operationId": "DownloadBucketDiff",
         "parameters": [
           {
             "type": "string",
@@ -683,13 +638,18 @@ func init() {
           },
           {
             "type": "string",
-            "name": "date",
-            "in": "path",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "target_bucket",
+            "in": "query",
             "required": true
           },
           {
             "type": "string",
-            "name": "prefix",
+            "name": "target_prefix",
             "in": "query"
           }
         ],
@@ -697,7 +657,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/rewindResponse"
+              "type": "file"
             }
           },
           "default": {
@@ -709,26 +669,31 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/versioning": {
+    "/buckets/{bucket_name}/incomplete-uploads": {
       "get": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Bucket Versioning",
-        "operationId": "GetBucketVersioning",
+        "summary": "Lists the incomplete multipart uploads of a bucket",
+        "operationId": "ListIncompleteUploads",
         "parameters": [
           {
             "type": "string",
             "name": "bucket_name",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucketVersioningResponse"
+              "$ref": "#/definitions/listIncompleteUploadsResponse"
             }
           },
           "default": {
@@ -739,12 +704,12 @@ func init() {
           }
         }
       },
-      "put": {
+      "delete": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Set Bucket Versioning",
-        "operationId": "SetBucketVersioning",
+        "summary": "Aborts an incomplete multipart upload",
+        "operationId": "AbortIncompleteUpload",
         "parameters": [
           {
             "type": "string",
@@ -753,16 +718,20 @@ func init() {
             "required": true
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/setBucketVersioning"
-            }
+            "type": "string",
+            "name": "key",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "upload_id",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
+          "204": {
             "description": "A successful response."
           },
           "default": {
@@ -774,26 +743,34 @@ func init() {
         }
       }
     },
-    "/buckets/{name}": {
-      "get": {
+    "/buckets/{bucket_name}/incomplete-uploads/abort": {
+      "post": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Bucket Info",
-        "operationId": "BucketInfo",
+        "summary": "Aborts the incomplete multipart uploads started before a given age",
+        "operationId": "AbortIncompleteUploads",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/abortIncompleteUploadsRequest"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucket"
+              "$ref": "#/definitions/abortIncompleteUploadsResponse"
             }
           },
           "default": {
@@ -805,26 +782,50 @@ func init() {
         }
       }
     },
-    "/buckets/{name}/quota": {
+    "/buckets/{bucket_name}/inventory": {
       "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "Bucket"
         ],
-        "summary": "Get Bucket Quota",
-        "operationId": "GetBucketQuota",
+        "summary": "Download an inventory of the objects in a bucket",
+        "operationId": "DownloadBucketInventory",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "one of csv, jsonl or parquet, csv by default",
+            "name": "format",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "versions",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "metadata",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucketQuota"
+              "type": "file"
             }
           },
           "default": {
@@ -834,32 +835,34 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/download-shared-object/{url}": {
-      "get": {
-        "security": [],
-        "produces": [
-          "application/octet-stream"
-        ],
+      },
+      "post": {
         "tags": [
-          "Public"
+          "Bucket"
         ],
-        "summary": "Downloads an object from a presigned url",
-        "operationId": "DownloadSharedObject",
+        "summary": "Write an inventory of the objects in a bucket into a destination bucket",
+        "operationId": "ExportBucketInventory",
         "parameters": [
           {
             "type": "string",
-            "name": "url",
+            "name": "bucket_name",
             "in": "path",
             "required": true
-          }
-        ],
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/inventoryExportRequest"
+            }
+          }
+        ],
         "responses": {
-          "200": {
+          "201": {
             "description": "A successful response.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/inventoryExportResult"
             }
           },
           "default": {
@@ -871,15 +874,60 @@ func init() {
         }
       }
     },
-    "/license/acknowledge": {
+    "/buckets/{bucket_name}/lifecycle": {
       "get": {
         "tags": [
-          "License"
+          "Bucket"
+        ],
+        "summary": "Bucket Lifecycle",
+        "operationId": "GetBucketLifecycle",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "Acknowledge the license",
-        "operationId": "LicenseAcknowledge",
         "responses": {
           "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketLifecycleResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Add Bucket Lifecycle",
+        "operationId": "AddBucketLifecycle",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/lifecycleRuleRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
             "description": "A successful response."
           },
           "default": {
@@ -891,19 +939,35 @@ func init() {
         }
       }
     },
-    "/login": {
+    "/buckets/{bucket_name}/lifecycle-export": {
       "get": {
-        "security": [],
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "Auth"
+          "Bucket"
+        ],
+        "summary": "Export Bucket Lifecycle configuration as XML or JSON",
+        "operationId": "ExportBucketLifecycle",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "default": "xml",
+            "name": "format",
+            "in": "query"
+          }
         ],
-        "summary": "Returns login strategy, form or sso.",
-        "operationId": "LoginDetail",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/loginDetails"
+              "type": "file"
             }
           },
           "default": {
@@ -913,27 +977,34 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/buckets/{bucket_name}/lifecycle-import": {
       "post": {
-        "security": [],
         "tags": [
-          "Auth"
+          "Bucket"
         ],
-        "summary": "Login to Console",
-        "operationId": "Login",
+        "summary": "Replace Bucket Lifecycle configuration with an XML or JSON document",
+        "operationId": "ImportBucketLifecycle",
         "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/loginRequest"
+              "$ref": "#/definitions/lifecycleImportRequest"
             }
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful login."
+          "201": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -944,20 +1015,32 @@ func init() {
         }
       }
     },
-    "/logout": {
-      "post": {
+    "/buckets/{bucket_name}/lifecycle/{lifecycle_id}": {
+      "put": {
         "tags": [
-          "Auth"
+          "Bucket"
         ],
-        "summary": "Logout from Console.",
-        "operationId": "Logout",
+        "summary": "Update Lifecycle rule",
+        "operationId": "UpdateBucketLifecycle",
         "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "lifecycle_id",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/logoutRequest"
+              "$ref": "#/definitions/lifecycleRuleRequest"
             }
           }
         ],
@@ -972,20 +1055,68 @@ func init() {
             }
           }
         }
+      },
+      "delete": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Delete Lifecycle rule",
+        "operationId": "DeleteBucketLifecycleRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "lifecycle_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
       }
     },
-    "/session": {
-      "get": {
+    "/buckets/{bucket_name}/mirror": {
+      "post": {
         "tags": [
-          "Auth"
+          "Bucket"
+        ],
+        "summary": "Start a job mirroring a bucket prefix into a target location",
+        "operationId": "StartBucketMirror",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/mirrorJobRequest"
+            }
+          }
         ],
-        "summary": "Endpoint to check if your session is still valid",
-        "operationId": "SessionCheck",
         "responses": {
-          "200": {
+          "201": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/sessionResponse"
+              "$ref": "#/definitions/mirrorJob"
             }
           },
           "default": {
@@ -996,849 +1127,5917 @@ func init() {
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "ApiError": {
-      "type": "object",
-      "properties": {
-        "detailedMessage": {
-          "type": "string"
-        },
-        "message": {
-          "type": "string"
-        }
-      }
-    },
-    "BackendProperties": {
-      "type": "object",
-      "properties": {
-        "backendType": {
-          "type": "string"
-        },
-        "offlineDrives": {
-          "type": "integer"
-        },
-        "onlineDrives": {
-          "type": "integer"
-        },
-        "rrSCParity": {
-          "type": "integer"
-        },
-        "standardSCParity": {
-          "type": "integer"
-        }
-      }
     },
-    "adminInfoResponse": {
-      "type": "object",
-      "properties": {
-        "advancedMetricsStatus": {
-          "type": "string",
-          "enum": [
-            "not configured",
-            "available",
-            "unavailable"
-          ]
-        },
-        "backend": {
-          "$ref": "#/definitions/BackendProperties"
-        },
-        "buckets": {
-          "type": "integer"
-        },
-        "objects": {
-          "type": "integer"
-        },
-        "servers": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/serverProperties"
-          }
-        },
-        "usage": {
-          "type": "integer"
-        },
-        "widgets": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/widget"
+    "/buckets/{bucket_name}/objects": {
+      "get": {
+        "security": [
+          {
+            "key": []
+          },
+          {
+            "anonymous": []
           }
-        }
-      }
-    },
-    "bucket": {
-      "type": "object",
-      "required": [
-        "name"
-      ],
-      "properties": {
-        "access": {
-          "$ref": "#/definitions/bucketAccess"
-        },
-        "creation_date": {
-          "type": "string"
-        },
-        "definition": {
-          "type": "string"
-        },
-        "details": {
-          "type": "object",
-          "properties": {
-            "locking": {
-              "type": "boolean"
-            },
-            "quota": {
-              "type": "object",
-              "properties": {
-                "quota": {
-                  "type": "integer",
-                  "format": "int64"
-                },
-                "type": {
-                  "type": "string",
-                  "enum": [
-                    "hard"
-                  ]
-                }
-              }
-            },
-            "replication": {
-              "type": "boolean"
-            },
-            "tags": {
-              "type": "object",
-              "additionalProperties": {
+        ],
+        "tags": [
+          "Object"
+        ],
+        "summary": "List Objects",
+        "operationId": "ListObjects",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "recursive",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "with_versions",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "with_metadata",
+            "in": "query"
+          },
+          {
+            "$ref": "#/parameters/limit"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listObjectsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "Object"
+        ],
+        "summary": "Delete Object",
+        "operationId": "DeleteObject",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "recursive",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "all_versions",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "non_current_versions",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "bypass",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/objects/download": {
+      "get": {
+        "security": [
+          {
+            "key": []
+          },
+          {
+            "anonymous": []
+          }
+        ],
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "Object"
+        ],
+        "summary": "Download Object",
+        "operationId": "Download Object",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "name": "preview",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "default": "",
+            "name": "override_file_name",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/objects/download-multiple": {
+      "post": {
+        "security": [
+          {
+            "key": []
+          },
+          {
+            "anonymous": []
+          }
+        ],
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "Object"
+        ],
+        "summary": "Download Multiple Objects",
+        "operationId": "DownloadMultipleObjects",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "objectList",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
                 "type": "string"
               }
-            },
-            "versioning": {
-              "type": "boolean"
-            },
-            "versioningSuspended": {
-              "type": "boolean"
             }
           }
-        },
-        "name": {
-          "type": "string",
-          "minLength": 3
-        },
-        "objects": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "rw_access": {
-          "type": "object",
-          "properties": {
-            "read": {
-              "type": "boolean"
-            },
-            "write": {
-              "type": "boolean"
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/objects/metadata": {
+      "get": {
+        "tags": [
+          "Object"
+        ],
+        "summary": "Gets the metadata of an object",
+        "operationId": "GetObjectMetadata",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "versionID",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/metadata"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/objects/restore": {
+      "put": {
+        "tags": [
+          "Object"
+        ],
+        "summary": "Restore Object to a selected version",
+        "operationId": "PutObjectRestore",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/objects/share": {
+      "get": {
+        "tags": [
+          "Object"
+        ],
+        "summary": "Shares an Object on a url",
+        "operationId": "ShareObject",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "expires",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/objects/tags": {
+      "put": {
+        "tags": [
+          "Object"
+        ],
+        "summary": "Put Object's tags",
+        "operationId": "PutObjectTags",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putObjectTagsRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/objects/upload": {
+      "post": {
+        "security": [
+          {
+            "key": []
+          },
+          {
+            "anonymous": []
+          }
+        ],
+        "consumes": [
+          "multipart/form-data"
+        ],
+        "tags": [
+          "Object"
+        ],
+        "summary": "Uploads an Object.",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/objects/versions": {
+      "get": {
+        "tags": [
+          "Object"
+        ],
+        "summary": "Gets the full version history of an object",
+        "operationId": "GetObjectVersionHistory",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/objectVersionHistory"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/objects/versions/prune": {
+      "post": {
+        "tags": [
+          "Object"
+        ],
+        "summary": "Deletes the noncurrent versions of an object that are no longer needed",
+        "operationId": "PruneObjectVersions",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/pruneObjectVersionsRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/pruneObjectVersionsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/replication": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Bucket Replication Rules",
+        "operationId": "GetBucketReplication",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketReplicationResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Add Bucket Replication Rule",
+        "operationId": "AddBucketReplicationRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/addBucketReplicationRule"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/replication-metrics": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Bucket Replication Status and Metrics",
+        "operationId": "GetBucketReplicationMetrics",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketReplicationMetrics"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/replication/{rule_id}": {
+      "delete": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Remove Bucket Replication Rule",
+        "operationId": "DeleteBucketReplicationRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "rule_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "description": "also remove the remote target of the rule unless another rule replicates to it",
+            "name": "remove_target",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/retention": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Get Bucket's default retention configuration",
+        "operationId": "GetBucketRetentionConfig",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/getBucketRetentionConfig"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Set Bucket's default retention configuration",
+        "operationId": "SetBucketRetentionConfig",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putBucketRetentionRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/putBucketRetentionResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/rewind/{date}": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Get objects in a bucket for a rewind date",
+        "operationId": "GetBucketRewind",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "date",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/rewindResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/versioning": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Bucket Versioning",
+        "operationId": "GetBucketVersioning",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketVersioningResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Set Bucket Versioning",
+        "operationId": "SetBucketVersioning",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setBucketVersioning"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{name}": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Bucket Info",
+        "operationId": "BucketInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucket"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{name}/quota": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Get Bucket Quota",
+        "operationId": "GetBucketQuota",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketQuota"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Set Bucket Hard Quota",
+        "operationId": "SetBucketQuota",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setBucketQuota"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketQuota"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Clear Bucket Quota",
+        "operationId": "DeleteBucketQuota",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/download-shared-object/{url}": {
+      "get": {
+        "security": [],
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "Public"
+        ],
+        "summary": "Downloads an object from a presigned url",
+        "operationId": "DownloadSharedObject",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "url",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/license/acknowledge": {
+      "get": {
+        "tags": [
+          "License"
+        ],
+        "summary": "Acknowledge the license",
+        "operationId": "LicenseAcknowledge",
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/login": {
+      "get": {
+        "security": [],
+        "tags": [
+          "Auth"
+        ],
+        "summary": "Returns login strategy, form or sso.",
+        "operationId": "LoginDetail",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/loginDetails"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [],
+        "tags": [
+          "Auth"
+        ],
+        "summary": "Login to Console",
+        "operationId": "Login",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/loginRequest"
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "The credentials are valid, a second factor is required to finish the login.",
+            "schema": {
+              "$ref": "#/definitions/loginSecondFactorResponse"
+            }
+          },
+          "204": {
+            "description": "A successful login."
+          },
+          "429": {
+            "description": "Too many failed logins, the client has to wait before trying again.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Seconds to wait before trying to log in again."
+              }
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/login/mfa": {
+      "post": {
+        "security": [],
+        "tags": [
+          "Auth"
+        ],
+        "summary": "Finishes a login with the code of the second factor",
+        "operationId": "LoginSecondFactor",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/secondFactorCodeRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful login."
+          },
+          "429": {
+            "description": "Too many failed logins, the client has to wait before trying again.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Seconds to wait before trying to log in again."
+              }
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/login/mfa/enroll": {
+      "post": {
+        "security": [],
+        "tags": [
+          "Auth"
+        ],
+        "summary": "Enrolls the second factor a login requires",
+        "operationId": "LoginSecondFactorEnroll",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/secondFactorEnrollment"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/login/oauth2/auth": {
+      "post": {
+        "security": [],
+        "tags": [
+          "Auth"
+        ],
+        "summary": "Identity Provider oauth2 callback endpoint.",
+        "operationId": "LoginOauth2Auth",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/loginOauth2AuthRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful login."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/logout": {
+      "post": {
+        "tags": [
+          "Auth"
+        ],
+        "summary": "Logout from Console.",
+        "operationId": "Logout",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/logoutRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/mfa": {
+      "get": {
+        "tags": [
+          "Auth"
+        ],
+        "summary": "Returns whether the current user has a second factor",
+        "operationId": "SecondFactorStatus",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/secondFactorStatus"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/mfa/confirm": {
+      "post": {
+        "tags": [
+          "Auth"
+        ],
+        "summary": "Confirms the enrollment of the second factor of the current user with a code",
+        "operationId": "SecondFactorConfirm",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/secondFactorCodeRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/mfa/disable": {
+      "post": {
+        "tags": [
+          "Auth"
+        ],
+        "summary": "Removes the second factor of the current user",
+        "operationId": "SecondFactorDisable",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/secondFactorCodeRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/mfa/enroll": {
+      "post": {
+        "tags": [
+          "Auth"
+        ],
+        "summary": "Starts the enrollment of a second factor for the current user",
+        "operationId": "SecondFactorEnroll",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/secondFactorEnrollment"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/session": {
+      "get": {
+        "tags": [
+          "Auth"
+        ],
+        "summary": "Endpoint to check if your session is still valid",
+        "operationId": "SessionCheck",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/sessionResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/session/refresh": {
+      "post": {
+        "tags": [
+          "Auth"
+        ],
+        "summary": "Renews the STS credentials of the current session before they expire",
+        "operationId": "SessionRefresh",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/sessionRefreshResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/sessions": {
+      "get": {
+        "tags": [
+          "Auth"
+        ],
+        "summary": "Lists the active console sessions of the current user",
+        "operationId": "ListActiveSessions",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listActiveSessionsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "Auth"
+        ],
+        "summary": "Revokes every console session of the current user",
+        "operationId": "RevokeActiveSessions",
+        "parameters": [
+          {
+            "type": "boolean",
+            "name": "keep_current",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/revokeActiveSessionsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/sessions/{session_id}": {
+      "delete": {
+        "tags": [
+          "Auth"
+        ],
+        "summary": "Revokes a console session of the current user",
+        "operationId": "RevokeActiveSession",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "session_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "ApiError": {
+      "type": "object",
+      "properties": {
+        "detailedMessage": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "BackendProperties": {
+      "type": "object",
+      "properties": {
+        "backendType": {
+          "type": "string"
+        },
+        "offlineDrives": {
+          "type": "integer"
+        },
+        "onlineDrives": {
+          "type": "integer"
+        },
+        "rrSCParity": {
+          "type": "integer"
+        },
+        "standardSCParity": {
+          "type": "integer"
+        }
+      }
+    },
+    "abortIncompleteUploadsRequest": {
+      "type": "object",
+      "required": [
+        "older_than_hours"
+      ],
+      "properties": {
+        "dry_run": {
+          "type": "boolean",
+          "title": "only report the uploads that would be aborted"
+        },
+        "older_than_hours": {
+          "type": "integer",
+          "format": "int32",
+          "title": "only abort uploads initiated more than this number of hours ago"
+        },
+        "prefix": {
+          "type": "string"
+        }
+      }
+    },
+    "abortIncompleteUploadsResponse": {
+      "type": "object",
+      "properties": {
+        "aborted": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/incompleteUpload"
+          }
+        },
+        "dry_run": {
+          "type": "boolean"
+        },
+        "freed_bytes": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "activeSession": {
+      "type": "object",
+      "properties": {
+        "created": {
+          "type": "string"
+        },
+        "current": {
+          "type": "boolean"
+        },
+        "expires": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "ip": {
+          "type": "string"
+        },
+        "last_seen": {
+          "type": "string"
+        },
+        "user_agent": {
+          "type": "string"
+        }
+      }
+    },
+    "addBucketReplicationRule": {
+      "type": "object",
+      "required": [
+        "priority"
+      ],
+      "properties": {
+        "access_key": {
+          "type": "string"
+        },
+        "arn": {
+          "type": "string",
+          "title": "ARN of an existing remote target, when empty a new remote target is created"
+        },
+        "bandwidth": {
+          "type": "integer",
+          "format": "int64",
+          "title": "bandwidth limit in bytes per second"
+        },
+        "delete_marker_replication": {
+          "type": "boolean"
+        },
+        "deletes_replication": {
+          "type": "boolean"
+        },
+        "disable": {
+          "type": "boolean"
+        },
+        "endpoint": {
+          "type": "string",
+          "title": "remote target URL, e.g. https://minio.example.net:9000"
+        },
+        "existing_objects": {
+          "type": "boolean"
+        },
+        "health_check_period": {
+          "type": "integer",
+          "format": "int32",
+          "title": "health check period in seconds"
+        },
+        "id": {
+          "type": "string",
+          "title": "rule ID, a random ID is generated when not provided"
+        },
+        "metadata_replication": {
+          "type": "boolean"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "priority": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "region": {
+          "type": "string"
+        },
+        "secret_key": {
+          "type": "string"
+        },
+        "storage_class": {
+          "type": "string"
+        },
+        "sync_mode": {
+          "type": "string",
+          "enum": [
+            "async",
+            "sync"
+          ]
+        },
+        "tags": {
+          "type": "string",
+          "title": "tag filter in key1=value1\u0026key2=value2 format"
+        },
+        "target_bucket": {
+          "type": "string"
+        }
+      }
+    },
+    "adminInfoResponse": {
+      "type": "object",
+      "properties": {
+        "advancedMetricsStatus": {
+          "type": "string",
+          "enum": [
+            "not configured",
+            "available",
+            "unavailable"
+          ]
+        },
+        "backend": {
+          "$ref": "#/definitions/BackendProperties"
+        },
+        "buckets": {
+          "type": "integer"
+        },
+        "objects": {
+          "type": "integer"
+        },
+        "servers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/serverProperties"
+          }
+        },
+        "usage": {
+          "type": "integer"
+        },
+        "widgets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/widget"
+          }
+        }
+      }
+    },
+    "bucket": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "access": {
+          "$ref": "#/definitions/bucketAccess"
+        },
+        "creation_date": {
+          "type": "string"
+        },
+        "definition": {
+          "type": "string"
+        },
+        "details": {
+          "type": "object",
+          "properties": {
+            "locking": {
+              "type": "boolean"
+            },
+            "quota": {
+              "type": "object",
+              "properties": {
+                "quota": {
+                  "type": "integer",
+                  "format": "int64"
+                },
+                "type": {
+                  "type": "string",
+                  "enum": [
+                    "hard"
+                  ]
+                }
+              }
+            },
+            "replication": {
+              "type": "boolean"
+            },
+            "tags": {
+              "type": "object",
+              "additionalProperties": {
+                "type": "string"
+              }
+            },
+            "versioning": {
+              "type": "boolean"
+            },
+            "versioningSuspended": {
+              "type": "boolean"
+            }
+          }
+        },
+        "name": {
+          "type": "string",
+          "minLength": 3
+        },
+        "objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "rw_access": {
+          "type": "object",
+          "properties": {
+            "read": {
+              "type": "boolean"
+            },
+            "write": {
+              "type": "boolean"
+            }
+          }
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "bucketAccess": {
+      "type": "string",
+      "default": "PRIVATE",
+      "enum": [
+        "PRIVATE",
+        "PUBLIC",
+        "CUSTOM"
+      ]
+    },
+    "bucketConfigBundle": {
+      "type": "object",
+      "properties": {
+        "bucket": {
+          "type": "string",
+          "title": "name of the exported bucket, policy resources are rewritten when importing into another bucket"
+        },
+        "encryption": {
+          "$ref": "#/definitions/bucketEncryptionSettings"
+        },
+        "lifecycle": {
+          "type": "object",
+          "title": "lifecycle configuration in the JSON format of the lifecycle export"
+        },
+        "locking": {
+          "type": "boolean"
+        },
+        "notifications": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketNotificationConfig"
+          }
+        },
+        "policy": {
+          "type": "string"
+        },
+        "quota": {
+          "type": "integer",
+          "format": "int64",
+          "title": "hard quota in bytes"
+        },
+        "retention": {
+          "$ref": "#/definitions/getBucketRetentionConfig"
+        },
+        "tags": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "versioning": {
+          "$ref": "#/definitions/setBucketVersioning"
+        }
+      }
+    },
+    "bucketConfigChange": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "string",
+          "title": "one of add, update, remove, unchanged or conflict"
+        },
+        "current": {
+          "type": "string"
+        },
+        "desired": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        },
+        "section": {
+          "type": "string"
+        }
+      }
+    },
+    "bucketConfigImportRequest": {
+      "type": "object",
+      "required": [
+        "bundle"
+      ],
+      "properties": {
+        "bundle": {
+          "$ref": "#/definitions/bucketConfigBundle"
+        },
+        "confirmationToken": {
+          "type": "string",
+          "title": "token returned by a previous request, required to apply a COMPLIANCE retention"
+        },
+        "dryRun": {
+          "type": "boolean",
+          "title": "only compute the changes without applying them"
+        },
+        "sections": {
+          "type": "array",
+          "title": "sections of the bundle to import, all of them when empty",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "bucketConfigImportResponse": {
+      "type": "object",
+      "properties": {
+        "applied": {
+          "type": "boolean"
+        },
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketConfigChange"
+          }
+        },
+        "confirmationRequired": {
+          "type": "boolean"
+        },
+        "confirmationToken": {
+          "type": "string"
+        },
+        "warning": {
+          "type": "string"
+        }
+      }
+    },
+    "bucketCorsConfiguration": {
+      "type": "object",
+      "properties": {
+        "rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/corsRule"
+          }
+        }
+      }
+    },
+    "bucketEncryptionSettings": {
+      "type": "object",
+      "required": [
+        "type"
+      ],
+      "properties": {
+        "kmsKeyID": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string",
+          "title": "sse-s3 or sse-kms"
+        }
+      }
+    },
+    "bucketLifecycleResponse": {
+      "type": "object",
+      "properties": {
+        "lifecycle": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/objectBucketLifecycle"
+          }
+        }
+      }
+    },
+    "bucketNotificationConfig": {
+      "type": "object",
+      "required": [
+        "arn"
+      ],
+      "properties": {
+        "arn": {
+          "type": "string"
+        },
+        "events": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "suffix": {
+          "type": "string"
+        }
+      }
+    },
+    "bucketObject": {
+      "type": "object",
+      "properties": {
+        "content_type": {
+          "type": "string"
+        },
+        "etag": {
+          "type": "string"
+        },
+        "expiration": {
+          "type": "string"
+        },
+        "expiration_rule_id": {
+          "type": "string"
+        },
+        "is_delete_marker": {
+          "type": "boolean"
+        },
+        "is_latest": {
+          "type": "boolean"
+        },
+        "last_modified": {
+          "type": "string"
+        },
+        "legal_hold_status": {
+          "type": "string"
+        },
+        "metadata": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "retention_mode": {
+          "type": "string"
+        },
+        "retention_until_date": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "tags": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "user_metadata": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "user_tags": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "version_id": {
+          "type": "string"
+        }
+      }
+    },
+    "bucketQuota": {
+      "type": "object",
+      "properties": {
+        "quota": {
+          "type": "integer"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "hard"
+          ]
+        }
+      }
+    },
+    "bucketReplicationDestination": {
+      "type": "object",
+      "properties": {
+        "bucket": {
+          "type": "string",
+          "title": "ARN of the remote target"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "online": {
+          "type": "boolean"
+        },
+        "region": {
+          "type": "string"
+        },
+        "sync_mode": {
+          "type": "string"
+        },
+        "target_bucket": {
+          "type": "string"
+        }
+      }
+    },
+    "bucketReplicationMetrics": {
+      "type": "object",
+      "properties": {
+        "failed_bytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "failed_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "pending_bytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "pending_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "queued_bytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "queued_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "replica_bytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "replica_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "replicated_bytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "replicated_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "targets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketReplicationTargetMetrics"
+          }
+        },
+        "uptime": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "bucketReplicationResponse": {
+      "type": "object",
+      "properties": {
+        "rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketReplicationRule"
+          }
+        }
+      }
+    },
+    "bucketReplicationRule": {
+      "type": "object",
+      "properties": {
+        "delete_marker_replication": {
+          "type": "boolean"
+        },
+        "deletes_replication": {
+          "type": "boolean"
+        },
+        "destination": {
+          "$ref": "#/definitions/bucketReplicationDestination"
+        },
+        "existing_objects": {
+          "type": "boolean"
+        },
+        "id": {
+          "type": "string"
+        },
+        "metadata_replication": {
+          "type": "boolean"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "priority": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "status": {
+          "type": "string"
+        },
+        "storage_class": {
+          "type": "string"
+        },
+        "tags": {
+          "type": "string"
+        }
+      }
+    },
+    "bucketReplicationTargetMetrics": {
+      "type": "object",
+      "properties": {
+        "arn": {
+          "type": "string"
+        },
+        "bandwidth_limit": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "current_bandwidth": {
+          "type": "number",
+          "format": "double"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "failed_bytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "failed_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "online": {
+          "type": "boolean"
+        },
+        "pending_bytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "pending_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "replicated_bytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "replicated_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "target_bucket": {
+          "type": "string"
+        }
+      }
+    },
+    "bucketSettings": {
+      "type": "object",
+      "properties": {
+        "access": {
+          "$ref": "#/definitions/bucketAccess"
+        },
+        "definition": {
+          "type": "string",
+          "title": "bucket policy applied with CUSTOM access"
+        },
+        "encryption": {
+          "$ref": "#/definitions/bucketEncryptionSettings"
+        },
+        "lifecycle": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/lifecycleRuleRequest"
+          }
+        },
+        "locking": {
+          "type": "boolean"
+        },
+        "quota": {
+          "type": "integer",
+          "format": "int64",
+          "title": "hard quota in bytes"
+        },
+        "retention": {
+          "$ref": "#/definitions/putBucketRetentionRequest"
+        },
+        "tags": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "versioning": {
+          "$ref": "#/definitions/setBucketVersioning"
+        }
+      }
+    },
+    "bucketTemplate": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "settings": {
+          "$ref": "#/definitions/bucketSettings"
+        }
+      }
+    },
+    "bucketTemplateList": {
+      "type": "object",
+      "properties": {
+        "templates": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketTemplate"
+          }
+        }
+      }
+    },
+    "bucketVersioningResponse": {
+      "type": "object",
+      "properties": {
+        "MFADelete": {
+          "type": "string"
+        },
+        "excludeFolders": {
+          "type": "boolean"
+        },
+        "excludedPrefixes": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "prefix": {
+                "type": "string"
+              }
+            }
+          }
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "corsRule": {
+      "type": "object",
+      "properties": {
+        "allowed_headers": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "allowed_methods": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "allowed_origins": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "expose_headers": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
+          "type": "string"
+        },
+        "max_age_seconds": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "corsRuleEvaluation": {
+      "type": "object",
+      "properties": {
+        "headers_allowed": {
+          "type": "boolean"
+        },
+        "id": {
+          "type": "string"
+        },
+        "method_allowed": {
+          "type": "boolean"
+        },
+        "origin_allowed": {
+          "type": "boolean"
+        },
+        "reason": {
+          "type": "string"
+        },
+        "rule": {
+          "type": "integer",
+          "format": "int32",
+          "title": "position of the rule in the configuration, starting at 1"
+        }
+      }
+    },
+    "corsTestRequest": {
+      "type": "object",
+      "required": [
+        "origin",
+        "method"
+      ],
+      "properties": {
+        "method": {
+          "type": "string"
+        },
+        "origin": {
+          "type": "string"
+        },
+        "request_headers": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "rules": {
+          "type": "array",
+          "title": "rules to evaluate instead of the bucket configuration, used to try changes before saving them",
+          "items": {
+            "$ref": "#/definitions/corsRule"
+          }
+        }
+      }
+    },
+    "corsTestResponse": {
+      "type": "object",
+      "properties": {
+        "allowed": {
+          "type": "boolean"
+        },
+        "evaluations": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/corsRuleEvaluation"
+          }
+        },
+        "explanation": {
+          "type": "string"
+        },
+        "matched_rule": {
+          "type": "integer",
+          "format": "int32",
+          "title": "position of the first matching rule starting at 1, 0 when no rule matched"
+        },
+        "response_headers": {
+          "type": "object",
+          "title": "CORS headers MinIO would send back for this request",
+          "additionalProperties": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "deleteFile": {
+      "type": "object",
+      "properties": {
+        "path": {
+          "type": "string"
+        },
+        "recursive": {
+          "type": "boolean"
+        },
+        "versionID": {
+          "type": "string"
+        }
+      }
+    },
+    "environmentConstants": {
+      "type": "object",
+      "properties": {
+        "maxConcurrentDownloads": {
+          "type": "integer"
+        },
+        "maxConcurrentUploads": {
+          "type": "integer"
+        }
+      }
+    },
+    "getBucketRetentionConfig": {
+      "type": "object",
+      "properties": {
+        "mode": {
+          "$ref": "#/definitions/objectRetentionMode"
+        },
+        "unit": {
+          "$ref": "#/definitions/objectRetentionUnit"
+        },
+        "validity": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "incompleteUpload": {
+      "type": "object",
+      "properties": {
+        "initiated": {
+          "type": "string"
+        },
+        "key": {
+          "type": "string"
+        },
+        "parts": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64",
+          "title": "size of the parts uploaded so far"
+        },
+        "upload_id": {
+          "type": "string"
+        }
+      }
+    },
+    "inventoryExportRequest": {
+      "type": "object",
+      "required": [
+        "destination_bucket"
+      ],
+      "properties": {
+        "destination_bucket": {
+          "type": "string"
+        },
+        "destination_prefix": {
+          "type": "string"
+        },
+        "format": {
+          "type": "string",
+          "title": "one of csv, jsonl or parquet, csv by default"
+        },
+        "metadata": {
+          "type": "boolean",
+          "title": "include the tags and the retention status of every object"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "versions": {
+          "type": "boolean",
+          "title": "list every object version and delete marker"
+        }
+      }
+    },
+    "inventoryExportResult": {
+      "type": "object",
+      "properties": {
+        "bucket": {
+          "type": "string"
+        },
+        "format": {
+          "type": "string"
+        },
+        "object": {
+          "type": "string"
+        },
+        "objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "lifecycleExpiration": {
+      "type": "object",
+      "properties": {
+        "date": {
+          "type": "string"
+        },
+        "days": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "delete_marker": {
+          "type": "boolean"
+        },
+        "newer_noncurrent_expiration_versions": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "noncurrent_expiration_days": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "lifecycleImportRequest": {
+      "type": "object",
+      "required": [
+        "configuration"
+      ],
+      "properties": {
+        "configuration": {
+          "type": "string"
+        },
+        "format": {
+          "type": "string",
+          "title": "configuration format, defaults to xml",
+          "enum": [
+            "xml",
+            "json"
+          ]
+        }
+      }
+    },
+    "lifecycleRuleRequest": {
+      "type": "object",
+      "properties": {
+        "abort_incomplete_multipart_days": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "disable": {
+          "type": "boolean"
+        },
+        "expired_object_delete_marker": {
+          "type": "boolean"
+        },
+        "expiry_date": {
+          "type": "string",
+          "title": "expiration date in YYYY-MM-DD format"
+        },
+        "expiry_days": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "id": {
+          "type": "string",
+          "title": "rule ID, a random ID is generated when adding a rule without one"
+        },
+        "newer_noncurrentversion_expiration_versions": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "noncurrentversion_expiration_days": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "noncurrentversion_transition_days": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "noncurrentversion_transition_storage_class": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "storage_class": {
+          "type": "string",
+          "title": "name of the configured tier objects will be transitioned to"
+        },
+        "tags": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/lifecycleTag"
+          }
+        },
+        "transition_date": {
+          "type": "string",
+          "title": "transition date in YYYY-MM-DD format"
+        },
+        "transition_days": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "lifecycleTag": {
+      "type": "object",
+      "properties": {
+        "key": {
+          "type": "string"
+        },
+        "value": {
+          "type": "string"
+        }
+      }
+    },
+    "lifecycleTransition": {
+      "type": "object",
+      "properties": {
+        "date": {
+          "type": "string"
+        },
+        "days": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "noncurrent_storage_class": {
+          "type": "string"
+        },
+        "noncurrent_transition_days": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "storage_class": {
+          "type": "string"
+        }
+      }
+    },
+    "listActiveSessionsResponse": {
+      "type": "object",
+      "properties": {
+        "sessions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/activeSession"
+          }
+        }
+      }
+    },
+    "listBucketsResponse": {
+      "type": "object",
+      "properties": {
+        "buckets": {
+          "type": "array",
+          "title": "list of resulting buckets",
+          "items": {
+            "$ref": "#/definitions/bucket"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of buckets accessible to the user matching the filters, before pagination"
+        }
+      }
+    },
+    "listIncompleteUploadsResponse": {
+      "type": "object",
+      "properties": {
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "total_size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "uploads": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/incompleteUpload"
+          }
+        }
+      }
+    },
+    "listObjectsResponse": {
+      "type": "object",
+      "properties": {
+        "objects": {
+          "type": "array",
+          "title": "list of resulting objects",
+          "items": {
+            "$ref": "#/definitions/bucketObject"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of objects"
+        }
+      }
+    },
+    "loginDetails": {
+      "type": "object",
+      "properties": {
+        "animatedLogin": {
+          "type": "boolean"
+        },
+        "isK8S": {
+          "type": "boolean"
+        },
+        "loginStrategy": {
+          "type": "string",
+          "enum": [
+            "form",
+            "ldap",
+            "service-account",
+            "redirect-service-account"
+          ]
+        },
+        "redirectRules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/redirectRule"
+          }
+        }
+      }
+    },
+    "loginOauth2AuthRequest": {
+      "type": "object",
+      "required": [
+        "state",
+        "code"
+      ],
+      "properties": {
+        "code": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string"
+        }
+      }
+    },
+    "loginRequest": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "features": {
+          "type": "object",
+          "properties": {
+            "hide_menu": {
+              "type": "boolean"
+            }
+          }
+        },
+        "secretKey": {
+          "type": "string"
+        },
+        "sts": {
+          "type": "string"
+        }
+      }
+    },
+    "loginResponse": {
+      "type": "object",
+      "properties": {
+        "IDPRefreshToken": {
+          "type": "string"
+        },
+        "secondFactorEnrollment": {
+          "type": "boolean"
+        },
+        "secondFactorToken": {
+          "type": "string"
+        },
+        "sessionId": {
+          "type": "string"
+        }
+      }
+    },
+    "loginSecondFactorResponse": {
+      "type": "object",
+      "properties": {
+        "enrollmentRequired": {
+          "type": "boolean"
+        }
+      }
+    },
+    "logoutRequest": {
+      "type": "object",
+      "properties": {
+        "state": {
+          "type": "string"
+        }
+      }
+    },
+    "makeBucketRequest": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "confirmationToken": {
+          "type": "string",
+          "title": "token returned by a previous request, required to create a bucket with COMPLIANCE retention"
+        },
+        "name": {
+          "type": "string"
+        },
+        "settings": {
+          "$ref": "#/definitions/bucketSettings"
+        },
+        "template": {
+          "type": "string",
+          "title": "name of a server configured template the bucket settings are based on"
+        }
+      }
+    },
+    "makeBucketsResponse": {
+      "type": "object",
+      "properties": {
+        "bucketName": {
+          "type": "string"
+        },
+        "confirmationRequired": {
+          "type": "boolean",
+          "title": "the bucket wasn't created, COMPLIANCE retention must be confirmed with the confirmation token"
+        },
+        "confirmationToken": {
+          "type": "string"
+        },
+        "warning": {
+          "type": "string"
+        }
+      }
+    },
+    "maxShareLinkExpResponse": {
+      "type": "object",
+      "required": [
+        "exp"
+      ],
+      "properties": {
+        "exp": {
+          "type": "number",
+          "format": "int64"
+        }
+      }
+    },
+    "metadata": {
+      "type": "object",
+      "properties": {
+        "objectMetadata": {
+          "type": "object",
+          "additionalProperties": true
+        }
+      }
+    },
+    "mirrorJob": {
+      "type": "object",
+      "properties": {
+        "bytes_per_second": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "copied_bytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "copied_objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "errors": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/mirrorJobError"
+          }
+        },
+        "eta_seconds": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "failed_objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "finished": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "newer_only": {
+          "type": "boolean"
+        },
+        "overwrite": {
+          "type": "boolean"
+        },
+        "remove_extraneous": {
+          "type": "boolean"
+        },
+        "removed_objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "skipped_objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "source_bucket": {
+          "type": "string"
+        },
+        "source_prefix": {
+          "type": "string"
+        },
+        "started": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "title": "one of planning, running, paused, canceling, completed, failed or canceled"
+        },
+        "target_bucket": {
+          "type": "string"
+        },
+        "target_prefix": {
+          "type": "string"
+        },
+        "total_bytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "total_objects": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "mirrorJobError": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "string"
+        },
+        "error": {
+          "type": "string"
+        },
+        "key": {
+          "type": "string"
+        }
+      }
+    },
+    "mirrorJobList": {
+      "type": "object",
+      "properties": {
+        "jobs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/mirrorJob"
+          }
+        }
+      }
+    },
+    "mirrorJobRequest": {
+      "type": "object",
+      "required": [
+        "target_bucket"
+      ],
+      "properties": {
+        "newer_only": {
+          "type": "boolean",
+          "title": "overwrite objects that differ only when the source is newer than the target"
+        },
+        "overwrite": {
+          "type": "boolean",
+          "title": "overwrite every object that differs in the target"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "remove_extraneous": {
+          "type": "boolean",
+          "title": "remove objects in the target that don't exist in the source"
+        },
+        "target_bucket": {
+          "type": "string"
+        },
+        "target_prefix": {
+          "type": "string"
+        }
+      }
+    },
+    "objectBucketLifecycle": {
+      "type": "object",
+      "properties": {
+        "abort_incomplete_multipart_days": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "expiration": {
+          "$ref": "#/definitions/lifecycleExpiration"
+        },
+        "id": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "tags": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/lifecycleTag"
+          }
+        },
+        "transition": {
+          "$ref": "#/definitions/lifecycleTransition"
+        }
+      }
+    },
+    "objectRetentionMode": {
+      "type": "string",
+      "enum": [
+        "governance",
+        "compliance"
+      ]
+    },
+    "objectRetentionUnit": {
+      "type": "string",
+      "enum": [
+        "days",
+        "years"
+      ]
+    },
+    "objectVersion": {
+      "type": "object",
+      "properties": {
+        "etag": {
+          "type": "string"
+        },
+        "is_delete_marker": {
+          "type": "boolean"
+        },
+        "is_latest": {
+          "type": "boolean"
+        },
+        "last_modified": {
+          "type": "string"
+        },
+        "legal_hold_status": {
+          "type": "string"
+        },
+        "retention_mode": {
+          "type": "string"
+        },
+        "retention_until_date": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "version_id": {
+          "type": "string"
+        },
+        "writer": {
+          "type": "string",
+          "title": "who wrote the version, taken from the object metadata"
+        }
+      }
+    },
+    "objectVersionHistory": {
+      "type": "object",
+      "properties": {
+        "delete_markers": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "name": {
+          "type": "string"
+        },
+        "noncurrent_bytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "total_bytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "total_versions": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "versions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/objectVersion"
+          }
+        }
+      }
+    },
+    "permissionResource": {
+      "type": "object",
+      "properties": {
+        "conditionOperator": {
+          "type": "string"
+        },
+        "prefixes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "resource": {
+          "type": "string"
+        }
+      }
+    },
+    "principal": {
+      "type": "object",
+      "properties": {
+        "STSAccessKeyID": {
+          "type": "string"
+        },
+        "STSSecretAccessKey": {
+          "type": "string"
+        },
+        "STSSessionToken": {
+          "type": "string"
+        },
+        "accountAccessKey": {
+          "type": "string"
+        },
+        "customStyleOb": {
+          "type": "string"
+        },
+        "hm": {
+          "type": "boolean"
+        },
+        "lastActivity": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "ob": {
+          "type": "boolean"
+        },
+        "sessionExpiration": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "sessionId": {
+          "type": "string"
+        },
+        "stsExpiration": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "pruneObjectVersionsRequest": {
+      "type": "object",
+      "required": [
+        "prefix"
+      ],
+      "properties": {
+        "dry_run": {
+          "type": "boolean",
+          "title": "only report the versions that would be pruned"
+        },
+        "keep_latest": {
+          "type": "integer",
+          "format": "int32",
+          "title": "number of most recent versions kept, including the current one"
+        },
+        "older_than_days": {
+          "type": "integer",
+          "format": "int32",
+          "title": "only prune versions last modified more than this number of days ago"
+        },
+        "prefix": {
+          "type": "string"
+        }
+      }
+    },
+    "pruneObjectVersionsResponse": {
+      "type": "object",
+      "properties": {
+        "dry_run": {
+          "type": "boolean"
+        },
+        "freed_bytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "pruned": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/objectVersion"
+          }
+        },
+        "skipped": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/skippedObjectVersion"
+          }
+        }
+      }
+    },
+    "putBucketRetentionRequest": {
+      "type": "object",
+      "required": [
+        "mode",
+        "unit",
+        "validity"
+      ],
+      "properties": {
+        "confirmationToken": {
+          "type": "string",
+          "title": "token returned by a previous request, required to apply compliance mode"
+        },
+        "mode": {
+          "$ref": "#/definitions/objectRetentionMode"
+        },
+        "unit": {
+          "$ref": "#/definitions/objectRetentionUnit"
+        },
+        "validity": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "putBucketRetentionResponse": {
+      "type": "object",
+      "properties": {
+        "applied": {
+          "type": "boolean"
+        },
+        "confirmationRequired": {
+          "type": "boolean"
+        },
+        "confirmationToken": {
+          "type": "string"
+        },
+        "warning": {
+          "type": "string"
+        }
+      }
+    },
+    "putObjectTagsRequest": {
+      "type": "object",
+      "properties": {
+        "tags": {
+          "additionalProperties": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "redirectRule": {
+      "type": "object",
+      "properties": {
+        "displayName": {
+          "type": "string"
+        },
+        "redirect": {
+          "type": "string"
+        },
+        "serviceType": {
+          "type": "string"
+        }
+      }
+    },
+    "resultTarget": {
+      "type": "object",
+      "properties": {
+        "legendFormat": {
+          "type": "string"
+        },
+        "result": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/widgetResult"
+          }
+        },
+        "resultType": {
+          "type": "string"
+        }
+      }
+    },
+    "revokeActiveSessionsResponse": {
+      "type": "object",
+      "properties": {
+        "revoked": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "rewindItem": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "string"
+        },
+        "delete_flag": {
+          "type": "boolean"
+        },
+        "is_latest": {
+          "type": "boolean"
+        },
+        "last_modified": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "version_id": {
+          "type": "string"
+        }
+      }
+    },
+    "rewindResponse": {
+      "type": "object",
+      "properties": {
+        "objects": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/rewindItem"
+          }
+        }
+      }
+    },
+    "secondFactorCodeRequest": {
+      "type": "object",
+      "required": [
+        "code"
+      ],
+      "properties": {
+        "code": {
+          "type": "string"
+        }
+      }
+    },
+    "secondFactorEnrollment": {
+      "type": "object",
+      "properties": {
+        "provisioningUri": {
+          "type": "string"
+        },
+        "recoveryCodes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "secret": {
+          "type": "string"
+        }
+      }
+    },
+    "secondFactorStatus": {
+      "type": "object",
+      "properties": {
+        "available": {
+          "type": "boolean"
+        },
+        "enrolled": {
+          "type": "boolean"
+        },
+        "required": {
+          "type": "boolean"
+        }
+      }
+    },
+    "serverDrives": {
+      "type": "object",
+      "properties": {
+        "availableSpace": {
+          "type": "integer"
+        },
+        "drivePath": {
+          "type": "string"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "healing": {
+          "type": "boolean"
+        },
+        "model": {
+          "type": "string"
+        },
+        "rootDisk": {
+          "type": "boolean"
+        },
+        "state": {
+          "type": "string"
+        },
+        "totalSpace": {
+          "type": "integer"
+        },
+        "usedSpace": {
+          "type": "integer"
+        },
+        "uuid": {
+          "type": "string"
+        }
+      }
+    },
+    "serverProperties": {
+      "type": "object",
+      "properties": {
+        "commitID": {
+          "type": "string"
+        },
+        "drives": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/serverDrives"
+          }
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "network": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "poolNumber": {
+          "type": "integer"
+        },
+        "state": {
+          "type": "string"
+        },
+        "uptime": {
+          "type": "integer"
+        },
+        "version": {
+          "type": "string"
+        }
+      }
+    },
+    "sessionRefreshResponse": {
+      "type": "object",
+      "properties": {
+        "expires": {
+          "type": "string"
+        },
+        "maxExpires": {
+          "type": "string"
+        }
+      }
+    },
+    "sessionResponse": {
+      "type": "object",
+      "properties": {
+        "allowResources": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/permissionResource"
+          }
+        },
+        "customStyles": {
+          "type": "string"
+        },
+        "distributedMode": {
+          "type": "boolean"
+        },
+        "envConstants": {
+          "$ref": "#/definitions/environmentConstants"
+        },
+        "features": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "operator": {
+          "type": "boolean"
+        },
+        "permissions": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "array",
+            "items": {
+              "type": "string"
+            }
+          }
+        },
+        "serverEndPoint": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "ok"
+          ]
+        }
+      }
+    },
+    "setBucketQuota": {
+      "type": "object",
+      "required": [
+        "quota"
+      ],
+      "properties": {
+        "quota": {
+          "type": "integer",
+          "format": "int64",
+          "title": "hard quota in bytes"
+        }
+      }
+    },
+    "setBucketVersioning": {
+      "type": "object",
+      "properties": {
+        "enabled": {
+          "type": "boolean"
+        },
+        "excludeFolders": {
+          "type": "boolean"
+        },
+        "excludePrefixes": {
+          "type": "array",
+          "maxLength": 10,
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "skippedObjectVersion": {
+      "type": "object",
+      "properties": {
+        "reason": {
+          "type": "string"
+        },
+        "version_id": {
+          "type": "string"
+        }
+      }
+    },
+    "widget": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "options": {
+          "type": "object",
+          "properties": {
+            "reduceOptions": {
+              "type": "object",
+              "properties": {
+                "calcs": {
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                }
+              }
+            }
+          }
+        },
+        "targets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/resultTarget"
+          }
+        },
+        "title": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        }
+      }
+    },
+    "widgetResult": {
+      "type": "object",
+      "properties": {
+        "metric": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "values": {
+          "type": "array",
+          "items": {}
+        }
+      }
+    }
+  },
+  "parameters": {
+    "limit": {
+      "type": "number",
+      "format": "int32",
+      "default": 20,
+      "name": "limit",
+      "in": "query"
+    },
+    "offset": {
+      "type": "number",
+      "format": "int32",
+      "default": 0,
+      "name": "offset",
+      "in": "query"
+    }
+  },
+  "securityDefinitions": {
+    "anonymous": {
+      "type": "apiKey",
+      "name": "X-Anonymous",
+      "in": "header"
+    },
+    "key": {
+      "type": "oauth2",
+      "flow": "accessCode",
+      "authorizationUrl": "http://min.io",
+      "tokenUrl": "http://min.io"
+    }
+  },
+  "security": [
+    {
+      "key": []
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http",
+    "ws"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "title": "MinIO Console Server",
+    "version": "0.1.0"
+  },
+  "basePath": "/api/v1",
+  "paths": {
+    "/admin/info": {
+      "get": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Returns information about the deployment",
+        "operationId": "AdminInfo",
+        "parameters": [
+          {
+            "type": "boolean",
+            "default": false,
+            "name": "defaultOnly",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/adminInfoResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/bucket-templates": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "List the bucket templates configured in the server",
+        "operationId": "ListBucketTemplates",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketTemplateList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "List Buckets",
+        "operationId": "ListBuckets",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "only list buckets whose name starts with prefix",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "only list buckets whose name contains search, case insensitive",
+            "name": "search",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "only list buckets with every tag of a comma separated list of key or key=value",
+            "name": "tags",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "one of name, size, objects or created, name by default",
+            "name": "sort_by",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "asc or desc, asc by default",
+            "name": "sort_order",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "description": "maximum number of buckets returned, all of them when empty",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listBucketsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Make bucket",
+        "operationId": "MakeBucket",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/makeBucketRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/makeBucketsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/max-share-exp": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Get max expiration time for share link in seconds",
+        "operationId": "GetMaxShareLinkExp",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/maxShareLinkExpResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/mirror-jobs": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "List the mirror jobs started by the current user",
+        "operationId": "ListMirrorJobs",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/mirrorJobList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/mirror-jobs/{job_id}": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Get the progress or the final report of a mirror job",
+        "operationId": "GetMirrorJob",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "job_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/mirrorJob"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Cancel a running mirror job or remove a finished one",
+        "operationId": "DeleteMirrorJob",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "job_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/mirror-jobs/{job_id}/pause": {
+      "post": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Pause a running mirror job",
+        "operationId": "PauseMirrorJob",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "job_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/mirrorJob"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/mirror-jobs/{job_id}/resume": {
+      "post": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Resume a paused mirror job",
+        "operationId": "ResumeMirrorJob",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "job_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/mirrorJob"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/config-export": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Export the full configuration of a bucket as a single document",
+        "operationId": "ExportBucketConfig",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketConfigBundle"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/config-import": {
+      "post": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Preview or apply a bucket configuration bundle",
+        "operationId": "ImportBucketConfig",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketConfigImportRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketConfigImportResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/cors": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Bucket CORS Configuration",
+        "operationId": "GetBucketCors",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketCorsConfiguration"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Set Bucket CORS Configuration",
+        "operationId": "SetBucketCors",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketCorsConfiguration"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Remove Bucket CORS Configuration",
+        "operationId": "DeleteBucketCors",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/cors-test": {
+      "post": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Evaluate a cross-origin request against the Bucket CORS rules",
+        "operationId": "TestBucketCors",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/corsTestRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/corsTestResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/delete-objects": {
+      "post": {
+        "tags": [
+          "Object"
+        ],
+        "summary": "Delete Multiple Objects",
+        "operationId": "DeleteMultipleObjects",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "name": "all_versions",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "bypass",
+            "in": "query"
+          },
+          {
+            "name": "files",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/deleteFile"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/diff": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Download the differences between a bucket prefix and a target location as CSV",
+        "operationId": "DownloadBucketDiff",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "target_bucket",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "target_prefix",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/incomplete-uploads": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Lists the incomplete multipart uploads of a bucket",
+        "operationId": "ListIncompleteUploads",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listIncompleteUploadsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Aborts an incomplete multipart upload",
+        "operationId": "AbortIncompleteUpload",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "key",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "upload_id",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/incomplete-uploads/abort": {
+      "post": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Aborts the incomplete multipart uploads started before a given age",
+        "operationId": "AbortIncompleteUploads",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/abortIncompleteUploadsRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/abortIncompleteUploadsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/inventory": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Download an inventory of the objects in a bucket",
+        "operationId": "DownloadBucketInventory",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "one of csv, jsonl or parquet, csv by default",
+            "name": "format",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "versions",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "metadata",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Write an inventory of the objects in a bucket into a destination bucket",
+        "operationId": "ExportBucketInventory",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/inventoryExportRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/inventoryExportResult"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/lifecycle": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Bucket Lifecycle",
+        "operationId": "GetBucketLifecycle",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketLifecycleResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Add Bucket Lifecycle",
+        "operationId": "AddBucketLifecycle",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/lifecycleRuleRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/lifecycle-export": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Export Bucket Lifecycle configuration as XML or JSON",
+        "operationId": "ExportBucketLifecycle",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "default": "xml",
+            "name": "format",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/lifecycle-import": {
+      "post": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Replace Bucket Lifecycle configuration with an XML or JSON document",
+        "operationId": "ImportBucketLifecycle",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/lifecycleImportRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/lifecycle/{lifecycle_id}": {
+      "put": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Update Lifecycle rule",
+        "operationId": "UpdateBucketLifecycle",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "lifecycle_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/lifecycleRuleRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Delete Lifecycle rule",
+        "operationId": "DeleteBucketLifecycleRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "lifecycle_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/mirror": {
+      "post": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Start a job mirroring a bucket prefix into a target location",
+        "operationId": "StartBucketMirror",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/mirrorJobRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/mirrorJob"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/objects": {
+      "get": {
+        "security": [
+          {
+            "key": []
+          },
+          {
+            "anonymous": []
+          }
+        ],
+        "tags": [
+          "Object"
+        ],
+        "summary": "List Objects",
+        "operationId": "ListObjects",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "recursive",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "with_versions",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "with_metadata",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int32",
+            "default": 20,
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listObjectsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "Object"
+        ],
+        "summary": "Delete Object",
+        "operationId": "DeleteObject",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "recursive",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "all_versions",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "non_current_versions",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "bypass",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
             }
           }
-        },
-        "size": {
-          "type": "integer",
-          "format": "int64"
         }
       }
     },
-    "bucketAccess": {
-      "type": "string",
-      "default": "PRIVATE",
-      "enum": [
-        "PRIVATE",
-        "PUBLIC",
-        "CUSTOM"
-      ]
-    },
-    "bucketObject": {
-      "type": "object",
-      "properties": {
-        "content_type": {
-          "type": "string"
-        },
-        "etag": {
-          "type": "string"
-        },
-        "expiration": {
-          "type": "string"
-        },
-        "expiration_rule_id": {
-          "type": "string"
-        },
-        "is_delete_marker": {
-          "type": "boolean"
-        },
-        "is_latest": {
-          "type": "boolean"
-        },
-        "last_modified": {
-          "type": "string"
-        },
-        "legal_hold_status": {
-          "type": "string"
-        },
-        "metadata": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
-          }
-        },
-        "name": {
-          "type": "string"
-        },
-        "retention_mode": {
-          "type": "string"
-        },
-        "retention_until_date": {
-          "type": "string"
-        },
-        "size": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "tags": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
+    "/buckets/{bucket_name}/objects/download": {
+      "get": {
+        "security": [
+          {
+            "key": []
+          },
+          {
+            "anonymous": []
           }
-        },
-        "user_metadata": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
+        ],
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "Object"
+        ],
+        "summary": "Download Object",
+        "operationId": "Download Object",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "name": "preview",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "default": "",
+            "name": "override_file_name",
+            "in": "query"
           }
-        },
-        "user_tags": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
-        },
-        "version_id": {
-          "type": "string"
-        }
-      }
-    },
-    "bucketQuota": {
-      "type": "object",
-      "properties": {
-        "quota": {
-          "type": "integer"
-        },
-        "type": {
-          "type": "string",
-          "enum": [
-            "hard"
-          ]
         }
       }
     },
-    "bucketVersioningResponse": {
-      "type": "object",
-      "properties": {
-        "MFADelete": {
-          "type": "string"
-        },
-        "excludeFolders": {
-          "type": "boolean"
-        },
-        "excludedPrefixes": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "prefix": {
+    "/buckets/{bucket_name}/objects/download-multiple": {
+      "post": {
+        "security": [
+          {
+            "key": []
+          },
+          {
+            "anonymous": []
+          }
+        ],
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "Object"
+        ],
+        "summary": "Download Multiple Objects",
+        "operationId": "DownloadMultipleObjects",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "objectList",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
                 "type": "string"
               }
             }
           }
-        },
-        "status": {
-          "type": "string"
-        }
-      }
-    },
-    "deleteFile": {
-      "type": "object",
-      "properties": {
-        "path": {
-          "type": "string"
-        },
-        "recursive": {
-          "type": "boolean"
-        },
-        "versionID": {
-          "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
         }
       }
     },
-    "environmentConstants": {
-      "type": "object",
-      "properties": {
-        "maxConcurrentDownloads": {
-          "type": "integer"
-        },
-        "maxConcurrentUploads": {
-          "type": "integer"
+    "/buckets/{bucket_name}/objects/metadata": {
+      "get": {
+        "tags": [
+          "Object"
+        ],
+        "summary": "Gets the metadata of an object",
+        "operationId": "GetObjectMetadata",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "versionID",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/metadata"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
         }
       }
     },
-    "getBucketRetentionConfig": {
-      "type": "object",
-      "properties": {
-        "mode": {
-          "$ref": "#/definitions/objectRetentionMode"
-        },
-        "unit": {
-          "$ref": "#/definitions/objectRetentionUnit"
-        },
-        "validity": {
-          "type": "integer",
-          "format": "int32"
+    "/buckets/{bucket_name}/objects/restore": {
+      "put": {
+        "tags": [
+          "Object"
+        ],
+        "summary": "Restore Object to a selected version",
+        "operationId": "PutObjectRestore",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
         }
       }
     },
-    "listBucketsResponse": {
-      "type": "object",
-      "properties": {
-        "buckets": {
-          "type": "array",
-          "title": "list of resulting buckets",
-          "items": {
-            "$ref": "#/definitions/bucket"
+    "/buckets/{bucket_name}/objects/share": {
+      "get": {
+        "tags": [
+          "Object"
+        ],
+        "summary": "Shares an Object on a url",
+        "operationId": "ShareObject",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "expires",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
-        },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "number of buckets accessible to the user"
         }
       }
     },
-    "listObjectsResponse": {
-      "type": "object",
-      "properties": {
-        "objects": {
-          "type": "array",
-          "title": "list of resulting objects",
-          "items": {
-            "$ref": "#/definitions/bucketObject"
+    "/buckets/{bucket_name}/objects/tags": {
+      "put": {
+        "tags": [
+          "Object"
+        ],
+        "summary": "Put Object's tags",
+        "operationId": "PutObjectTags",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putObjectTagsRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
-        },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "number of objects"
         }
       }
     },
-    "loginDetails": {
-      "type": "object",
-      "properties": {
-        "animatedLogin": {
-          "type": "boolean"
-        },
-        "isK8S": {
-          "type": "boolean"
-        },
-        "loginStrategy": {
-          "type": "string",
-          "enum": [
-            "form",
-            "service-account",
-            "redirect-service-account"
-          ]
-        },
-        "redirectRules": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/redirectRule"
+    "/buckets/{bucket_name}/objects/upload": {
+      "post": {
+        "security": [
+          {
+            "key": []
+          },
+          {
+            "anonymous": []
           }
-        }
-      }
-    },
-    "loginRequest": {
-      "type": "object",
-      "properties": {
-        "accessKey": {
-          "type": "string"
-        },
-        "features": {
-          "type": "object",
-          "properties": {
-            "hide_menu": {
-              "type": "boolean"
+        ],
+        "consumes": [
+          "multipart/form-data"
+        ],
+        "tags": [
+          "Object"
+        ],
+        "summary": "Uploads an Object.",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
             }
           }
-        },
-        "secretKey": {
-          "type": "string"
-        },
-        "sts": {
-          "type": "string"
-        }
-      }
-    },
-    "loginResponse": {
-      "type": "object",
-      "properties": {
-        "IDPRefreshToken": {
-          "type": "string"
-        },
-        "sessionId": {
-          "type": "string"
-        }
-      }
-    },
-    "logoutRequest": {
-      "type": "object",
-      "properties": {
-        "state": {
-          "type": "string"
-        }
-      }
-    },
-    "makeBucketRequest": {
-      "type": "object",
-      "required": [
-        "name"
-      ],
-      "properties": {
-        "name": {
-          "type": "string"
         }
       }
     },
-    "makeBucketsResponse": {
-      "type": "object",
-      "properties": {
-        "bucketName": {
-          "type": "string"
+    "/buckets/{bucket_name}/objects/versions": {
+      "get": {
+        "tags": [
+          "Object"
+        ],
+        "summary": "Gets the full version history of an object",
+        "operationId": "GetObjectVersionHistory",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/objectVersionHistory"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
         }
       }
     },
-    "maxShareLinkExpResponse": {
-      "type": "object",
-      "required": [
-        "exp"
-      ],
-      "properties": {
-        "exp": {
-          "type": "number",
-          "format": "int64"
+    "/buckets/{bucket_name}/objects/versions/prune": {
+      "post": {
+        "tags": [
+          "Object"
+        ],
+        "summary": "Deletes the noncurrent versions of an object that are no longer needed",
+        "operationId": "PruneObjectVersions",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/pruneObjectVersionsRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/pruneObjectVersionsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
         }
       }
     },
-    "metadata": {
-      "type": "object",
-      "properties": {
-        "objectMetadata": {
-          "type": "object",
-          "additionalProperties": true
+    "/buckets/{bucket_name}/replication": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Bucket Replication Rules",
+        "operationId": "GetBucketReplication",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketReplicationResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
         }
-      }
-    },
-    "objectRetentionMode": {
-      "type": "string",
-      "enum": [
-        "governance",
-        "compliance"
-      ]
-    },
-    "objectRetentionUnit": {
-      "type": "string",
-      "enum": [
-        "days",
-        "years"
-      ]
-    },
-    "permissionResource": {
-      "type": "object",
-      "properties": {
-        "conditionOperator": {
-          "type": "string"
-        },
-        "prefixes": {
-          "type": "array",
-          "items": {
-            "type": "string"
+      },
+      "post": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Add Bucket Replication Rule",
+        "operationId": "AddBucketReplicationRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/addBucketReplicationRule"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
-        },
-        "resource": {
-          "type": "string"
-        }
-      }
-    },
-    "principal": {
-      "type": "object",
-      "properties": {
-        "STSAccessKeyID": {
-          "type": "string"
-        },
-        "STSSecretAccessKey": {
-          "type": "string"
-        },
-        "STSSessionToken": {
-          "type": "string"
-        },
-        "accountAccessKey": {
-          "type": "string"
-        },
-        "customStyleOb": {
-          "type": "string"
-        },
-        "hm": {
-          "type": "boolean"
-        },
-        "ob": {
-          "type": "boolean"
         }
       }
     },
-    "putObjectTagsRequest": {
-      "type": "object",
-      "properties": {
-        "tags": {
-          "additionalProperties": {
-            "type": "string"
+    "/buckets/{bucket_name}/replication-metrics": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Bucket Replication Status and Metrics",
+        "operationId": "GetBucketReplicationMetrics",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketReplicationMetrics"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
         }
       }
     },
-    "redirectRule": {
-      "type": "object",
-      "properties": {
-        "displayName": {
-          "type": "string"
-        },
-        "redirect": {
-          "type": "string"
-        },
-        "serviceType": {
-          "type": "string"
+    "/buckets/{bucket_name}/replication/{rule_id}": {
+      "delete": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Remove Bucket Replication Rule",
+        "operationId": "DeleteBucketReplicationRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "rule_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "description": "also remove the remote target of the rule unless another rule replicates to it",
+            "name": "remove_target",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
         }
       }
     },
-    "resultTarget": {
-      "type": "object",
-      "properties": {
-        "legendFormat": {
-          "type": "string"
-        },
-        "result": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/widgetResult"
+    "/buckets/{bucket_name}/retention": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Get Bucket's default retention configuration",
+        "operationId": "GetBucketRetentionConfig",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/getBucketRetentionConfig"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
-        },
-        "resultType": {
-          "type": "string"
         }
-      }
-    },
-    "rewindItem": {
-      "type": "object",
-      "properties": {
-        "action": {
-          "type": "string"
-        },
-        "delete_flag": {
-          "type": "boolean"
-        },
-        "is_latest": {
-          "type": "boolean"
-        },
-        "last_modified": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
-        },
-        "size": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "version_id": {
-          "type": "string"
+      },
+      "put": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Set Bucket's default retention configuration",
+        "operationId": "SetBucketRetentionConfig",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putBucketRetentionRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/putBucketRetentionResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
         }
       }
     },
-    "rewindResponse": {
-      "type": "object",
-      "properties": {
-        "objects": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/rewindItem"
+    "/buckets/{bucket_name}/rewind/{date}": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Get objects in a bucket for a rewind date",
+        "operationId": "GetBucketRewind",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "date",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/rewindResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
         }
       }
     },
-    "serverDrives": {
-      "type": "object",
-      "properties": {
-        "availableSpace": {
-          "type": "integer"
-        },
-        "drivePath": {
-          "type": "string"
-        },
-        "endpoint": {
-          "type": "string"
-        },
-        "healing": {
-          "type": "boolean"
-        },
-        "model": {
-          "type": "string"
-        },
-        "rootDisk": {
-          "type": "boolean"
-        },
-        "state": {
-          "type": "string"
-        },
-        "totalSpace": {
-          "type": "integer"
-        },
-        "usedSpace": {
-          "type": "integer"
-        },
-        "uuid": {
-          "type": "string"
+    "/buckets/{bucket_name}/versioning": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Bucket Versioning",
+        "operationId": "GetBucketVersioning",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketVersioningResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Set Bucket Versioning",
+        "operationId": "SetBucketVersioning",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setBucketVersioning"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
         }
       }
     },
-    "serverProperties": {
-      "type": "object",
-      "properties": {
-        "commitID": {
-          "type": "string"
-        },
-        "drives": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/serverDrives"
+    "/buckets/{name}": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Bucket Info",
+        "operationId": "BucketInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
           }
-        },
-        "endpoint": {
-          "type": "string"
-        },
-        "network": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucket"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
-        },
-        "poolNumber": {
-          "type": "integer"
-        },
-        "state": {
-          "type": "string"
-        },
-        "uptime": {
-          "type": "integer"
-        },
-        "version": {
-          "type": "string"
         }
       }
     },
-    "sessionResponse": {
-      "type": "object",
-      "properties": {
-        "allowResources": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/permissionResource"
+    "/buckets/{name}/quota": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Get Bucket Quota",
+        "operationId": "GetBucketQuota",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
           }
-        },
-        "customStyles": {
-          "type": "string"
-        },
-        "distributedMode": {
-          "type": "boolean"
-        },
-        "envConstants": {
-          "$ref": "#/definitions/environmentConstants"
-        },
-        "features": {
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketQuota"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
-        },
-        "operator": {
-          "type": "boolean"
-        },
-        "permissions": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "array",
-            "items": {
-              "type": "string"
+        }
+      },
+      "put": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Set Bucket Hard Quota",
+        "operationId": "SetBucketQuota",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setBucketQuota"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketQuota"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Clear Bucket Quota",
+        "operationId": "DeleteBucketQuota",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
             }
           }
-        },
-        "serverEndPoint": {
-          "type": "string"
-        },
-        "status": {
-          "type": "string",
-          "enum": [
-            "ok"
-          ]
         }
       }
     },
-    "setBucketVersioning": {
-      "type": "object",
-      "properties": {
-        "enabled": {
-          "type": "boolean"
-        },
-        "excludeFolders": {
-          "type": "boolean"
-        },
-        "excludePrefixes": {
-          "type": "array",
-          "maxLength": 10,
-          "items": {
-            "type": "string"
+    "/download-shared-object/{url}": {
+      "get": {
+        "security": [],
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "Public"
+        ],
+        "summary": "Downloads an object from a presigned url",
+        "operationId": "DownloadSharedObject",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "url",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
         }
       }
     },
-    "widget": {
-      "type": "object",
-      "properties": {
-        "id": {
-          "type": "integer",
-          "format": "int32"
-        },
-        "options": {
-          "type": "object",
-          "properties": {
-            "reduceOptions": {
-              "type": "object",
-              "properties": {
-                "calcs": {
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  }
-                }
-              }
+    "/license/acknowledge": {
+      "get": {
+        "tags": [
+          "License"
+        ],
+        "summary": "Acknowledge the license",
+        "operationId": "LicenseAcknowledge",
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
             }
           }
-        },
-        "targets": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/resultTarget"
-          }
-        },
-        "title": {
-          "type": "string"
-        },
-        "type": {
-          "type": "string"
         }
       }
     },
-    "widgetResult": {
-      "type": "object",
-      "properties": {
-        "metric": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
+    "/login": {
+      "get": {
+        "security": [],
+        "tags": [
+          "Auth"
+        ],
+        "summary": "Returns login strategy, form or sso.",
+        "operationId": "LoginDetail",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/loginDetails"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [],
+        "tags": [
+          "Auth"
+        ],
+        "summary": "Login to Console",
+        "operationId": "Login",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/loginRequest"
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "The credentials are valid, a second factor is required to finish the login.",
+            "schema": {
+              "$ref": "#/definitions/loginSecondFactorResponse"
+            }
+          },
+          "204": {
+            "description": "A successful login."
+          },
+          "429": {
+            "description": "Too many failed logins, the client has to wait before trying again.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Seconds to wait before trying to log in again."
+              }
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
-        },
-        "values": {
-          "type": "array",
-          "items": {}
         }
       }
-    }
-  },
-  "parameters": {
-    "limit": {
-      "type": "number",
-      "format": "int32",
-      "default": 20,
-      "name": "limit",
-      "in": "query"
-    },
-    "offset": {
-      "type": "number",
-      "format": "int32",
-      "default": 0,
-      "name": "offset",
-      "in": "query"
-    }
-  },
-  "securityDefinitions": {
-    "anonymous": {
-      "type": "apiKey",
-      "name": "X-Anonymous",
-      "in": "header"
     },
-    "key": {
-      "type": "oauth2",
-      "flow": "accessCode",
-      "authorizationUrl": "http://min.io",
-      "tokenUrl": "http://min.io"
-    }
-  },
-  "security": [
-    {
-      "key": []
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http",
-    "ws"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "title": "MinIO Console Server",
-    "version": "0.1.0"
-  },
-  "basePath": "/api/v1",
-  "paths": {
-    "/admin/info": {
-      "get": {
+    "/login/mfa": {
+      "post": {
+        "security": [],
         "tags": [
-          "System"
+          "Auth"
         ],
-        "summary": "Returns information about the deployment",
-        "operationId": "AdminInfo",
+        "summary": "Finishes a login with the code of the second factor",
+        "operationId": "LoginSecondFactor",
         "parameters": [
           {
-            "type": "boolean",
-            "default": false,
-            "name": "defaultOnly",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/secondFactorCodeRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful login."
+          },
+          "429": {
+            "description": "Too many failed logins, the client has to wait before trying again.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Seconds to wait before trying to log in again."
+              }
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
+        }
+      }
+    },
+    "/login/mfa/enroll": {
+      "post": {
+        "security": [],
+        "tags": [
+          "Auth"
         ],
+        "summary": "Enrolls the second factor a login requires",
+        "operationId": "LoginSecondFactorEnroll",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/adminInfoResponse"
+              "$ref": "#/definitions/secondFactorEnrollment"
             }
           },
           "default": {
@@ -1850,19 +7049,27 @@ func init() {
         }
       }
     },
-    "/buckets": {
-      "get": {
+    "/login/oauth2/auth": {
+      "post": {
+        "security": [],
         "tags": [
-          "Bucket"
+          "Auth"
         ],
-        "summary": "List Buckets",
-        "operationId": "ListBuckets",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
+        "summary": "Identity Provider oauth2 callback endpoint.",
+        "operationId": "LoginOauth2Auth",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/listBucketsResponse"
+              "$ref": "#/definitions/loginOauth2AuthRequest"
             }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful login."
           },
           "default": {
             "description": "Generic error response.",
@@ -1871,29 +7078,28 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/logout": {
       "post": {
         "tags": [
-          "Bucket"
+          "Auth"
         ],
-        "summary": "Make bucket",
-        "operationId": "MakeBucket",
+        "summary": "Logout from Console.",
+        "operationId": "Logout",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/makeBucketRequest"
+              "$ref": "#/definitions/logoutRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/makeBucketsResponse"
-            }
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -1904,18 +7110,18 @@ func init() {
         }
       }
     },
-    "/buckets/max-share-exp": {
+    "/mfa": {
       "get": {
         "tags": [
-          "Bucket"
+          "Auth"
         ],
-        "summary": "Get max expiration time for share link in seconds",
-        "operationId": "GetMaxShareLinkExp",
+        "summary": "Returns whether the current user has a second factor",
+        "operationId": "SecondFactorStatus",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/maxShareLinkExpResponse"
+              "$ref": "#/definitions/secondFactorStatus"
             }
           },
           "default": {
@@ -1927,44 +7133,25 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/delete-objects": {
+    "/mfa/confirm": {
       "post": {
         "tags": [
-          "Object"
+          "Auth"
         ],
-        "summary": "Delete Multiple Objects",
-        "operationId": "DeleteMultipleObjects",
+        "summary": "Confirms the enrollment of the second factor of the current user with a code",
+        "operationId": "SecondFactorConfirm",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "boolean",
-            "name": "all_versions",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "bypass",
-            "in": "query"
-          },
-          {
-            "name": "files",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/deleteFile"
-              }
+              "$ref": "#/definitions/secondFactorCodeRequest"
             }
           }
         ],
         "responses": {
-          "200": {
+          "204": {
             "description": "A successful response."
           },
           "default": {
@@ -1976,62 +7163,26 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects": {
-      "get": {
-        "security": [
-          {
-            "key": []
-          },
-          {
-            "anonymous": []
-          }
-        ],
+    "/mfa/disable": {
+      "post": {
         "tags": [
-          "Object"
+          "Auth"
         ],
-        "summary": "List Objects",
-        "operationId": "ListObjects",
+        "summary": "Removes the second factor of the current user",
+        "operationId": "SecondFactorDisable",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "recursive",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "with_versions",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "with_metadata",
-            "in": "query"
-          },
-          {
-            "type": "number",
-            "format": "int32",
-            "default": 20,
-            "name": "limit",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/secondFactorCodeRequest"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/listObjectsResponse"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -2040,55 +7191,21 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/mfa/enroll": {
+      "post": {
         "tags": [
-          "Object"
-        ],
-        "summary": "Delete Object",
-        "operationId": "DeleteObject",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "recursive",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "all_versions",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "non_current_versions",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "bypass",
-            "in": "query"
-          }
+          "Auth"
         ],
+        "summary": "Starts the enrollment of a second factor for the current user",
+        "operationId": "SecondFactorEnroll",
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/secondFactorEnrollment"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -2099,60 +7216,41 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/download": {
+    "/session": {
       "get": {
-        "security": [
-          {
-            "key": []
-          },
-          {
-            "anonymous": []
-          }
-        ],
-        "produces": [
-          "application/octet-stream"
-        ],
         "tags": [
-          "Object"
+          "Auth"
         ],
-        "summary": "Download Object",
-        "operationId": "Download Object",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "default": false,
-            "name": "preview",
-            "in": "query"
+        "summary": "Endpoint to check if your session is still valid",
+        "operationId": "SessionCheck",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/sessionResponse"
+            }
           },
-          {
-            "type": "string",
-            "default": "",
-            "name": "override_file_name",
-            "in": "query"
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
+        }
+      }
+    },
+    "/session/refresh": {
+      "post": {
+        "tags": [
+          "Auth"
         ],
+        "summary": "Renews the STS credentials of the current session before they expire",
+        "operationId": "SessionRefresh",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/sessionRefreshResponse"
             }
           },
           "default": {
@@ -2164,48 +7262,46 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/download-multiple": {
-      "post": {
-        "security": [
-          {
-            "key": []
+    "/sessions": {
+      "get": {
+        "tags": [
+          "Auth"
+        ],
+        "summary": "Lists the active console sessions of the current user",
+        "operationId": "ListActiveSessions",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listActiveSessionsResponse"
+            }
           },
-          {
-            "anonymous": []
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/ApiError"
+            }
           }
-        ],
-        "produces": [
-          "application/octet-stream"
-        ],
+        }
+      },
+      "delete": {
         "tags": [
-          "Object"
+          "Auth"
         ],
-        "summary": "Download Multiple Objects",
-        "operationId": "DownloadMultipleObjects",
+        "summary": "Revokes every console session of the current user",
+        "operationId": "RevokeActiveSessions",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "objectList",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
+            "type": "boolean",
+            "name": "keep_current",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/revokeActiveSessionsResponse"
             }
           },
           "default": {
@@ -2217,38 +7313,24 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/metadata": {
-      "get": {
+    "/sessions/{session_id}": {
+      "delete": {
         "tags": [
-          "Object"
+          "Auth"
         ],
-        "summary": "Gets the metadata of an object",
-        "operationId": "GetObjectMetadata",
+        "summary": "Revokes a console session of the current user",
+        "operationId": "RevokeActiveSession",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "session_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "versionID",
-            "in": "query"
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/metadata"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -2258,572 +7340,881 @@ func init() {
           }
         }
       }
+    }
+  },
+  "definitions": {
+    "ApiError": {
+      "type": "object",
+      "properties": {
+        "detailedMessage": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "BackendProperties": {
+      "type": "object",
+      "properties": {
+        "backendType": {
+          "type": "string"
+        },
+        "offlineDrives": {
+          "type": "integer"
+        },
+        "onlineDrives": {
+          "type": "integer"
+        },
+        "rrSCParity": {
+          "type": "integer"
+        },
+        "standardSCParity": {
+          "type": "integer"
+        }
+      }
+    },
+    "BucketDetails": {
+      "type": "object",
+      "properties": {
+        "locking": {
+          "type": "boolean"
+        },
+        "quota": {
+          "type": "object",
+          "properties": {
+            "quota": {
+              "type": "integer",
+              "format": "int64"
+            },
+            "type": {
+              "type": "string",
+              "enum": [
+                "hard"
+              ]
+            }
+          }
+        },
+        "replication": {
+          "type": "boolean"
+        },
+        "tags": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "versioning": {
+          "type": "boolean"
+        },
+        "versioningSuspended": {
+          "type": "boolean"
+        }
+      }
+    },
+    "BucketDetailsQuota": {
+      "type": "object",
+      "properties": {
+        "quota": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "hard"
+          ]
+        }
+      }
+    },
+    "BucketRwAccess": {
+      "type": "object",
+      "properties": {
+        "read": {
+          "type": "boolean"
+        },
+        "write": {
+          "type": "boolean"
+        }
+      }
+    },
+    "BucketVersioningResponseExcludedPrefixesItems0": {
+      "type": "object",
+      "properties": {
+        "prefix": {
+          "type": "string"
+        }
+      }
+    },
+    "LoginRequestFeatures": {
+      "type": "object",
+      "properties": {
+        "hide_menu": {
+          "type": "boolean"
+        }
+      }
+    },
+    "WidgetOptions": {
+      "type": "object",
+      "properties": {
+        "reduceOptions": {
+          "type": "object",
+          "properties": {
+            "calcs": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          }
+        }
+      }
+    },
+    "WidgetOptionsReduceOptions": {
+      "type": "object",
+      "properties": {
+        "calcs": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "abortIncompleteUploadsRequest": {
+      "type": "object",
+      "required": [
+        "older_than_hours"
+      ],
+      "properties": {
+        "dry_run": {
+          "type": "boolean",
+          "title": "only report the uploads that would be aborted"
+        },
+        "older_than_hours": {
+          "type": "integer",
+          "format": "int32",
+          "title": "only abort uploads initiated more than this number of hours ago"
+        },
+        "prefix": {
+          "type": "string"
+        }
+      }
+    },
+    "abortIncompleteUploadsResponse": {
+      "type": "object",
+      "properties": {
+        "aborted": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/incompleteUpload"
+          }
+        },
+        "dry_run": {
+          "type": "boolean"
+        },
+        "freed_bytes": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "activeSession": {
+      "type": "object",
+      "properties": {
+        "created": {
+          "type": "string"
+        },
+        "current": {
+          "type": "boolean"
+        },
+        "expires": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "ip": {
+          "type": "string"
+        },
+        "last_seen": {
+          "type": "string"
+        },
+        "user_agent": {
+          "type": "string"
+        }
+      }
     },
-    "/buckets/{bucket_name}/objects/restore": {
-      "put": {
-        "tags": [
-          "Object"
-        ],
-        "summary": "Restore Object to a selected version",
-        "operationId": "PutObjectRestore",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/ApiError"
-            }
-          }
+    "addBucketReplicationRule": {
+      "type": "object",
+      "required": [
+        "priority"
+      ],
+      "properties": {
+        "access_key": {
+          "type": "string"
+        },
+        "arn": {
+          "type": "string",
+          "title": "ARN of an existing remote target, when empty a new remote target is created"
+        },
+        "bandwidth": {
+          "type": "integer",
+          "format": "int64",
+          "title": "bandwidth limit in bytes per second"
+        },
+        "delete_marker_replication": {
+          "type": "boolean"
+        },
+        "deletes_replication": {
+          "type": "boolean"
+        },
+        "disable": {
+          "type": "boolean"
+        },
+        "endpoint": {
+          "type": "string",
+          "title": "remote target URL, e.g. https://minio.example.net:9000"
+        },
+        "existing_objects": {
+          "type": "boolean"
+        },
+        "health_check_period": {
+          "type": "integer",
+          "format": "int32",
+          "title": "health check period in seconds"
+        },
+        "id": {
+          "type": "string",
+          "title": "rule ID, a random ID is generated when not provided"
+        },
+        "metadata_replication": {
+          "type": "boolean"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "priority": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "region": {
+          "type": "string"
+        },
+        "secret_key": {
+          "type": "string"
+        },
+        "storage_class": {
+          "type": "string"
+        },
+        "sync_mode": {
+          "type": "string",
+          "enum": [
+            "async",
+            "sync"
+          ]
+        },
+        "tags": {
+          "type": "string",
+          "title": "tag filter in key1=value1\u0026key2=value2 format"
+        },
+        "target_bucket": {
+          "type": "string"
         }
       }
     },
-    "/buckets/{bucket_name}/objects/share": {
-      "get": {
-        "tags": [
-          "Object"
-        ],
-        "summary": "Shares an Object on a url",
-        "operationId": "ShareObject",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "expires",
-            "in": "query"
+    "adminInfoResponse": {
+      "type": "object",
+      "properties": {
+        "advancedMetricsStatus": {
+          "type": "string",
+          "enum": [
+            "not configured",
+            "available",
+            "unavailable"
+          ]
+        },
+        "backend": {
+          "$ref": "#/definitions/BackendProperties"
+        },
+        "buckets": {
+          "type": "integer"
+        },
+        "objects": {
+          "type": "integer"
+        },
+        "servers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/serverProperties"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "type": "string"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/ApiError"
-            }
+        },
+        "usage": {
+          "type": "integer"
+        },
+        "widgets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/widget"
           }
         }
       }
     },
-    "/buckets/{bucket_name}/objects/tags": {
-      "put": {
-        "tags": [
-          "Object"
-        ],
-        "summary": "Put Object's tags",
-        "operationId": "PutObjectTags",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/putObjectTagsRequest"
+    "bucket": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "access": {
+          "$ref": "#/definitions/bucketAccess"
+        },
+        "creation_date": {
+          "type": "string"
+        },
+        "definition": {
+          "type": "string"
+        },
+        "details": {
+          "type": "object",
+          "properties": {
+            "locking": {
+              "type": "boolean"
+            },
+            "quota": {
+              "type": "object",
+              "properties": {
+                "quota": {
+                  "type": "integer",
+                  "format": "int64"
+                },
+                "type": {
+                  "type": "string",
+                  "enum": [
+                    "hard"
+                  ]
+                }
+              }
+            },
+            "replication": {
+              "type": "boolean"
+            },
+            "tags": {
+              "type": "object",
+              "additionalProperties": {
+                "type": "string"
+              }
+            },
+            "versioning": {
+              "type": "boolean"
+            },
+            "versioningSuspended": {
+              "type": "boolean"
             }
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/ApiError"
+        },
+        "name": {
+          "type": "string",
+          "minLength": 3
+        },
+        "objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "rw_access": {
+          "type": "object",
+          "properties": {
+            "read": {
+              "type": "boolean"
+            },
+            "write": {
+              "type": "boolean"
             }
           }
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "/buckets/{bucket_name}/objects/upload": {
-      "post": {
-        "security": [
-          {
-            "key": []
-          },
-          {
-            "anonymous": []
-          }
-        ],
-        "consumes": [
-          "multipart/form-data"
-        ],
-        "tags": [
-          "Object"
-        ],
-        "summary": "Uploads an Object.",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query"
+    "bucketAccess": {
+      "type": "string",
+      "default": "PRIVATE",
+      "enum": [
+        "PRIVATE",
+        "PUBLIC",
+        "CUSTOM"
+      ]
+    },
+    "bucketConfigBundle": {
+      "type": "object",
+      "properties": {
+        "bucket": {
+          "type": "string",
+          "title": "name of the exported bucket, policy resources are rewritten when importing into another bucket"
+        },
+        "encryption": {
+          "$ref": "#/definitions/bucketEncryptionSettings"
+        },
+        "lifecycle": {
+          "type": "object",
+          "title": "lifecycle configuration in the JSON format of the lifecycle export"
+        },
+        "locking": {
+          "type": "boolean"
+        },
+        "notifications": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketNotificationConfig"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/ApiError"
-            }
+        },
+        "policy": {
+          "type": "string"
+        },
+        "quota": {
+          "type": "integer",
+          "format": "int64",
+          "title": "hard quota in bytes"
+        },
+        "retention": {
+          "$ref": "#/definitions/getBucketRetentionConfig"
+        },
+        "tags": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
           }
+        },
+        "versioning": {
+          "$ref": "#/definitions/setBucketVersioning"
         }
       }
     },
-    "/buckets/{bucket_name}/rewind/{date}": {
-      "get": {
-        "tags": [
-          "Bucket"
-        ],
-        "summary": "Get objects in a bucket for a rewind date",
-        "operationId": "GetBucketRewind",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "date",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/rewindResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/ApiError"
-            }
-          }
+    "bucketConfigChange": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "string",
+          "title": "one of add, update, remove, unchanged or conflict"
+        },
+        "current": {
+          "type": "string"
+        },
+        "desired": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        },
+        "section": {
+          "type": "string"
         }
       }
     },
-    "/buckets/{bucket_name}/versioning": {
-      "get": {
-        "tags": [
-          "Bucket"
-        ],
-        "summary": "Bucket Versioning",
-        "operationId": "GetBucketVersioning",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/bucketVersioningResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/ApiError"
-            }
-          }
-        }
-      },
-      "put": {
-        "tags": [
-          "Bucket"
-        ],
-        "summary": "Set Bucket Versioning",
-        "operationId": "SetBucketVersioning",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/setBucketVersioning"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/ApiError"
-            }
+    "bucketConfigImportRequest": {
+      "type": "object",
+      "required": [
+        "bundle"
+      ],
+      "properties": {
+        "bundle": {
+          "$ref": "#/definitions/bucketConfigBundle"
+        },
+        "confirmationToken": {
+          "type": "string",
+          "title": "token returned by a previous request, required to apply a COMPLIANCE retention"
+        },
+        "dryRun": {
+          "type": "boolean",
+          "title": "only compute the changes without applying them"
+        },
+        "sections": {
+          "type": "array",
+          "title": "sections of the bundle to import, all of them when empty",
+          "items": {
+            "type": "string"
           }
         }
       }
-    },
-    "/buckets/{name}": {
-      "get": {
-        "tags": [
-          "Bucket"
-        ],
-        "summary": "Bucket Info",
-        "operationId": "BucketInfo",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/bucket"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/ApiError"
-            }
+    },
+    "bucketConfigImportResponse": {
+      "type": "object",
+      "properties": {
+        "applied": {
+          "type": "boolean"
+        },
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketConfigChange"
           }
+        },
+        "confirmationRequired": {
+          "type": "boolean"
+        },
+        "confirmationToken": {
+          "type": "string"
+        },
+        "warning": {
+          "type": "string"
         }
       }
     },
-    "/buckets/{name}/quota": {
-      "get": {
-        "tags": [
-          "Bucket"
-        ],
-        "summary": "Get Bucket Quota",
-        "operationId": "GetBucketQuota",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/bucketQuota"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/ApiError"
-            }
+    "bucketCorsConfiguration": {
+      "type": "object",
+      "properties": {
+        "rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/corsRule"
           }
         }
       }
     },
-    "/download-shared-object/{url}": {
-      "get": {
-        "security": [],
-        "produces": [
-          "application/octet-stream"
-        ],
-        "tags": [
-          "Public"
-        ],
-        "summary": "Downloads an object from a presigned url",
-        "operationId": "DownloadSharedObject",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "url",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "type": "file"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/ApiError"
-            }
-          }
+    "bucketEncryptionSettings": {
+      "type": "object",
+      "required": [
+        "type"
+      ],
+      "properties": {
+        "kmsKeyID": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string",
+          "title": "sse-s3 or sse-kms"
         }
       }
     },
-    "/license/acknowledge": {
-      "get": {
-        "tags": [
-          "License"
-        ],
-        "summary": "Acknowledge the license",
-        "operationId": "LicenseAcknowledge",
-        "responses": {
-          "200": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/ApiError"
-            }
+    "bucketLifecycleResponse": {
+      "type": "object",
+      "properties": {
+        "lifecycle": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/objectBucketLifecycle"
           }
         }
       }
     },
-    "/login": {
-      "get": {
-        "security": [],
-        "tags": [
-          "Auth"
-        ],
-        "summary": "Returns login strategy, form or sso.",
-        "operationId": "LoginDetail",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/loginDetails"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/ApiError"
-            }
+    "bucketNotificationConfig": {
+      "type": "object",
+      "required": [
+        "arn"
+      ],
+      "properties": {
+        "arn": {
+          "type": "string"
+        },
+        "events": {
+          "type": "array",
+          "items": {
+            "type": "string"
           }
+        },
+        "id": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "suffix": {
+          "type": "string"
         }
-      },
-      "post": {
-        "security": [],
-        "tags": [
-          "Auth"
-        ],
-        "summary": "Login to Console",
-        "operationId": "Login",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/loginRequest"
-            }
+      }
+    },
+    "bucketObject": {
+      "type": "object",
+      "properties": {
+        "content_type": {
+          "type": "string"
+        },
+        "etag": {
+          "type": "string"
+        },
+        "expiration": {
+          "type": "string"
+        },
+        "expiration_rule_id": {
+          "type": "string"
+        },
+        "is_delete_marker": {
+          "type": "boolean"
+        },
+        "is_latest": {
+          "type": "boolean"
+        },
+        "last_modified": {
+          "type": "string"
+        },
+        "legal_hold_status": {
+          "type": "string"
+        },
+        "metadata": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
           }
-        ],
-        "responses": {
-          "204": {
-            "description": "A successful login."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/ApiError"
-            }
+        },
+        "name": {
+          "type": "string"
+        },
+        "retention_mode": {
+          "type": "string"
+        },
+        "retention_until_date": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "tags": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "user_metadata": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
           }
+        },
+        "user_tags": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "version_id": {
+          "type": "string"
+        }
+      }
+    },
+    "bucketQuota": {
+      "type": "object",
+      "properties": {
+        "quota": {
+          "type": "integer"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "hard"
+          ]
+        }
+      }
+    },
+    "bucketReplicationDestination": {
+      "type": "object",
+      "properties": {
+        "bucket": {
+          "type": "string",
+          "title": "ARN of the remote target"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "online": {
+          "type": "boolean"
+        },
+        "region": {
+          "type": "string"
+        },
+        "sync_mode": {
+          "type": "string"
+        },
+        "target_bucket": {
+          "type": "string"
         }
       }
     },
-    "/logout": {
-      "post": {
-        "tags": [
-          "Auth"
-        ],
-        "summary": "Logout from Console.",
-        "operationId": "Logout",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/logoutRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/ApiError"
-            }
+    "bucketReplicationMetrics": {
+      "type": "object",
+      "properties": {
+        "failed_bytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "failed_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "pending_bytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "pending_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "queued_bytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "queued_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "replica_bytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "replica_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "replicated_bytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "replicated_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "targets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketReplicationTargetMetrics"
           }
+        },
+        "uptime": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "/session": {
-      "get": {
-        "tags": [
-          "Auth"
-        ],
-        "summary": "Endpoint to check if your session is still valid",
-        "operationId": "SessionCheck",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/sessionResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/ApiError"
-            }
+    "bucketReplicationResponse": {
+      "type": "object",
+      "properties": {
+        "rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketReplicationRule"
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "ApiError": {
+    },
+    "bucketReplicationRule": {
       "type": "object",
       "properties": {
-        "detailedMessage": {
+        "delete_marker_replication": {
+          "type": "boolean"
+        },
+        "deletes_replication": {
+          "type": "boolean"
+        },
+        "destination": {
+          "$ref": "#/definitions/bucketReplicationDestination"
+        },
+        "existing_objects": {
+          "type": "boolean"
+        },
+        "id": {
           "type": "string"
         },
-        "message": {
+        "metadata_replication": {
+          "type": "boolean"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "priority": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "status": {
+          "type": "string"
+        },
+        "storage_class": {
+          "type": "string"
+        },
+        "tags": {
           "type": "string"
         }
       }
     },
-    "BackendProperties": {
+    "bucketReplicationTargetMetrics": {
       "type": "object",
       "properties": {
-        "backendType": {
+        "arn": {
           "type": "string"
         },
-        "offlineDrives": {
-          "type": "integer"
+        "bandwidth_limit": {
+          "type": "integer",
+          "format": "int64"
         },
-        "onlineDrives": {
-          "type": "integer"
+        "current_bandwidth": {
+          "type": "number",
+          "format": "double"
         },
-        "rrSCParity": {
-          "type": "integer"
+        "endpoint": {
+          "type": "string"
         },
-        "standardSCParity": {
-          "type": "integer"
+        "failed_bytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "failed_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "online": {
+          "type": "boolean"
+        },
+        "pending_bytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "pending_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "replicated_bytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "replicated_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "target_bucket": {
+          "type": "string"
         }
       }
     },
-    "BucketDetails": {
+    "bucketSettings": {
       "type": "object",
       "properties": {
+        "access": {
+          "$ref": "#/definitions/bucketAccess"
+        },
+        "definition": {
+          "type": "string",
+          "title": "bucket policy applied with CUSTOM access"
+        },
+        "encryption": {
+          "$ref": "#/definitions/bucketEncryptionSettings"
+        },
+        "lifecycle": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/lifecycleRuleRequest"
+          }
+        },
         "locking": {
           "type": "boolean"
         },
         "quota": {
-          "type": "object",
-          "properties": {
-            "quota": {
-              "type": "integer",
-              "format": "int64"
-            },
-            "type": {
-              "type": "string",
-              "enum": [
-                "hard"
-              ]
-            }
-          }
+          "type": "integer",
+          "format": "int64",
+          "title": "hard quota in bytes"
         },
-        "replication": {
-          "type": "boolean"
+        "retention": {
+          "$ref": "#/definitions/putBucketRetentionRequest"
         },
         "tags": {
           "type": "object",
@@ -2832,346 +8223,436 @@ func init() {
           }
         },
         "versioning": {
-          "type": "boolean"
+          "$ref": "#/definitions/setBucketVersioning"
+        }
+      }
+    },
+    "bucketTemplate": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
         },
-        "versioningSuspended": {
+        "name": {
+          "type": "string"
+        },
+        "settings": {
+          "$ref": "#/definitions/bucketSettings"
+        }
+      }
+    },
+    "bucketTemplateList": {
+      "type": "object",
+      "properties": {
+        "templates": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketTemplate"
+          }
+        }
+      }
+    },
+    "bucketVersioningResponse": {
+      "type": "object",
+      "properties": {
+        "MFADelete": {
+          "type": "string"
+        },
+        "excludeFolders": {
           "type": "boolean"
+        },
+        "excludedPrefixes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BucketVersioningResponseExcludedPrefixesItems0"
+          }
+        },
+        "status": {
+          "type": "string"
         }
       }
     },
-    "BucketDetailsQuota": {
+    "corsRule": {
       "type": "object",
       "properties": {
-        "quota": {
+        "allowed_headers": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "allowed_methods": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "allowed_origins": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "expose_headers": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
+          "type": "string"
+        },
+        "max_age_seconds": {
           "type": "integer",
           "format": "int64"
-        },
-        "type": {
-          "type": "string",
-          "enum": [
-            "hard"
-          ]
         }
       }
     },
-    "BucketRwAccess": {
+    "corsRuleEvaluation": {
       "type": "object",
       "properties": {
-        "read": {
+        "headers_allowed": {
           "type": "boolean"
         },
-        "write": {
+        "id": {
+          "type": "string"
+        },
+        "method_allowed": {
           "type": "boolean"
+        },
+        "origin_allowed": {
+          "type": "boolean"
+        },
+        "reason": {
+          "type": "string"
+        },
+        "rule": {
+          "type": "integer",
+          "format": "int32",
+          "title": "position of the rule in the configuration, starting at 1"
         }
       }
     },
-    "BucketVersioningResponseExcludedPrefixesItems0": {
+    "corsTestRequest": {
       "type": "object",
+      "required": [
+        "origin",
+        "method"
+      ],
       "properties": {
-        "prefix": {
+        "method": {
+          "type": "string"
+        },
+        "origin": {
           "type": "string"
+        },
+        "request_headers": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "rules": {
+          "type": "array",
+          "title": "rules to evaluate instead of the bucket configuration, used to try changes before saving them",
+          "items": {
+            "$ref": "#/definitions/corsRule"
+          }
         }
       }
     },
-    "LoginRequestFeatures": {
+    "corsTestResponse": {
       "type": "object",
       "properties": {
-        "hide_menu": {
+        "allowed": {
           "type": "boolean"
-        }
-      }
-    },
-    "WidgetOptions": {
-      "type": "object",
-      "properties": {
-        "reduceOptions": {
+        },
+        "evaluations": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/corsRuleEvaluation"
+          }
+        },
+        "explanation": {
+          "type": "string"
+        },
+        "matched_rule": {
+          "type": "integer",
+          "format": "int32",
+          "title": "position of the first matching rule starting at 1, 0 when no rule matched"
+        },
+        "response_headers": {
           "type": "object",
-          "properties": {
-            "calcs": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
+          "title": "CORS headers MinIO would send back for this request",
+          "additionalProperties": {
+            "type": "string"
           }
         }
       }
     },
-    "WidgetOptionsReduceOptions": {
+    "deleteFile": {
       "type": "object",
       "properties": {
-        "calcs": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "path": {
+          "type": "string"
+        },
+        "recursive": {
+          "type": "boolean"
+        },
+        "versionID": {
+          "type": "string"
         }
       }
     },
-    "adminInfoResponse": {
+    "environmentConstants": {
       "type": "object",
       "properties": {
-        "advancedMetricsStatus": {
-          "type": "string",
-          "enum": [
-            "not configured",
-            "available",
-            "unavailable"
-          ]
-        },
-        "backend": {
-          "$ref": "#/definitions/BackendProperties"
-        },
-        "buckets": {
+        "maxConcurrentDownloads": {
           "type": "integer"
         },
-        "objects": {
+        "maxConcurrentUploads": {
           "type": "integer"
+        }
+      }
+    },
+    "getBucketRetentionConfig": {
+      "type": "object",
+      "properties": {
+        "mode": {
+          "$ref": "#/definitions/objectRetentionMode"
         },
-        "servers": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/serverProperties"
-          }
-        },
-        "usage": {
-          "type": "integer"
+        "unit": {
+          "$ref": "#/definitions/objectRetentionUnit"
         },
-        "widgets": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/widget"
-          }
+        "validity": {
+          "type": "integer",
+          "format": "int32"
         }
       }
     },
-    "bucket": {
+    "incompleteUpload": {
       "type": "object",
-      "required": [
-        "name"
-      ],
       "properties": {
-        "access": {
-          "$ref": "#/definitions/bucketAccess"
-        },
-        "creation_date": {
+        "initiated": {
           "type": "string"
         },
-        "definition": {
+        "key": {
           "type": "string"
         },
-        "details": {
-          "type": "object",
-          "properties": {
-            "locking": {
-              "type": "boolean"
-            },
-            "quota": {
-              "type": "object",
-              "properties": {
-                "quota": {
-                  "type": "integer",
-                  "format": "int64"
-                },
-                "type": {
-                  "type": "string",
-                  "enum": [
-                    "hard"
-                  ]
-                }
-              }
-            },
-            "replication": {
-              "type": "boolean"
-            },
-            "tags": {
-              "type": "object",
-              "additionalProperties": {
-                "type": "string"
-              }
-            },
-            "versioning": {
-              "type": "boolean"
-            },
-            "versioningSuspended": {
-              "type": "boolean"
-            }
-          }
-        },
-        "name": {
-          "type": "string",
-          "minLength": 3
-        },
-        "objects": {
+        "parts": {
           "type": "integer",
           "format": "int64"
         },
-        "rw_access": {
-          "type": "object",
-          "properties": {
-            "read": {
-              "type": "boolean"
-            },
-            "write": {
-              "type": "boolean"
-            }
-          }
-        },
         "size": {
           "type": "integer",
-          "format": "int64"
+          "format": "int64",
+          "title": "size of the parts uploaded so far"
+        },
+        "upload_id": {
+          "type": "string"
         }
       }
     },
-    "bucketAccess": {
-      "type": "string",
-      "default": "PRIVATE",
-      "enum": [
-        "PRIVATE",
-        "PUBLIC",
-        "CUSTOM"
-      ]
-    },
-    "bucketObject": {
+    "inventoryExportRequest": {
       "type": "object",
+      "required": [
+        "destination_bucket"
+      ],
       "properties": {
-        "content_type": {
-          "type": "string"
-        },
-        "etag": {
-          "type": "string"
-        },
-        "expiration": {
+        "destination_bucket": {
           "type": "string"
         },
-        "expiration_rule_id": {
+        "destination_prefix": {
           "type": "string"
         },
-        "is_delete_marker": {
-          "type": "boolean"
+        "format": {
+          "type": "string",
+          "title": "one of csv, jsonl or parquet, csv by default"
         },
-        "is_latest": {
-          "type": "boolean"
+        "metadata": {
+          "type": "boolean",
+          "title": "include the tags and the retention status of every object"
         },
-        "last_modified": {
+        "prefix": {
           "type": "string"
         },
-        "legal_hold_status": {
+        "versions": {
+          "type": "boolean",
+          "title": "list every object version and delete marker"
+        }
+      }
+    },
+    "inventoryExportResult": {
+      "type": "object",
+      "properties": {
+        "bucket": {
           "type": "string"
         },
-        "metadata": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
-          }
-        },
-        "name": {
+        "format": {
           "type": "string"
         },
-        "retention_mode": {
+        "object": {
           "type": "string"
         },
-        "retention_until_date": {
-          "type": "string"
+        "objects": {
+          "type": "integer",
+          "format": "int64"
         },
         "size": {
           "type": "integer",
           "format": "int64"
+        }
+      }
+    },
+    "lifecycleExpiration": {
+      "type": "object",
+      "properties": {
+        "date": {
+          "type": "string"
         },
-        "tags": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
-          }
-        },
-        "user_metadata": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
-          }
-        },
-        "user_tags": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
-          }
+        "days": {
+          "type": "integer",
+          "format": "int64"
         },
-        "version_id": {
-          "type": "string"
+        "delete_marker": {
+          "type": "boolean"
+        },
+        "newer_noncurrent_expiration_versions": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "noncurrent_expiration_days": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "bucketQuota": {
+    "lifecycleImportRequest": {
       "type": "object",
+      "required": [
+        "configuration"
+      ],
       "properties": {
-        "quota": {
-          "type": "integer"
+        "configuration": {
+          "type": "string"
         },
-        "type": {
+        "format": {
           "type": "string",
+          "title": "configuration format, defaults to xml",
           "enum": [
-            "hard"
+            "xml",
+            "json"
           ]
         }
       }
     },
-    "bucketVersioningResponse": {
+    "lifecycleRuleRequest": {
       "type": "object",
       "properties": {
-        "MFADelete": {
-          "type": "string"
+        "abort_incomplete_multipart_days": {
+          "type": "integer",
+          "format": "int32"
         },
-        "excludeFolders": {
+        "disable": {
           "type": "boolean"
         },
-        "excludedPrefixes": {
+        "expired_object_delete_marker": {
+          "type": "boolean"
+        },
+        "expiry_date": {
+          "type": "string",
+          "title": "expiration date in YYYY-MM-DD format"
+        },
+        "expiry_days": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "id": {
+          "type": "string",
+          "title": "rule ID, a random ID is generated when adding a rule without one"
+        },
+        "newer_noncurrentversion_expiration_versions": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "noncurrentversion_expiration_days": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "noncurrentversion_transition_days": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "noncurrentversion_transition_storage_class": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "storage_class": {
+          "type": "string",
+          "title": "name of the configured tier objects will be transitioned to"
+        },
+        "tags": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/BucketVersioningResponseExcludedPrefixesItems0"
+            "$ref": "#/definitions/lifecycleTag"
           }
         },
-        "status": {
-          "type": "string"
+        "transition_date": {
+          "type": "string",
+          "title": "transition date in YYYY-MM-DD format"
+        },
+        "transition_days": {
+          "type": "integer",
+          "format": "int32"
         }
       }
     },
-    "deleteFile": {
+    "lifecycleTag": {
       "type": "object",
       "properties": {
-        "path": {
+        "key": {
           "type": "string"
         },
-        "recursive": {
-          "type": "boolean"
-        },
-        "versionID": {
+        "value": {
           "type": "string"
         }
       }
     },
-    "environmentConstants": {
+    "lifecycleTransition": {
       "type": "object",
       "properties": {
-        "maxConcurrentDownloads": {
-          "type": "integer"
+        "date": {
+          "type": "string"
         },
-        "maxConcurrentUploads": {
-          "type": "integer"
+        "days": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "noncurrent_storage_class": {
+          "type": "string"
+        },
+        "noncurrent_transition_days": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "storage_class": {
+          "type": "string"
         }
       }
     },
-    "getBucketRetentionConfig": {
+    "listActiveSessionsResponse": {
       "type": "object",
       "properties": {
-        "mode": {
-          "$ref": "#/definitions/objectRetentionMode"
-        },
-        "unit": {
-          "$ref": "#/definitions/objectRetentionUnit"
-        },
-        "validity": {
-          "type": "integer",
-          "format": "int32"
+        "sessions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/activeSession"
+          }
         }
       }
     },
@@ -3188,7 +8669,26 @@ func init() {
         "total": {
           "type": "integer",
           "format": "int64",
-          "title": "number of buckets accessible to the user"
+          "title": "number of buckets accessible to the user matching the filters, before pagination"
+        }
+      }
+    },
+    "listIncompleteUploadsResponse": {
+      "type": "object",
+      "properties": {
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "total_size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "uploads": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/incompleteUpload"
+          }
         }
       }
     },
@@ -3222,6 +8722,7 @@ func init() {
           "type": "string",
           "enum": [
             "form",
+            "ldap",
             "service-account",
             "redirect-service-account"
           ]
@@ -3234,6 +8735,21 @@ func init() {
         }
       }
     },
+    "loginOauth2AuthRequest": {
+      "type": "object",
+      "required": [
+        "state",
+        "code"
+      ],
+      "properties": {
+        "code": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string"
+        }
+      }
+    },
     "loginRequest": {
       "type": "object",
       "properties": {
@@ -3259,59 +8775,259 @@ func init() {
     "loginResponse": {
       "type": "object",
       "properties": {
-        "IDPRefreshToken": {
+        "IDPRefreshToken": {
+          "type": "string"
+        },
+        "secondFactorEnrollment": {
+          "type": "boolean"
+        },
+        "secondFactorToken": {
+          "type": "string"
+        },
+        "sessionId": {
+          "type": "string"
+        }
+      }
+    },
+    "loginSecondFactorResponse": {
+      "type": "object",
+      "properties": {
+        "enrollmentRequired": {
+          "type": "boolean"
+        }
+      }
+    },
+    "logoutRequest": {
+      "type": "object",
+      "properties": {
+        "state": {
+          "type": "string"
+        }
+      }
+    },
+    "makeBucketRequest": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "confirmationToken": {
+          "type": "string",
+          "title": "token returned by a previous request, required to create a bucket with COMPLIANCE retention"
+        },
+        "name": {
+          "type": "string"
+        },
+        "settings": {
+          "$ref": "#/definitions/bucketSettings"
+        },
+        "template": {
+          "type": "string",
+          "title": "name of a server configured template the bucket settings are based on"
+        }
+      }
+    },
+    "makeBucketsResponse": {
+      "type": "object",
+      "properties": {
+        "bucketName": {
+          "type": "string"
+        },
+        "confirmationRequired": {
+          "type": "boolean",
+          "title": "the bucket wasn't created, COMPLIANCE retention must be confirmed with the confirmation token"
+        },
+        "confirmationToken": {
+          "type": "string"
+        },
+        "warning": {
+          "type": "string"
+        }
+      }
+    },
+    "maxShareLinkExpResponse": {
+      "type": "object",
+      "required": [
+        "exp"
+      ],
+      "properties": {
+        "exp": {
+          "type": "number",
+          "format": "int64"
+        }
+      }
+    },
+    "metadata": {
+      "type": "object",
+      "properties": {
+        "objectMetadata": {
+          "type": "object",
+          "additionalProperties": true
+        }
+      }
+    },
+    "mirrorJob": {
+      "type": "object",
+      "properties": {
+        "bytes_per_second": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "copied_bytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "copied_objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "errors": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/mirrorJobError"
+          }
+        },
+        "eta_seconds": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "failed_objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "finished": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "newer_only": {
+          "type": "boolean"
+        },
+        "overwrite": {
+          "type": "boolean"
+        },
+        "remove_extraneous": {
+          "type": "boolean"
+        },
+        "removed_objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "skipped_objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "source_bucket": {
+          "type": "string"
+        },
+        "source_prefix": {
+          "type": "string"
+        },
+        "started": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "title": "one of planning, running, paused, canceling, completed, failed or canceled"
+        },
+        "target_bucket": {
+          "type": "string"
+        },
+        "target_prefix": {
+          "type": "string"
+        },
+        "total_bytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "total_objects": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "mirrorJobError": {
+      "type": "object",
+      "properties": {
+        "action": {
           "type": "string"
         },
-        "sessionId": {
+        "error": {
+          "type": "string"
+        },
+        "key": {
           "type": "string"
         }
       }
     },
-    "logoutRequest": {
+    "mirrorJobList": {
       "type": "object",
       "properties": {
-        "state": {
-          "type": "string"
+        "jobs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/mirrorJob"
+          }
         }
       }
     },
-    "makeBucketRequest": {
+    "mirrorJobRequest": {
       "type": "object",
       "required": [
-        "name"
+        "target_bucket"
       ],
       "properties": {
-        "name": {
+        "newer_only": {
+          "type": "boolean",
+          "title": "overwrite objects that differ only when the source is newer than the target"
+        },
+        "overwrite": {
+          "type": "boolean",
+          "title": "overwrite every object that differs in the target"
+        },
+        "prefix": {
           "type": "string"
-        }
-      }
-    },
-    "makeBucketsResponse": {
-      "type": "object",
-      "properties": {
-        "bucketName": {
+        },
+        "remove_extraneous": {
+          "type": "boolean",
+          "title": "remove objects in the target that don't exist in the source"
+        },
+        "target_bucket": {
+          "type": "string"
+        },
+        "target_prefix": {
           "type": "string"
         }
       }
     },
-    "maxShareLinkExpResponse": {
+    "objectBucketLifecycle": {
       "type": "object",
-      "required": [
-        "exp"
-      ],
       "properties": {
-        "exp": {
-          "type": "number",
+        "abort_incomplete_multipart_days": {
+          "type": "integer",
           "format": "int64"
-        }
-      }
-    },
-    "metadata": {
-      "type": "object",
-      "properties": {
-        "objectMetadata": {
-          "type": "object",
-          "additionalProperties": true
+        },
+        "expiration": {
+          "$ref": "#/definitions/lifecycleExpiration"
+        },
+        "id": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "tags": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/lifecycleTag"
+          }
+        },
+        "transition": {
+          "$ref": "#/definitions/lifecycleTransition"
         }
       }
     },
@@ -3329,6 +9045,73 @@ func init() {
         "years"
       ]
     },
+    "objectVersion": {
+      "type": "object",
+      "properties": {
+        "etag": {
+          "type": "string"
+        },
+        "is_delete_marker": {
+          "type": "boolean"
+        },
+        "is_latest": {
+          "type": "boolean"
+        },
+        "last_modified": {
+          "type": "string"
+        },
+        "legal_hold_status": {
+          "type": "string"
+        },
+        "retention_mode": {
+          "type": "string"
+        },
+        "retention_until_date": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "version_id": {
+          "type": "string"
+        },
+        "writer": {
+          "type": "string",
+          "title": "who wrote the version, taken from the object metadata"
+        }
+      }
+    },
+    "objectVersionHistory": {
+      "type": "object",
+      "properties": {
+        "delete_markers": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "name": {
+          "type": "string"
+        },
+        "noncurrent_bytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "total_bytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "total_versions": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "versions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/objectVersion"
+          }
+        }
+      }
+    },
     "permissionResource": {
       "type": "object",
       "properties": {
@@ -3367,8 +9150,113 @@ func init() {
         "hm": {
           "type": "boolean"
         },
+        "lastActivity": {
+          "type": "integer",
+          "format": "int64"
+        },
         "ob": {
           "type": "boolean"
+        },
+        "sessionExpiration": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "sessionId": {
+          "type": "string"
+        },
+        "stsExpiration": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "pruneObjectVersionsRequest": {
+      "type": "object",
+      "required": [
+        "prefix"
+      ],
+      "properties": {
+        "dry_run": {
+          "type": "boolean",
+          "title": "only report the versions that would be pruned"
+        },
+        "keep_latest": {
+          "type": "integer",
+          "format": "int32",
+          "title": "number of most recent versions kept, including the current one"
+        },
+        "older_than_days": {
+          "type": "integer",
+          "format": "int32",
+          "title": "only prune versions last modified more than this number of days ago"
+        },
+        "prefix": {
+          "type": "string"
+        }
+      }
+    },
+    "pruneObjectVersionsResponse": {
+      "type": "object",
+      "properties": {
+        "dry_run": {
+          "type": "boolean"
+        },
+        "freed_bytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "pruned": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/objectVersion"
+          }
+        },
+        "skipped": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/skippedObjectVersion"
+          }
+        }
+      }
+    },
+    "putBucketRetentionRequest": {
+      "type": "object",
+      "required": [
+        "mode",
+        "unit",
+        "validity"
+      ],
+      "properties": {
+        "confirmationToken": {
+          "type": "string",
+          "title": "token returned by a previous request, required to apply compliance mode"
+        },
+        "mode": {
+          "$ref": "#/definitions/objectRetentionMode"
+        },
+        "unit": {
+          "$ref": "#/definitions/objectRetentionUnit"
+        },
+        "validity": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "putBucketRetentionResponse": {
+      "type": "object",
+      "properties": {
+        "applied": {
+          "type": "boolean"
+        },
+        "confirmationRequired": {
+          "type": "boolean"
+        },
+        "confirmationToken": {
+          "type": "string"
+        },
+        "warning": {
+          "type": "string"
         }
       }
     },
@@ -3413,6 +9301,15 @@ func init() {
         }
       }
     },
+    "revokeActiveSessionsResponse": {
+      "type": "object",
+      "properties": {
+        "revoked": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
     "rewindItem": {
       "type": "object",
       "properties": {
@@ -3451,6 +9348,48 @@ func init() {
         }
       }
     },
+    "secondFactorCodeRequest": {
+      "type": "object",
+      "required": [
+        "code"
+      ],
+      "properties": {
+        "code": {
+          "type": "string"
+        }
+      }
+    },
+    "secondFactorEnrollment": {
+      "type": "object",
+      "properties": {
+        "provisioningUri": {
+          "type": "string"
+        },
+        "recoveryCodes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "secret": {
+          "type": "string"
+        }
+      }
+    },
+    "secondFactorStatus": {
+      "type": "object",
+      "properties": {
+        "available": {
+          "type": "boolean"
+        },
+        "enrolled": {
+          "type": "boolean"
+        },
+        "required": {
+          "type": "boolean"
+        }
+      }
+    },
     "serverDrives": {
       "type": "object",
       "properties": {
@@ -3521,6 +9460,17 @@ func init() {
         }
       }
     },
+    "sessionRefreshResponse": {
+      "type": "object",
+      "properties": {
+        "expires": {
+          "type": "string"
+        },
+        "maxExpires": {
+          "type": "string"
+        }
+      }
+    },
     "sessionResponse": {
       "type": "object",
       "properties": {
@@ -3568,6 +9518,19 @@ func init() {
         }
       }
     },
+    "setBucketQuota": {
+      "type": "object",
+      "required": [
+        "quota"
+      ],
+      "properties": {
+        "quota": {
+          "type": "integer",
+          "format": "int64",
+          "title": "hard quota in bytes"
+        }
+      }
+    },
     "setBucketVersioning": {
       "type": "object",
       "properties": {
@@ -3586,6 +9549,17 @@ func init() {
         }
       }
     },
+    "skippedObjectVersion": {
+      "type": "object",
+      "properties": {
+        "reason": {
+          "type": "string"
+        },
+        "version_id": {
+          "type": "string"
+        }
+      }
+    },
     "widget": {
       "type": "object",
       "properties": {
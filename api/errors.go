@@ -24,6 +24,7 @@ import (
 	"github.com/minio/minio-go/v7"
 
 	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/auth/session"
 	"github.com/minio/madmin-go/v3"
 )
 
@@ -46,6 +47,33 @@ var (
 	ErrInvalidEncryptionAlgorithm       = errors.New("error invalid encryption algorithm")
 	ErrSSENotConfigured                 = errors.New("error server side encryption configuration not found")
 	ErrBucketLifeCycleNotConfigured     = errors.New("error bucket life cycle configuration not found")
+	ErrInvalidLifecycleConfig           = errors.New("invalid lifecycle configuration")
+	ErrInvalidReplicationRule           = errors.New("invalid replication rule")
+	ErrInvalidBucketQuota               = errors.New("bucket quota must be greater than zero")
+	ErrInvalidCorsConfig                = errors.New("invalid CORS configuration")
+	ErrInvalidDiffLocations             = errors.New("source and target locations must be different")
+	ErrMirrorJobNotFound                = errors.New("mirror job not found")
+	ErrInvalidBucketSettings            = errors.New("invalid bucket settings")
+	ErrBucketTemplateNotFound           = errors.New("bucket template not found")
+	ErrInvalidBucketConfigBundle        = errors.New("invalid bucket configuration bundle")
+	ErrBucketConfigConflict             = errors.New("bucket configuration bundle conflicts with the bucket")
+	ErrInvalidInventoryFormat           = errors.New("invalid inventory format")
+	ErrInvalidMirrorJobState            = errors.New("operation not allowed in the current mirror job state")
+	ErrMirrorJobCredentialsExpired      = errors.New("the credentials of the session that started the mirror job expired, start it again to mirror the remaining objects")
+	ErrInvalidRewindWindow              = errors.New("the end of the rewind window must be after its start")
+	ErrSessionRegistryDisabled          = errors.New("the session registry is not enabled")
+	ErrSessionNotRenewable              = errors.New("this session can't be renewed")
+	ErrSessionMaxLifetime               = errors.New("the session reached its maximum lifetime")
+	ErrSessionIdle                      = errors.New("the session expired after being idle")
+	ErrTooManyLoginAttempts             = errors.New("too many failed login attempts, please try again later")
+	ErrSecondFactorDisabled             = errors.New("second factors are not enabled")
+	ErrSecondFactorUnavailable          = errors.New("this session can't have a second factor")
+	ErrSecondFactorEnrolled             = errors.New("a second factor is already enrolled")
+	ErrSecondFactorNotEnrolled          = errors.New("no second factor is enrolled")
+	ErrSecondFactorRequired             = errors.New("a second factor is required for this user")
+	ErrInvalidSecondFactor              = errors.New("invalid second factor code")
+	ErrSecondFactorLoginExpired         = errors.New("the login expired, please log in again")
+	ErrSecondFactorUnreadable           = errors.New("second factors can't be read, please contact your administrator")
 	ErrChangePassword                   = errors.New("error please check your current password")
 	ErrInvalidLicense                   = errors.New("invalid license key")
 	ErrLicenseNotFound                  = errors.New("license not found")
@@ -161,7 +189,7 @@ func ErrorWithContext(ctx context.Context, err ...interface{}) *CodedAPIError {
 				errorMessage = ErrPolicyBodyNotInRequest.Error()
 			}
 			// console invalid session errors
-			if errors.Is(err1, ErrInvalidSession) {
+			if errors.Is(err1, ErrInvalidSession) || errors.Is(err1, session.ErrSessionRevoked) {
 				errorCode = 401
 				errorMessage = ErrInvalidSession.Error()
 			}
@@ -174,6 +202,110 @@ func ErrorWithContext(ctx context.Context, err ...interface{}) *CodedAPIError {
 				errorCode = 404
 				errorMessage = ErrBucketLifeCycleNotConfigured.Error()
 			}
+			if errors.Is(err1, ErrInvalidLifecycleConfig) {
+				errorCode = 400
+				errorMessage = ErrInvalidLifecycleConfig.Error()
+			}
+			if errors.Is(err1, ErrInvalidReplicationRule) {
+				errorCode = 400
+				errorMessage = ErrInvalidReplicationRule.Error()
+			}
+			if errors.Is(err1, ErrInvalidBucketQuota) {
+				errorCode = 400
+				errorMessage = ErrInvalidBucketQuota.Error()
+			}
+			if errors.Is(err1, ErrInvalidCorsConfig) {
+				errorCode = 400
+				errorMessage = ErrInvalidCorsConfig.Error()
+			}
+			if errors.Is(err1, ErrInvalidDiffLocations) {
+				errorCode = 400
+				errorMessage = ErrInvalidDiffLocations.Error()
+			}
+			if errors.Is(err1, ErrInvalidInventoryFormat) {
+				errorCode = 400
+				errorMessage = ErrInvalidInventoryFormat.Error()
+			}
+			if errors.Is(err1, ErrInvalidBucketSettings) {
+				errorCode = 400
+				errorMessage = ErrInvalidBucketSettings.Error()
+			}
+			if errors.Is(err1, ErrBucketTemplateNotFound) {
+				errorCode = 404
+				errorMessage = ErrBucketTemplateNotFound.Error()
+			}
+			if errors.Is(err1, ErrInvalidBucketConfigBundle) {
+				errorCode = 400
+				errorMessage = ErrInvalidBucketConfigBundle.Error()
+			}
+			if errors.Is(err1, ErrBucketConfigConflict) {
+				errorCode = 409
+				errorMessage = ErrBucketConfigConflict.Error()
+			}
+			if errors.Is(err1, ErrMirrorJobNotFound) {
+				errorCode = 404
+				errorMessage = ErrMirrorJobNotFound.Error()
+			}
+			if errors.Is(err1, ErrInvalidMirrorJobState) {
+				errorCode = 409
+				errorMessage = ErrInvalidMirrorJobState.Error()
+			}
+			if errors.Is(err1, ErrInvalidRewindWindow) {
+				errorCode = 400
+				errorMessage = ErrInvalidRewindWindow.Error()
+			}
+			if errors.Is(err1, ErrSessionRegistryDisabled) {
+				errorCode = 501
+				errorMessage = ErrSessionRegistryDisabled.Error()
+			}
+			if errors.Is(err1, ErrSessionNotRenewable) {
+				errorCode = 400
+				errorMessage = ErrSessionNotRenewable.Error()
+			}
+			if errors.Is(err1, ErrSessionMaxLifetime) {
+				errorCode = 401
+				errorMessage = ErrSessionMaxLifetime.Error()
+			}
+			if errors.Is(err1, ErrSessionIdle) {
+				errorCode = 401
+				errorMessage = ErrSessionIdle.Error()
+			}
+			if errors.Is(err1, ErrTooManyLoginAttempts) {
+				errorCode = 429
+				errorMessage = ErrTooManyLoginAttempts.Error()
+			}
+			if errors.Is(err1, ErrSecondFactorDisabled) {
+				errorCode = 501
+				errorMessage = ErrSecondFactorDisabled.Error()
+			}
+			if errors.Is(err1, ErrSecondFactorUnavailable) {
+				errorCode = 400
+				errorMessage = ErrSecondFactorUnavailable.Error()
+			}
+			if errors.Is(err1, ErrSecondFactorEnrolled) {
+				errorCode = 409
+				errorMessage = ErrSecondFactorEnrolled.Error()
+			}
+			if errors.Is(err1, ErrSecondFactorNotEnrolled) {
+				errorCode = 404
+				errorMessage = ErrSecondFactorNotEnrolled.Error()
+			}
+			if errors.Is(err1, ErrSecondFactorRequired) {
+				errorCode = 403
+				errorMessage = ErrSecondFactorRequired.Error()
+			}
+			if errors.Is(err1, ErrInvalidSecondFactor) {
+				errorCode = 401
+				errorMessage = ErrInvalidSecondFactor.Error()
+			}
+			if errors.Is(err1, ErrSecondFactorUnreadable) {
+				errorCode = 500
+				errorMessage = ErrSecondFactorUnreadable.Error()
+			}
+			if errors.Is(err1, ErrSecondFactorLoginExpired) {
+				errorCode = 401
+				errorMessage = ErrSecondFactorLoginExpired.Error()
+			}
 			// Encryption not configured
 			if errors.Is(err1, ErrSSENotConfigured) {
 				errorCode = 404
@@ -248,7 +380,7 @@ func ErrorWithContext(ctx context.Context, err ...interface{}) *CodedAPIError {
 				errorCode = 403
 				errorMessage = err1.Error()
 			}
-			if err1.Error() == ErrFileTooLarge.Error() {
+			if errors.Is(err1, ErrFileTooLarge) {
 				errorCode = 413
 				errorMessage = err1.Error()
 			}
@@ -26,10 +26,56 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
 
 	"github.com/minio/console/models"
 )
 
+// LoginAcceptedCode is the HTTP code returned for type LoginAccepted
+const LoginAcceptedCode int = 202
+
+/*
+LoginAccepted The credentials are valid, a second factor is required to finish the login.
+
+swagger:response loginAccepted
+*/
+type LoginAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.LoginSecondFactorResponse `json:"body,omitempty"`
+}
+
+// NewLoginAccepted creates LoginAccepted with default headers values
+func NewLoginAccepted() *LoginAccepted {
+
+	return &LoginAccepted{}
+}
+
+// WithPayload adds the payload to the login accepted response
+func (o *LoginAccepted) WithPayload(payload *models.LoginSecondFactorResponse) *LoginAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the login accepted response
+func (o *LoginAccepted) SetPayload(payload *models.LoginSecondFactorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *LoginAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // LoginNoContentCode is the HTTP code returned for type LoginNoContent
 const LoginNoContentCode int = 204
 
@@ -55,6 +101,73 @@ func (o *LoginNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.
 	rw.WriteHeader(204)
 }
 
+// LoginTooManyRequestsCode is the HTTP code returned for type LoginTooManyRequests
+const LoginTooManyRequestsCode int = 429
+
+/*
+LoginTooManyRequests Too many failed logins, the client has to wait before trying again.
+
+swagger:response loginTooManyRequests
+*/
+type LoginTooManyRequests struct {
+	/*Seconds to wait before trying to log in again.
+
+	 */
+	RetryAfter int64 `json:"Retry-After"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIError `json:"body,omitempty"`
+}
+
+// NewLoginTooManyRequests creates LoginTooManyRequests with default headers values
+func NewLoginTooManyRequests() *LoginTooManyRequests {
+
+	return &LoginTooManyRequests{}
+}
+
+// WithRetryAfter adds the retryAfter to the login too many requests response
+func (o *LoginTooManyRequests) WithRetryAfter(retryAfter int64) *LoginTooManyRequests {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the login too many requests response
+func (o *LoginTooManyRequests) SetRetryAfter(retryAfter int64) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the login too many requests response
+func (o *LoginTooManyRequests) WithPayload(payload *models.APIError) *LoginTooManyRequests {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the login too many requests response
+func (o *LoginTooManyRequests) SetPayload(payload *models.APIError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *LoginTooManyRequests) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+
+	retryAfter := swag.FormatInt64(o.RetryAfter)
+	if retryAfter != "" {
+		rw.Header().Set("Retry-After", retryAfter)
+	}
+
+	rw.WriteHeader(429)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*
 LoginDefault Generic error response.
 
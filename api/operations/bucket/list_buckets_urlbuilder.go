@@ -26,11 +26,23 @@ import (
 	"errors"
 	"net/url"
 	golangswaggerpaths "path"
+
+	"github.com/go-openapi/swag"
 )
 
 // ListBucketsURL generates an URL for the list buckets operation
 type ListBucketsURL struct {
+	Limit     *int32
+	Offset    *int32
+	Prefix    *string
+	Search    *string
+	SortBy    *string
+	SortOrder *string
+	Tags      *string
+
 	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
 }
 
 // WithBasePath sets the base path for this url builder, only required when it's different from the
@@ -60,6 +72,66 @@ func (o *ListBucketsURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var limitQ string
+	if o.Limit != nil {
+		limitQ = swag.FormatInt32(*o.Limit)
+	}
+	if limitQ != "" {
+		qs.Set("limit", limitQ)
+	}
+
+	var offsetQ string
+	if o.Offset != nil {
+		offsetQ = swag.FormatInt32(*o.Offset)
+	}
+	if offsetQ != "" {
+		qs.Set("offset", offsetQ)
+	}
+
+	var prefixQ string
+	if o.Prefix != nil {
+		prefixQ = *o.Prefix
+	}
+	if prefixQ != "" {
+		qs.Set("prefix", prefixQ)
+	}
+
+	var searchQ string
+	if o.Search != nil {
+		searchQ = *o.Search
+	}
+	if searchQ != "" {
+		qs.Set("search", searchQ)
+	}
+
+	var sortByQ string
+	if o.SortBy != nil {
+		sortByQ = *o.SortBy
+	}
+	if sortByQ != "" {
+		qs.Set("sort_by", sortByQ)
+	}
+
+	var sortOrderQ string
+	if o.SortOrder != nil {
+		sortOrderQ = *o.SortOrder
+	}
+	if sortOrderQ != "" {
+		qs.Set("sort_order", sortOrderQ)
+	}
+
+	var tagsQ string
+	if o.Tags != nil {
+		tagsQ = *o.Tags
+	}
+	if tagsQ != "" {
+		qs.Set("tags", tagsQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
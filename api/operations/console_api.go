@@ -69,12 +69,39 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		BinProducer:  runtime.ByteStreamProducer(),
 		JSONProducer: runtime.JSONProducer(),
 
+		BucketAbortIncompleteUploadHandler: bucket.AbortIncompleteUploadHandlerFunc(func(params bucket.AbortIncompleteUploadParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.AbortIncompleteUpload has not yet been implemented")
+		}),
+		BucketAbortIncompleteUploadsHandler: bucket.AbortIncompleteUploadsHandlerFunc(func(params bucket.AbortIncompleteUploadsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.AbortIncompleteUploads has not yet been implemented")
+		}),
+		BucketAddBucketLifecycleHandler: bucket.AddBucketLifecycleHandlerFunc(func(params bucket.AddBucketLifecycleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.AddBucketLifecycle has not yet been implemented")
+		}),
+		BucketAddBucketReplicationRuleHandler: bucket.AddBucketReplicationRuleHandlerFunc(func(params bucket.AddBucketReplicationRuleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.AddBucketReplicationRule has not yet been implemented")
+		}),
 		SystemAdminInfoHandler: system.AdminInfoHandlerFunc(func(params system.AdminInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation system.AdminInfo has not yet been implemented")
 		}),
 		BucketBucketInfoHandler: bucket.BucketInfoHandlerFunc(func(params bucket.BucketInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.BucketInfo has not yet been implemented")
 		}),
+		BucketDeleteBucketCorsHandler: bucket.DeleteBucketCorsHandlerFunc(func(params bucket.DeleteBucketCorsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.DeleteBucketCors has not yet been implemented")
+		}),
+		BucketDeleteBucketLifecycleRuleHandler: bucket.DeleteBucketLifecycleRuleHandlerFunc(func(params bucket.DeleteBucketLifecycleRuleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.DeleteBucketLifecycleRule has not yet been implemented")
+		}),
+		BucketDeleteBucketQuotaHandler: bucket.DeleteBucketQuotaHandlerFunc(func(params bucket.DeleteBucketQuotaParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.DeleteBucketQuota has not yet been implemented")
+		}),
+		BucketDeleteBucketReplicationRuleHandler: bucket.DeleteBucketReplicationRuleHandlerFunc(func(params bucket.DeleteBucketReplicationRuleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.DeleteBucketReplicationRule has not yet been implemented")
+		}),
+		BucketDeleteMirrorJobHandler: bucket.DeleteMirrorJobHandlerFunc(func(params bucket.DeleteMirrorJobParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.DeleteMirrorJob has not yet been implemented")
+		}),
 		ObjectDeleteMultipleObjectsHandler: object.DeleteMultipleObjectsHandlerFunc(func(params object.DeleteMultipleObjectsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation object.DeleteMultipleObjects has not yet been implemented")
 		}),
@@ -84,15 +111,45 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		ObjectDownloadObjectHandler: object.DownloadObjectHandlerFunc(func(params object.DownloadObjectParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation object.DownloadObject has not yet been implemented")
 		}),
+		BucketDownloadBucketDiffHandler: bucket.DownloadBucketDiffHandlerFunc(func(params bucket.DownloadBucketDiffParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.DownloadBucketDiff has not yet been implemented")
+		}),
+		BucketDownloadBucketInventoryHandler: bucket.DownloadBucketInventoryHandlerFunc(func(params bucket.DownloadBucketInventoryParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.DownloadBucketInventory has not yet been implemented")
+		}),
 		ObjectDownloadMultipleObjectsHandler: object.DownloadMultipleObjectsHandlerFunc(func(params object.DownloadMultipleObjectsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation object.DownloadMultipleObjects has not yet been implemented")
 		}),
 		PublicDownloadSharedObjectHandler: public.DownloadSharedObjectHandlerFunc(func(params public.DownloadSharedObjectParams) middleware.Responder {
 			return middleware.NotImplemented("operation public.DownloadSharedObject has not yet been implemented")
 		}),
+		BucketExportBucketConfigHandler: bucket.ExportBucketConfigHandlerFunc(func(params bucket.ExportBucketConfigParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.ExportBucketConfig has not yet been implemented")
+		}),
+		BucketExportBucketInventoryHandler: bucket.ExportBucketInventoryHandlerFunc(func(params bucket.ExportBucketInventoryParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.ExportBucketInventory has not yet been implemented")
+		}),
+		BucketExportBucketLifecycleHandler: bucket.ExportBucketLifecycleHandlerFunc(func(params bucket.ExportBucketLifecycleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.ExportBucketLifecycle has not yet been implemented")
+		}),
+		BucketGetBucketCorsHandler: bucket.GetBucketCorsHandlerFunc(func(params bucket.GetBucketCorsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.GetBucketCors has not yet been implemented")
+		}),
+		BucketGetBucketLifecycleHandler: bucket.GetBucketLifecycleHandlerFunc(func(params bucket.GetBucketLifecycleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.GetBucketLifecycle has not yet been implemented")
+		}),
 		BucketGetBucketQuotaHandler: bucket.GetBucketQuotaHandlerFunc(func(params bucket.GetBucketQuotaParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.GetBucketQuota has not yet been implemented")
 		}),
+		BucketGetBucketReplicationHandler: bucket.GetBucketReplicationHandlerFunc(func(params bucket.GetBucketReplicationParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.GetBucketReplication has not yet been implemented")
+		}),
+		BucketGetBucketReplicationMetricsHandler: bucket.GetBucketReplicationMetricsHandlerFunc(func(params bucket.GetBucketReplicationMetricsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.GetBucketReplicationMetrics has not yet been implemented")
+		}),
+		BucketGetBucketRetentionConfigHandler: bucket.GetBucketRetentionConfigHandlerFunc(func(params bucket.GetBucketRetentionConfigParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.GetBucketRetentionConfig has not yet been implemented")
+		}),
 		BucketGetBucketRewindHandler: bucket.GetBucketRewindHandlerFunc(func(params bucket.GetBucketRewindParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.GetBucketRewind has not yet been implemented")
 		}),
@@ -102,15 +159,39 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		BucketGetMaxShareLinkExpHandler: bucket.GetMaxShareLinkExpHandlerFunc(func(params bucket.GetMaxShareLinkExpParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.GetMaxShareLinkExp has not yet been implemented")
 		}),
+		BucketGetMirrorJobHandler: bucket.GetMirrorJobHandlerFunc(func(params bucket.GetMirrorJobParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.GetMirrorJob has not yet been implemented")
+		}),
 		ObjectGetObjectMetadataHandler: object.GetObjectMetadataHandlerFunc(func(params object.GetObjectMetadataParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation object.GetObjectMetadata has not yet been implemented")
 		}),
+		ObjectGetObjectVersionHistoryHandler: object.GetObjectVersionHistoryHandlerFunc(func(params object.GetObjectVersionHistoryParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation object.GetObjectVersionHistory has not yet been implemented")
+		}),
+		BucketImportBucketConfigHandler: bucket.ImportBucketConfigHandlerFunc(func(params bucket.ImportBucketConfigParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.ImportBucketConfig has not yet been implemented")
+		}),
+		BucketImportBucketLifecycleHandler: bucket.ImportBucketLifecycleHandlerFunc(func(params bucket.ImportBucketLifecycleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.ImportBucketLifecycle has not yet been implemented")
+		}),
 		LicenseLicenseAcknowledgeHandler: license.LicenseAcknowledgeHandlerFunc(func(params license.LicenseAcknowledgeParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation license.LicenseAcknowledge has not yet been implemented")
 		}),
+		AuthListActiveSessionsHandler: auth.ListActiveSessionsHandlerFunc(func(params auth.ListActiveSessionsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation auth.ListActiveSessions has not yet been implemented")
+		}),
+		BucketListBucketTemplatesHandler: bucket.ListBucketTemplatesHandlerFunc(func(params bucket.ListBucketTemplatesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.ListBucketTemplates has not yet been implemented")
+		}),
 		BucketListBucketsHandler: bucket.ListBucketsHandlerFunc(func(params bucket.ListBucketsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.ListBuckets has not yet been implemented")
 		}),
+		BucketListIncompleteUploadsHandler: bucket.ListIncompleteUploadsHandlerFunc(func(params bucket.ListIncompleteUploadsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.ListIncompleteUploads has not yet been implemented")
+		}),
+		BucketListMirrorJobsHandler: bucket.ListMirrorJobsHandlerFunc(func(params bucket.ListMirrorJobsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.ListMirrorJobs has not yet been implemented")
+		}),
 		ObjectListObjectsHandler: object.ListObjectsHandlerFunc(func(params object.ListObjectsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation object.ListObjects has not yet been implemented")
 		}),
@@ -120,30 +201,87 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		AuthLoginDetailHandler: auth.LoginDetailHandlerFunc(func(params auth.LoginDetailParams) middleware.Responder {
 			return middleware.NotImplemented("operation auth.LoginDetail has not yet been implemented")
 		}),
+		AuthLoginOauth2AuthHandler: auth.LoginOauth2AuthHandlerFunc(func(params auth.LoginOauth2AuthParams) middleware.Responder {
+			return middleware.NotImplemented("operation auth.LoginOauth2Auth has not yet been implemented")
+		}),
+		AuthLoginSecondFactorHandler: auth.LoginSecondFactorHandlerFunc(func(params auth.LoginSecondFactorParams) middleware.Responder {
+			return middleware.NotImplemented("operation auth.LoginSecondFactor has not yet been implemented")
+		}),
+		AuthLoginSecondFactorEnrollHandler: auth.LoginSecondFactorEnrollHandlerFunc(func(params auth.LoginSecondFactorEnrollParams) middleware.Responder {
+			return middleware.NotImplemented("operation auth.LoginSecondFactorEnroll has not yet been implemented")
+		}),
 		AuthLogoutHandler: auth.LogoutHandlerFunc(func(params auth.LogoutParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation auth.Logout has not yet been implemented")
 		}),
 		BucketMakeBucketHandler: bucket.MakeBucketHandlerFunc(func(params bucket.MakeBucketParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.MakeBucket has not yet been implemented")
 		}),
+		BucketPauseMirrorJobHandler: bucket.PauseMirrorJobHandlerFunc(func(params bucket.PauseMirrorJobParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.PauseMirrorJob has not yet been implemented")
+		}),
 		ObjectPostBucketsBucketNameObjectsUploadHandler: object.PostBucketsBucketNameObjectsUploadHandlerFunc(func(params object.PostBucketsBucketNameObjectsUploadParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation object.PostBucketsBucketNameObjectsUpload has not yet been implemented")
 		}),
+		ObjectPruneObjectVersionsHandler: object.PruneObjectVersionsHandlerFunc(func(params object.PruneObjectVersionsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation object.PruneObjectVersions has not yet been implemented")
+		}),
 		ObjectPutObjectRestoreHandler: object.PutObjectRestoreHandlerFunc(func(params object.PutObjectRestoreParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation object.PutObjectRestore has not yet been implemented")
 		}),
 		ObjectPutObjectTagsHandler: object.PutObjectTagsHandlerFunc(func(params object.PutObjectTagsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation object.PutObjectTags has not yet been implemented")
 		}),
+		BucketResumeMirrorJobHandler: bucket.ResumeMirrorJobHandlerFunc(func(params bucket.ResumeMirrorJobParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.ResumeMirrorJob has not yet been implemented")
+		}),
+		AuthRevokeActiveSessionHandler: auth.RevokeActiveSessionHandlerFunc(func(params auth.RevokeActiveSessionParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation auth.RevokeActiveSession has not yet been implemented")
+		}),
+		AuthRevokeActiveSessionsHandler: auth.RevokeActiveSessionsHandlerFunc(func(params auth.RevokeActiveSessionsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation auth.RevokeActiveSessions has not yet been implemented")
+		}),
+		AuthSecondFactorConfirmHandler: auth.SecondFactorConfirmHandlerFunc(func(params auth.SecondFactorConfirmParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation auth.SecondFactorConfirm has not yet been implemented")
+		}),
+		AuthSecondFactorDisableHandler: auth.SecondFactorDisableHandlerFunc(func(params auth.SecondFactorDisableParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation auth.SecondFactorDisable has not yet been implemented")
+		}),
+		AuthSecondFactorEnrollHandler: auth.SecondFactorEnrollHandlerFunc(func(params auth.SecondFactorEnrollParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation auth.SecondFactorEnroll has not yet been implemented")
+		}),
+		AuthSecondFactorStatusHandler: auth.SecondFactorStatusHandlerFunc(func(params auth.SecondFactorStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation auth.SecondFactorStatus has not yet been implemented")
+		}),
 		AuthSessionCheckHandler: auth.SessionCheckHandlerFunc(func(params auth.SessionCheckParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation auth.SessionCheck has not yet been implemented")
 		}),
+		AuthSessionRefreshHandler: auth.SessionRefreshHandlerFunc(func(params auth.SessionRefreshParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation auth.SessionRefresh has not yet been implemented")
+		}),
+		BucketSetBucketCorsHandler: bucket.SetBucketCorsHandlerFunc(func(params bucket.SetBucketCorsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.SetBucketCors has not yet been implemented")
+		}),
+		BucketSetBucketQuotaHandler: bucket.SetBucketQuotaHandlerFunc(func(params bucket.SetBucketQuotaParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.SetBucketQuota has not yet been implemented")
+		}),
+		BucketSetBucketRetentionConfigHandler: bucket.SetBucketRetentionConfigHandlerFunc(func(params bucket.SetBucketRetentionConfigParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.SetBucketRetentionConfig has not yet been implemented")
+		}),
 		BucketSetBucketVersioningHandler: bucket.SetBucketVersioningHandlerFunc(func(params bucket.SetBucketVersioningParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.SetBucketVersioning has not yet been implemented")
 		}),
 		ObjectShareObjectHandler: object.ShareObjectHandlerFunc(func(params object.ShareObjectParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation object.ShareObject has not yet been implemented")
 		}),
+		BucketStartBucketMirrorHandler: bucket.StartBucketMirrorHandlerFunc(func(params bucket.StartBucketMirrorParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.StartBucketMirror has not yet been implemented")
+		}),
+		BucketTestBucketCorsHandler: bucket.TestBucketCorsHandlerFunc(func(params bucket.TestBucketCorsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.TestBucketCors has not yet been implemented")
+		}),
+		BucketUpdateBucketLifecycleHandler: bucket.UpdateBucketLifecycleHandlerFunc(func(params bucket.UpdateBucketLifecycleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.UpdateBucketLifecycle has not yet been implemented")
+		}),
 
 		// Applies when the "X-Anonymous" header is set
 		AnonymousAuth: func(token string) (*models.Principal, error) {
@@ -207,56 +345,148 @@ type ConsoleAPI struct {
 	// APIAuthorizer provides access control (ACL/RBAC/ABAC) by providing access to the request and authenticated principal
 	APIAuthorizer runtime.Authorizer
 
+	// BucketAbortIncompleteUploadHandler sets the operation handler for the abort incomplete upload operation
+	BucketAbortIncompleteUploadHandler bucket.AbortIncompleteUploadHandler
+	// BucketAbortIncompleteUploadsHandler sets the operation handler for the abort incomplete uploads operation
+	BucketAbortIncompleteUploadsHandler bucket.AbortIncompleteUploadsHandler
+	// BucketAddBucketLifecycleHandler sets the operation handler for the add bucket lifecycle operation
+	BucketAddBucketLifecycleHandler bucket.AddBucketLifecycleHandler
+	// BucketAddBucketReplicationRuleHandler sets the operation handler for the add bucket replication rule operation
+	BucketAddBucketReplicationRuleHandler bucket.AddBucketReplicationRuleHandler
 	// SystemAdminInfoHandler sets the operation handler for the admin info operation
 	SystemAdminInfoHandler system.AdminInfoHandler
 	// BucketBucketInfoHandler sets the operation handler for the bucket info operation
 	BucketBucketInfoHandler bucket.BucketInfoHandler
+	// BucketDeleteBucketCorsHandler sets the operation handler for the delete bucket cors operation
+	BucketDeleteBucketCorsHandler bucket.DeleteBucketCorsHandler
+	// BucketDeleteBucketLifecycleRuleHandler sets the operation handler for the delete bucket lifecycle rule operation
+	BucketDeleteBucketLifecycleRuleHandler bucket.DeleteBucketLifecycleRuleHandler
+	// BucketDeleteBucketQuotaHandler sets the operation handler for the delete bucket quota operation
+	BucketDeleteBucketQuotaHandler bucket.DeleteBucketQuotaHandler
+	// BucketDeleteBucketReplicationRuleHandler sets the operation handler for the delete bucket replication rule operation
+	BucketDeleteBucketReplicationRuleHandler bucket.DeleteBucketReplicationRuleHandler
+	// BucketDeleteMirrorJobHandler sets the operation handler for the delete mirror job operation
+	BucketDeleteMirrorJobHandler bucket.DeleteMirrorJobHandler
 	// ObjectDeleteMultipleObjectsHandler sets the operation handler for the delete multiple objects operation
 	ObjectDeleteMultipleObjectsHandler object.DeleteMultipleObjectsHandler
 	// ObjectDeleteObjectHandler sets the operation handler for the delete object operation
 	ObjectDeleteObjectHandler object.DeleteObjectHandler
 	// ObjectDownloadObjectHandler sets the operation handler for the download object operation
 	ObjectDownloadObjectHandler object.DownloadObjectHandler
+	// BucketDownloadBucketDiffHandler sets the operation handler for the download bucket diff operation
+	BucketDownloadBucketDiffHandler bucket.DownloadBucketDiffHandler
+	// BucketDownloadBucketInventoryHandler sets the operation handler for the download bucket inventory operation
+	BucketDownloadBucketInventoryHandler bucket.DownloadBucketInventoryHandler
 	// ObjectDownloadMultipleObjectsHandler sets the operation handler for the download multiple objects operation
 	ObjectDownloadMultipleObjectsHandler object.DownloadMultipleObjectsHandler
 	// PublicDownloadSharedObjectHandler sets the operation handler for the download shared object operation
 	PublicDownloadSharedObjectHandler public.DownloadSharedObjectHandler
+	// BucketExportBucketConfigHandler sets the operation handler for the export bucket config operation
+	BucketExportBucketConfigHandler bucket.ExportBucketConfigHandler
+	// BucketExportBucketInventoryHandler sets the operation handler for the export bucket inventory operation
+	BucketExportBucketInventoryHandler bucket.ExportBucketInventoryHandler
+	// BucketExportBucketLifecycleHandler sets the operation handler for the export bucket lifecycle operation
+	BucketExportBucketLifecycleHandler bucket.ExportBucketLifecycleHandler
+	// BucketGetBucketCorsHandler sets the operation handler for the get bucket cors operation
+	BucketGetBucketCorsHandler bucket.GetBucketCorsHandler
+	// BucketGetBucketLifecycleHandler sets the operation handler for the get bucket lifecycle operation
+	BucketGetBucketLifecycleHandler bucket.GetBucketLifecycleHandler
 	// BucketGetBucketQuotaHandler sets the operation handler for the get bucket quota operation
 	BucketGetBucketQuotaHandler bucket.GetBucketQuotaHandler
+	// BucketGetBucketReplicationHandler sets the operation handler for the get bucket replication operation
+	BucketGetBucketReplicationHandler bucket.GetBucketReplicationHandler
+	// BucketGetBucketReplicationMetricsHandler sets the operation handler for the get bucket replication metrics operation
+	BucketGetBucketReplicationMetricsHandler bucket.GetBucketReplicationMetricsHandler
+	// BucketGetBucketRetentionConfigHandler sets the operation handler for the get bucket retention config operation
+	BucketGetBucketRetentionConfigHandler bucket.GetBucketRetentionConfigHandler
 	// BucketGetBucketRewindHandler sets the operation handler for the get bucket rewind operation
 	BucketGetBucketRewindHandler bucket.GetBucketRewindHandler
 	// BucketGetBucketVersioningHandler sets the operation handler for the get bucket versioning operation
 	BucketGetBucketVersioningHandler bucket.GetBucketVersioningHandler
 	// BucketGetMaxShareLinkExpHandler sets the operation handler for the get max share link exp operation
 	BucketGetMaxShareLinkExpHandler bucket.GetMaxShareLinkExpHandler
+	// BucketGetMirrorJobHandler sets the operation handler for the get mirror job operation
+	BucketGetMirrorJobHandler bucket.GetMirrorJobHandler
 	// ObjectGetObjectMetadataHandler sets the operation handler for the get object metadata operation
 	ObjectGetObjectMetadataHandler object.GetObjectMetadataHandler
+	// ObjectGetObjectVersionHistoryHandler sets the operation handler for the get object version history operation
+	ObjectGetObjectVersionHistoryHandler object.GetObjectVersionHistoryHandler
+	// BucketImportBucketConfigHandler sets the operation handler for the import bucket config operation
+	BucketImportBucketConfigHandler bucket.ImportBucketConfigHandler
+	// BucketImportBucketLifecycleHandler sets the operation handler for the import bucket lifecycle operation
+	BucketImportBucketLifecycleHandler bucket.ImportBucketLifecycleHandler
 	// LicenseLicenseAcknowledgeHandler sets the operation handler for the license acknowledge operation
 	LicenseLicenseAcknowledgeHandler license.LicenseAcknowledgeHandler
+	// AuthListActiveSessionsHandler sets the operation handler for the list active sessions operation
+	AuthListActiveSessionsHandler auth.ListActiveSessionsHandler
+	// BucketListBucketTemplatesHandler sets the operation handler for the list bucket templates operation
+	BucketListBucketTemplatesHandler bucket.ListBucketTemplatesHandler
 	// BucketListBucketsHandler sets the operation handler for the list buckets operation
 	BucketListBucketsHandler bucket.ListBucketsHandler
+	// BucketListIncompleteUploadsHandler sets the operation handler for the list incomplete uploads operation
+	BucketListIncompleteUploadsHandler bucket.ListIncompleteUploadsHandler
+	// BucketListMirrorJobsHandler sets the operation handler for the list mirror jobs operation
+	BucketListMirrorJobsHandler bucket.ListMirrorJobsHandler
 	// ObjectListObjectsHandler sets the operation handler for the list objects operation
 	ObjectListObjectsHandler object.ListObjectsHandler
 	// AuthLoginHandler sets the operation handler for the login operation
 	AuthLoginHandler auth.LoginHandler
 	// AuthLoginDetailHandler sets the operation handler for the login detail operation
 	AuthLoginDetailHandler auth.LoginDetailHandler
+	// AuthLoginOauth2AuthHandler sets the operation handler for the login oauth2 auth operation
+	AuthLoginOauth2AuthHandler auth.LoginOauth2AuthHandler
+	// AuthLoginSecondFactorHandler sets the operation handler for the login second factor operation
+	AuthLoginSecondFactorHandler auth.LoginSecondFactorHandler
+	// AuthLoginSecondFactorEnrollHandler sets the operation handler for the login second factor enroll operation
+	AuthLoginSecondFactorEnrollHandler auth.LoginSecondFactorEnrollHandler
 	// AuthLogoutHandler sets the operation handler for the logout operation
 	AuthLogoutHandler auth.LogoutHandler
 	// BucketMakeBucketHandler sets the operation handler for the make bucket operation
 	BucketMakeBucketHandler bucket.MakeBucketHandler
+	// BucketPauseMirrorJobHandler sets the operation handler for the pause mirror job operation
+	BucketPauseMirrorJobHandler bucket.PauseMirrorJobHandler
 	// ObjectPostBucketsBucketNameObjectsUploadHandler sets the operation handler for the post buckets bucket name objects upload operation
 	ObjectPostBucketsBucketNameObjectsUploadHandler object.PostBucketsBucketNameObjectsUploadHandler
+	// ObjectPruneObjectVersionsHandler sets the operation handler for the prune object versions operation
+	ObjectPruneObjectVersionsHandler object.PruneObjectVersionsHandler
 	// ObjectPutObjectRestoreHandler sets the operation handler for the put object restore operation
 	ObjectPutObjectRestoreHandler object.PutObjectRestoreHandler
 	// ObjectPutObjectTagsHandler sets the operation handler for the put object tags operation
 	ObjectPutObjectTagsHandler object.PutObjectTagsHandler
+	// BucketResumeMirrorJobHandler sets the operation handler for the resume mirror job operation
+	BucketResumeMirrorJobHandler bucket.ResumeMirrorJobHandler
+	// AuthRevokeActiveSessionHandler sets the operation handler for the revoke active session operation
+	AuthRevokeActiveSessionHandler auth.RevokeActiveSessionHandler
+	// AuthRevokeActiveSessionsHandler sets the operation handler for the revoke active sessions operation
+	AuthRevokeActiveSessionsHandler auth.RevokeActiveSessionsHandler
+	// AuthSecondFactorConfirmHandler sets the operation handler for the second factor confirm operation
+	AuthSecondFactorConfirmHandler auth.SecondFactorConfirmHandler
+	// AuthSecondFactorDisableHandler sets the operation handler for the second factor disable operation
+	AuthSecondFactorDisableHandler auth.SecondFactorDisableHandler
+	// AuthSecondFactorEnrollHandler sets the operation handler for the second factor enroll operation
+	AuthSecondFactorEnrollHandler auth.SecondFactorEnrollHandler
+	// AuthSecondFactorStatusHandler sets the operation handler for the second factor status operation
+	AuthSecondFactorStatusHandler auth.SecondFactorStatusHandler
 	// AuthSessionCheckHandler sets the operation handler for the session check operation
 	AuthSessionCheckHandler auth.SessionCheckHandler
+	// AuthSessionRefreshHandler sets the operation handler for the session refresh operation
+	AuthSessionRefreshHandler auth.SessionRefreshHandler
+	// BucketSetBucketCorsHandler sets the operation handler for the set bucket cors operation
+	BucketSetBucketCorsHandler bucket.SetBucketCorsHandler
+	// BucketSetBucketQuotaHandler sets the operation handler for the set bucket quota operation
+	BucketSetBucketQuotaHandler bucket.SetBucketQuotaHandler
+	// BucketSetBucketRetentionConfigHandler sets the operation handler for the set bucket retention config operation
+	BucketSetBucketRetentionConfigHandler bucket.SetBucketRetentionConfigHandler
 	// BucketSetBucketVersioningHandler sets the operation handler for the set bucket versioning operation
 	BucketSetBucketVersioningHandler bucket.SetBucketVersioningHandler
 	// ObjectShareObjectHandler sets the operation handler for the share object operation
 	ObjectShareObjectHandler object.ShareObjectHandler
+	// BucketStartBucketMirrorHandler sets the operation handler for the start bucket mirror operation
+	BucketStartBucketMirrorHandler bucket.StartBucketMirrorHandler
+	// BucketTestBucketCorsHandler sets the operation handler for the test bucket cors operation
+	BucketTestBucketCorsHandler bucket.TestBucketCorsHandler
+	// BucketUpdateBucketLifecycleHandler sets the operation handler for the update bucket lifecycle operation
+	BucketUpdateBucketLifecycleHandler bucket.UpdateBucketLifecycleHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -347,12 +577,39 @@ func (o *ConsoleAPI) Validate() error {
 		unregistered = append(unregistered, "KeyAuth")
 	}
 
+	if o.BucketAbortIncompleteUploadHandler == nil {
+		unregistered = append(unregistered, "bucket.AbortIncompleteUploadHandler")
+	}
+	if o.BucketAbortIncompleteUploadsHandler == nil {
+		unregistered = append(unregistered, "bucket.AbortIncompleteUploadsHandler")
+	}
+	if o.BucketAddBucketLifecycleHandler == nil {
+		unregistered = append(unregistered, "bucket.AddBucketLifecycleHandler")
+	}
+	if o.BucketAddBucketReplicationRuleHandler == nil {
+		unregistered = append(unregistered, "bucket.AddBucketReplicationRuleHandler")
+	}
 	if o.SystemAdminInfoHandler == nil {
 		unregistered = append(unregistered, "system.AdminInfoHandler")
 	}
 	if o.BucketBucketInfoHandler == nil {
 		unregistered = append(unregistered, "bucket.BucketInfoHandler")
 	}
+	if o.BucketDeleteBucketCorsHandler == nil {
+		unregistered = append(unregistered, "bucket.DeleteBucketCorsHandler")
+	}
+	if o.BucketDeleteBucketLifecycleRuleHandler == nil {
+		unregistered = append(unregistered, "bucket.DeleteBucketLifecycleRuleHandler")
+	}
+	if o.BucketDeleteBucketQuotaHandler == nil {
+		unregistered = append(unregistered, "bucket.DeleteBucketQuotaHandler")
+	}
+	if o.BucketDeleteBucketReplicationRuleHandler == nil {
+		unregistered = append(unregistered, "bucket.DeleteBucketReplicationRuleHandler")
+	}
+	if o.BucketDeleteMirrorJobHandler == nil {
+		unregistered = append(unregistered, "bucket.DeleteMirrorJobHandler")
+	}
 	if o.ObjectDeleteMultipleObjectsHandler == nil {
 		unregistered = append(unregistered, "object.DeleteMultipleObjectsHandler")
 	}
@@ -362,15 +619,45 @@ func (o *ConsoleAPI) Validate() error {
 	if o.ObjectDownloadObjectHandler == nil {
 		unregistered = append(unregistered, "object.DownloadObjectHandler")
 	}
+	if o.BucketDownloadBucketDiffHandler == nil {
+		unregistered = append(unregistered, "bucket.DownloadBucketDiffHandler")
+	}
+	if o.BucketDownloadBucketInventoryHandler == nil {
+		unregistered = append(unregistered, "bucket.DownloadBucketInventoryHandler")
+	}
 	if o.ObjectDownloadMultipleObjectsHandler == nil {
 		unregistered = append(unregistered, "object.DownloadMultipleObjectsHandler")
 	}
 	if o.PublicDownloadSharedObjectHandler == nil {
 		unregistered = append(unregistered, "public.DownloadSharedObjectHandler")
 	}
+	if o.BucketExportBucketConfigHandler == nil {
+		unregistered = append(unregistered, "bucket.ExportBucketConfigHandler")
+	}
+	if o.BucketExportBucketInventoryHandler == nil {
+		unregistered = append(unregistered, "bucket.ExportBucketInventoryHandler")
+	}
+	if o.BucketExportBucketLifecycleHandler == nil {
+		unregistered = append(unregistered, "bucket.ExportBucketLifecycleHandler")
+	}
+	if o.BucketGetBucketCorsHandler == nil {
+		unregistered = append(unregistered, "bucket.GetBucketCorsHandler")
+	}
+	if o.BucketGetBucketLifecycleHandler == nil {
+		unregistered = append(unregistered, "bucket.GetBucketLifecycleHandler")
+	}
 	if o.BucketGetBucketQuotaHandler == nil {
 		unregistered = append(unregistered, "bucket.GetBucketQuotaHandler")
 	}
+	if o.BucketGetBucketReplicationHandler == nil {
+		unregistered = append(unregistered, "bucket.GetBucketReplicationHandler")
+	}
+	if o.BucketGetBucketReplicationMetricsHandler == nil {
+		unregistered = append(unregistered, "bucket.GetBucketReplicationMetricsHandler")
+	}
+	if o.BucketGetBucketRetentionConfigHandler == nil {
+		unregistered = append(unregistered, "bucket.GetBucketRetentionConfigHandler")
+	}
 	if o.BucketGetBucketRewindHandler == nil {
 		unregistered = append(unregistered, "bucket.GetBucketRewindHandler")
 	}
@@ -380,15 +667,39 @@ func (o *ConsoleAPI) Validate() error {
 	if o.BucketGetMaxShareLinkExpHandler == nil {
 		unregistered = append(unregistered, "bucket.GetMaxShareLinkExpHandler")
 	}
+	if o.BucketGetMirrorJobHandler == nil {
+		unregistered = append(unregistered, "bucket.GetMirrorJobHandler")
+	}
 	if o.ObjectGetObjectMetadataHandler == nil {
 		unregistered = append(unregistered, "object.GetObjectMetadataHandler")
 	}
+	if o.ObjectGetObjectVersionHistoryHandler == nil {
+		unregistered = append(unregistered, "object.GetObjectVersionHistoryHandler")
+	}
+	if o.BucketImportBucketConfigHandler == nil {
+		unregistered = append(unregistered, "bucket.ImportBucketConfigHandler")
+	}
+	if o.BucketImportBucketLifecycleHandler == nil {
+		unregistered = append(unregistered, "bucket.ImportBucketLifecycleHandler")
+	}
 	if o.LicenseLicenseAcknowledgeHandler == nil {
 		unregistered = append(unregistered, "license.LicenseAcknowledgeHandler")
 	}
+	if o.AuthListActiveSessionsHandler == nil {
+		unregistered = append(unregistered, "auth.ListActiveSessionsHandler")
+	}
+	if o.BucketListBucketTemplatesHandler == nil {
+		unregistered = append(unregistered, "bucket.ListBucketTemplatesHandler")
+	}
 	if o.BucketListBucketsHandler == nil {
 		unregistered = append(unregistered, "bucket.ListBucketsHandler")
 	}
+	if o.BucketListIncompleteUploadsHandler == nil {
+		unregistered = append(unregistered, "bucket.ListIncompleteUploadsHandler")
+	}
+	if o.BucketListMirrorJobsHandler == nil {
+		unregistered = append(unregistered, "bucket.ListMirrorJobsHandler")
+	}
 	if o.ObjectListObjectsHandler == nil {
 		unregistered = append(unregistered, "object.ListObjectsHandler")
 	}
@@ -398,30 +709,87 @@ func (o *ConsoleAPI) Validate() error {
 	if o.AuthLoginDetailHandler == nil {
 		unregistered = append(unregistered, "auth.LoginDetailHandler")
 	}
+	if o.AuthLoginOauth2AuthHandler == nil {
+		unregistered = append(unregistered, "auth.LoginOauth2AuthHandler")
+	}
+	if o.AuthLoginSecondFactorHandler == nil {
+		unregistered = append(unregistered, "auth.LoginSecondFactorHandler")
+	}
+	if o.AuthLoginSecondFactorEnrollHandler == nil {
+		unregistered = append(unregistered, "auth.LoginSecondFactorEnrollHandler")
+	}
 	if o.AuthLogoutHandler == nil {
 		unregistered = append(unregistered, "auth.LogoutHandler")
 	}
 	if o.BucketMakeBucketHandler == nil {
 		unregistered = append(unregistered, "bucket.MakeBucketHandler")
 	}
+	if o.BucketPauseMirrorJobHandler == nil {
+		unregistered = append(unregistered, "bucket.PauseMirrorJobHandler")
+	}
 	if o.ObjectPostBucketsBucketNameObjectsUploadHandler == nil {
 		unregistered = append(unregistered, "object.PostBucketsBucketNameObjectsUploadHandler")
 	}
+	if o.ObjectPruneObjectVersionsHandler == nil {
+		unregistered = append(unregistered, "object.PruneObjectVersionsHandler")
+	}
 	if o.ObjectPutObjectRestoreHandler == nil {
 		unregistered = append(unregistered, "object.PutObjectRestoreHandler")
 	}
 	if o.ObjectPutObjectTagsHandler == nil {
 		unregistered = append(unregistered, "object.PutObjectTagsHandler")
 	}
+	if o.BucketResumeMirrorJobHandler == nil {
+		unregistered = append(unregistered, "bucket.ResumeMirrorJobHandler")
+	}
+	if o.AuthRevokeActiveSessionHandler == nil {
+		unregistered = append(unregistered, "auth.RevokeActiveSessionHandler")
+	}
+	if o.AuthRevokeActiveSessionsHandler == nil {
+		unregistered = append(unregistered, "auth.RevokeActiveSessionsHandler")
+	}
+	if o.AuthSecondFactorConfirmHandler == nil {
+		unregistered = append(unregistered, "auth.SecondFactorConfirmHandler")
+	}
+	if o.AuthSecondFactorDisableHandler == nil {
+		unregistered = append(unregistered, "auth.SecondFactorDisableHandler")
+	}
+	if o.AuthSecondFactorEnrollHandler == nil {
+		unregistered = append(unregistered, "auth.SecondFactorEnrollHandler")
+	}
+	if o.AuthSecondFactorStatusHandler == nil {
+		unregistered = append(unregistered, "auth.SecondFactorStatusHandler")
+	}
 	if o.AuthSessionCheckHandler == nil {
 		unregistered = append(unregistered, "auth.SessionCheckHandler")
 	}
+	if o.AuthSessionRefreshHandler == nil {
+		unregistered = append(unregistered, "auth.SessionRefreshHandler")
+	}
+	if o.BucketSetBucketCorsHandler == nil {
+		unregistered = append(unregistered, "bucket.SetBucketCorsHandler")
+	}
+	if o.BucketSetBucketQuotaHandler == nil {
+		unregistered = append(unregistered, "bucket.SetBucketQuotaHandler")
+	}
+	if o.BucketSetBucketRetentionConfigHandler == nil {
+		unregistered = append(unregistered, "bucket.SetBucketRetentionConfigHandler")
+	}
 	if o.BucketSetBucketVersioningHandler == nil {
 		unregistered = append(unregistered, "bucket.SetBucketVersioningHandler")
 	}
 	if o.ObjectShareObjectHandler == nil {
 		unregistered = append(unregistered, "object.ShareObjectHandler")
 	}
+	if o.BucketStartBucketMirrorHandler == nil {
+		unregistered = append(unregistered, "bucket.StartBucketMirrorHandler")
+	}
+	if o.BucketTestBucketCorsHandler == nil {
+		unregistered = append(unregistered, "bucket.TestBucketCorsHandler")
+	}
+	if o.BucketUpdateBucketLifecycleHandler == nil {
+		unregistered = append(unregistered, "bucket.UpdateBucketLifecycleHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -530,6 +898,22 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/buckets/{bucket_name}/incomplete-uploads"] = bucket.NewAbortIncompleteUpload(o.context, o.BucketAbortIncompleteUploadHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/incomplete-uploads/abort"] = bucket.NewAbortIncompleteUploads(o.context, o.BucketAbortIncompleteUploadsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/lifecycle"] = bucket.NewAddBucketLifecycle(o.context, o.BucketAddBucketLifecycleHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/replication"] = bucket.NewAddBucketReplicationRule(o.context, o.BucketAddBucketReplicationRuleHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -538,6 +922,26 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/buckets/{name}"] = bucket.NewBucketInfo(o.context, o.BucketBucketInfoHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/buckets/{bucket_name}/cors"] = bucket.NewDeleteBucketCors(o.context, o.BucketDeleteBucketCorsHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/buckets/{bucket_name}/lifecycle/{lifecycle_id}"] = bucket.NewDeleteBucketLifecycleRule(o.context, o.BucketDeleteBucketLifecycleRuleHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/buckets/{name}/quota"] = bucket.NewDeleteBucketQuota(o.context, o.BucketDeleteBucketQuotaHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/buckets/{bucket_name}/replication/{rule_id}"] = bucket.NewDeleteBucketReplicationRule(o.context, o.BucketDeleteBucketReplicationRuleHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/buckets/mirror-jobs/{job_id}"] = bucket.NewDeleteMirrorJob(o.context, o.BucketDeleteMirrorJobHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -550,6 +954,14 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/buckets/{bucket_name}/objects/download"] = object.NewDownloadObject(o.context, o.ObjectDownloadObjectHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/buckets/{bucket_name}/diff"] = bucket.NewDownloadBucketDiff(o.context, o.BucketDownloadBucketDiffHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/buckets/{bucket_name}/inventory"] = bucket.NewDownloadBucketInventory(o.context, o.BucketDownloadBucketInventoryHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -561,10 +973,42 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/buckets/{bucket_name}/config-export"] = bucket.NewExportBucketConfig(o.context, o.BucketExportBucketConfigHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/inventory"] = bucket.NewExportBucketInventory(o.context, o.BucketExportBucketInventoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/buckets/{bucket_name}/lifecycle-export"] = bucket.NewExportBucketLifecycle(o.context, o.BucketExportBucketLifecycleHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/buckets/{bucket_name}/cors"] = bucket.NewGetBucketCors(o.context, o.BucketGetBucketCorsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/buckets/{bucket_name}/lifecycle"] = bucket.NewGetBucketLifecycle(o.context, o.BucketGetBucketLifecycleHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/buckets/{name}/quota"] = bucket.NewGetBucketQuota(o.context, o.BucketGetBucketQuotaHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/buckets/{bucket_name}/replication"] = bucket.NewGetBucketReplication(o.context, o.BucketGetBucketReplicationHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/buckets/{bucket_name}/replication-metrics"] = bucket.NewGetBucketReplicationMetrics(o.context, o.BucketGetBucketReplicationMetricsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/buckets/{bucket_name}/retention"] = bucket.NewGetBucketRetentionConfig(o.context, o.BucketGetBucketRetentionConfigHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/buckets/{bucket_name}/rewind/{date}"] = bucket.NewGetBucketRewind(o.context, o.BucketGetBucketRewindHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -577,18 +1021,50 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/buckets/mirror-jobs/{job_id}"] = bucket.NewGetMirrorJob(o.context, o.BucketGetMirrorJobHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/buckets/{bucket_name}/objects/metadata"] = object.NewGetObjectMetadata(o.context, o.ObjectGetObjectMetadataHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/buckets/{bucket_name}/objects/versions"] = object.NewGetObjectVersionHistory(o.context, o.ObjectGetObjectVersionHistoryHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/config-import"] = bucket.NewImportBucketConfig(o.context, o.BucketImportBucketConfigHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/lifecycle-import"] = bucket.NewImportBucketLifecycle(o.context, o.BucketImportBucketLifecycleHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/license/acknowledge"] = license.NewLicenseAcknowledge(o.context, o.LicenseLicenseAcknowledgeHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/sessions"] = auth.NewListActiveSessions(o.context, o.AuthListActiveSessionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/bucket-templates"] = bucket.NewListBucketTemplates(o.context, o.BucketListBucketTemplatesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/buckets"] = bucket.NewListBuckets(o.context, o.BucketListBucketsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/buckets/{bucket_name}/incomplete-uploads"] = bucket.NewListIncompleteUploads(o.context, o.BucketListIncompleteUploadsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/buckets/mirror-jobs"] = bucket.NewListMirrorJobs(o.context, o.BucketListMirrorJobsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/buckets/{bucket_name}/objects"] = object.NewListObjects(o.context, o.ObjectListObjectsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -601,6 +1077,18 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/login/oauth2/auth"] = auth.NewLoginOauth2Auth(o.context, o.AuthLoginOauth2AuthHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/login/mfa"] = auth.NewLoginSecondFactor(o.context, o.AuthLoginSecondFactorHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/login/mfa/enroll"] = auth.NewLoginSecondFactorEnroll(o.context, o.AuthLoginSecondFactorEnrollHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/logout"] = auth.NewLogout(o.context, o.AuthLogoutHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -609,7 +1097,15 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/buckets/mirror-jobs/{job_id}/pause"] = bucket.NewPauseMirrorJob(o.context, o.BucketPauseMirrorJobHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/buckets/{bucket_name}/objects/upload"] = object.NewPostBucketsBucketNameObjectsUpload(o.context, o.ObjectPostBucketsBucketNameObjectsUploadHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/objects/versions/prune"] = object.NewPruneObjectVersions(o.context, o.ObjectPruneObjectVersionsHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -618,10 +1114,54 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/buckets/{bucket_name}/objects/tags"] = object.NewPutObjectTags(o.context, o.ObjectPutObjectTagsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/mirror-jobs/{job_id}/resume"] = bucket.NewResumeMirrorJob(o.context, o.BucketResumeMirrorJobHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/sessions/{session_id}"] = auth.NewRevokeActiveSession(o.context, o.AuthRevokeActiveSessionHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/sessions"] = auth.NewRevokeActiveSessions(o.context, o.AuthRevokeActiveSessionsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/mfa/confirm"] = auth.NewSecondFactorConfirm(o.context, o.AuthSecondFactorConfirmHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/mfa/disable"] = auth.NewSecondFactorDisable(o.context, o.AuthSecondFactorDisableHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/mfa/enroll"] = auth.NewSecondFactorEnroll(o.context, o.AuthSecondFactorEnrollHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/mfa"] = auth.NewSecondFactorStatus(o.context, o.AuthSecondFactorStatusHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/session"] = auth.NewSessionCheck(o.context, o.AuthSessionCheckHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/session/refresh"] = auth.NewSessionRefresh(o.context, o.AuthSessionRefreshHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/buckets/{bucket_name}/cors"] = bucket.NewSetBucketCors(o.context, o.BucketSetBucketCorsHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/buckets/{name}/quota"] = bucket.NewSetBucketQuota(o.context, o.BucketSetBucketQuotaHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/buckets/{bucket_name}/retention"] = bucket.NewSetBucketRetentionConfig(o.context, o.BucketSetBucketRetentionConfigHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -630,6 +1170,18 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/buckets/{bucket_name}/objects/share"] = object.NewShareObject(o.context, o.ObjectShareObjectHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/mirror"] = bucket.NewStartBucketMirror(o.context, o.BucketStartBucketMirrorHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/cors-test"] = bucket.NewTestBucketCors(o.context, o.BucketTestBucketCorsHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/buckets/{bucket_name}/lifecycle/{lifecycle_id}"] = bucket.NewUpdateBucketLifecycle(o.context, o.BucketUpdateBucketLifecycleHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
@@ -0,0 +1,252 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+	"github.com/minio/console/api/operations"
+	authApi "github.com/minio/console/api/operations/auth"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/auth"
+	"github.com/minio/console/pkg/auth/session"
+	xjwt "github.com/minio/console/pkg/auth/token"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/pkg/v3/env"
+)
+
+// GlobalSessions is the registry of the console sessions, sessions are stateless when it's nil
+var GlobalSessions *session.Registry
+
+// NewSessionRegistry returns the session registry set up by CONSOLE_SESSION_STORE, the memory store
+// suits a single console while the bucket store is shared by every console of a deployment
+func NewSessionRegistry(ctx context.Context) (*session.Registry, error) {
+	switch store := getSessionStore(); store {
+	case "":
+		return nil, nil
+	case "memory":
+		return session.NewRegistry(session.NewMemoryStore(), session.DefaultCacheTTL), nil
+	case "bucket":
+		client, err := newSystemBucketClient(ConsoleSessionStoreAccessKey, ConsoleSessionStoreSecretKey)
+		if err != nil {
+			return nil, err
+		}
+		bucketStore, err := session.NewBucketStore(ctx, client, getSessionStoreBucket())
+		if err != nil {
+			return nil, err
+		}
+		return session.NewRegistry(bucketStore, session.DefaultCacheTTL), nil
+	default:
+		return nil, fmt.Errorf("unknown session store %s, expected memory or bucket", store)
+	}
+}
+
+func registerActiveSessionsHandlers(api *operations.ConsoleAPI) {
+	// list the active sessions of the user
+	api.AuthListActiveSessionsHandler = authApi.ListActiveSessionsHandlerFunc(func(params authApi.ListActiveSessionsParams, session *models.Principal) middleware.Responder {
+		resp, err := getListActiveSessionsResponse(session, params)
+		if err != nil {
+			return authApi.NewListActiveSessionsDefault(err.Code).WithPayload(err.APIError)
+		}
+		return authApi.NewListActiveSessionsOK().WithPayload(resp)
+	})
+	// revoke a session of the user
+	api.AuthRevokeActiveSessionHandler = authApi.RevokeActiveSessionHandlerFunc(func(params authApi.RevokeActiveSessionParams, session *models.Principal) middleware.Responder {
+		if err := getRevokeActiveSessionResponse(session, params); err != nil {
+			return authApi.NewRevokeActiveSessionDefault(err.Code).WithPayload(err.APIError)
+		}
+		return authApi.NewRevokeActiveSessionNoContent()
+	})
+	// revoke every session of the user
+	api.AuthRevokeActiveSessionsHandler = authApi.RevokeActiveSessionsHandlerFunc(func(params authApi.RevokeActiveSessionsParams, session *models.Principal) middleware.Responder {
+		resp, err := getRevokeActiveSessionsResponse(session, params)
+		if err != nil {
+			return authApi.NewRevokeActiveSessionsDefault(err.Code).WithPayload(err.APIError)
+		}
+		return authApi.NewRevokeActiveSessionsOK().WithPayload(resp)
+	})
+}
+
+// newSystemBucketClient returns a client of MinIO authenticated with the keys in the environment variables,
+// console keeps the state shared by the consoles of a deployment in buckets with it
+func newSystemBucketClient(accessKeyEnv, secretKeyEnv string) (*minio.Client, error) {
+	accessKey := env.Get(accessKeyEnv, "")
+	secretKey := env.Get(secretKeyEnv, "")
+	if accessKey == "" || secretKey == "" {
+		return nil, fmt.Errorf("the bucket store requires %s and %s", accessKeyEnv, secretKeyEnv)
+	}
+	return minio.New(getMinIOEndpoint(), &minio.Options{
+		Creds:     credentials.NewStaticV4(accessKey, secretKey, ""),
+		Secure:    getMinIOEndpointIsSecure(),
+		Region:    GetMinIORegion(),
+		Transport: GetConsoleHTTPClient("").Transport,
+	})
+}
+
+// sessionOwner returns the user a session belongs to, the parent user MinIO records in the STS session
+// token when there's one so the sessions of a user logged in through an IDP are listed together. The
+// token isn't verified here: console either obtained it from MinIO or had MinIO check it at login, and
+// the principal comes from the session token console encrypted.
+func sessionOwner(principal *models.Principal) string {
+	if claims, err := getClaimsFromToken(principal.STSSessionToken); err == nil {
+		if parent, ok := claims["parent"].(string); ok && parent != "" {
+			return parent
+		}
+	}
+	if principal.AccountAccessKey != "" {
+		return principal.AccountAccessKey
+	}
+	return principal.STSAccessKeyID
+}
+
+// registerSession records a new session when the registry is enabled and returns its ID, the session
+// expires with its credentials unless it lasts until another time. Sessions get an ID that is not
+// registered when the registry is disabled, their activity is still tracked with it. The secret key
+// renewing the session, if any, is kept encrypted in the registry.
+func registerSession(r *http.Request, tokens credentials.Value, accountAccessKey, renewalSecretKey string, expires time.Time) (string, error) {
+	if GlobalSessions == nil {
+		return uuid.NewString(), nil
+	}
+	ctx := context.Background()
+	var clientIP, userAgent string
+	if r != nil {
+		ctx = r.Context()
+		clientIP = getClientIP(r)
+		userAgent = r.UserAgent()
+	}
+	if expires.IsZero() {
+		expires = time.Now().Add(xjwt.GetConsoleSTSDuration())
+	}
+	owner := sessionOwner(&models.Principal{
+		STSAccessKeyID:   tokens.AccessKeyID,
+		STSSessionToken:  tokens.SessionToken,
+		AccountAccessKey: accountAccessKey,
+	})
+	var renewal string
+	if renewalSecretKey != "" {
+		var err error
+		if renewal, err = auth.EncryptPayload([]byte(renewalSecretKey), sessionRenewalPurpose(owner)); err != nil {
+			return "", err
+		}
+	}
+	s, err := GlobalSessions.Create(ctx, owner, clientIP, userAgent, renewal, expires)
+	if err != nil {
+		return "", err
+	}
+	return s.ID, nil
+}
+
+// validateSession rejects the sessions past their maximum lifetime or idle for too long, and the ones
+// that were revoked when the registry is enabled
+func validateSession(ctx context.Context, principal *models.Principal) error {
+	now := time.Now()
+	if principal.SessionExpiration != 0 && !now.Before(time.Unix(principal.SessionExpiration, 0)) {
+		return ErrSessionMaxLifetime
+	}
+	if sessionIdle(principal, now) {
+		return ErrSessionIdle
+	}
+	if GlobalSessions == nil {
+		return nil
+	}
+	return GlobalSessions.Validate(ctx, sessionOwner(principal), principal.SessionID)
+}
+
+// revokeCurrentSession revokes the session of the principal when the registry is enabled
+func revokeCurrentSession(ctx context.Context, principal *models.Principal) error {
+	if GlobalSessions == nil || principal == nil || principal.SessionID == "" {
+		return nil
+	}
+	err := GlobalSessions.Revoke(ctx, sessionOwner(principal), principal.SessionID)
+	if errors.Is(err, session.ErrSessionNotFound) {
+		return nil
+	}
+	return err
+}
+
+func listActiveSessions(ctx context.Context, registry *session.Registry, principal *models.Principal) (*models.ListActiveSessionsResponse, error) {
+	sessions, err := registry.List(ctx, sessionOwner(principal))
+	if err != nil {
+		return nil, err
+	}
+	resp := &models.ListActiveSessionsResponse{Sessions: []*models.ActiveSession{}}
+	for _, s := range sessions {
+		resp.Sessions = append(resp.Sessions, &models.ActiveSession{
+			ID:        s.ID,
+			IP:        s.IP,
+			UserAgent: s.UserAgent,
+			Created:   s.Created.Format(time.RFC3339),
+			LastSeen:  s.LastSeen.Format(time.RFC3339),
+			Expires:   s.Expires.Format(time.RFC3339),
+			Current:   s.ID == principal.SessionID,
+		})
+	}
+	return resp, nil
+}
+
+func getListActiveSessionsResponse(principal *models.Principal, params authApi.ListActiveSessionsParams) (*models.ListActiveSessionsResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if GlobalSessions == nil {
+		return nil, ErrorWithContext(ctx, ErrSessionRegistryDisabled)
+	}
+	resp, err := listActiveSessions(ctx, GlobalSessions, principal)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return resp, nil
+}
+
+func getRevokeActiveSessionResponse(principal *models.Principal, params authApi.RevokeActiveSessionParams) *CodedAPIError {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if GlobalSessions == nil {
+		return ErrorWithContext(ctx, ErrSessionRegistryDisabled)
+	}
+	if err := GlobalSessions.Revoke(ctx, sessionOwner(principal), strings.TrimSpace(params.SessionID)); err != nil {
+		if errors.Is(err, session.ErrSessionNotFound) {
+			return ErrorWithContext(ctx, ErrNotFound)
+		}
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
+
+func getRevokeActiveSessionsResponse(principal *models.Principal, params authApi.RevokeActiveSessionsParams) (*models.RevokeActiveSessionsResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if GlobalSessions == nil {
+		return nil, ErrorWithContext(ctx, ErrSessionRegistryDisabled)
+	}
+	var keep string
+	if params.KeepCurrent != nil && *params.KeepCurrent {
+		keep = principal.SessionID
+	}
+	revoked, err := GlobalSessions.RevokeAll(ctx, sessionOwner(principal), keep)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return &models.RevokeActiveSessionsResponse{Revoked: int64(revoked)}, nil
+}
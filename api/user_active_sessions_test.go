@@ -0,0 +1,75 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/auth"
+	"github.com/minio/console/pkg/auth/session"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_sessionRegistry(t *testing.T) {
+	assert := assert.New(t)
+	GlobalSessions = session.NewRegistry(session.NewMemoryStore(), 0)
+	defer func() { GlobalSessions = nil }()
+	consoleCredentialsGetMock = func() (credentials.Value, error) {
+		return credentials.Value{AccessKeyID: "fakeAccessKeyID", SecretAccessKey: "fakeSecretAccessKey", SessionToken: "fakeSessionToken"}, nil
+	}
+	loginReq := httptest.NewRequest(http.MethodPost, "/api/v1/login", nil)
+	loginReq.Header.Set("User-Agent", "Firefox")
+	token, err := login(consoleCredentialsMock{}, nil, loginReq)
+	assert.Nil(err)
+
+	claims, err := auth.SessionTokenAuthenticate(*token)
+	assert.Nil(err)
+	principal := &models.Principal{STSAccessKeyID: claims.STSAccessKeyID, STSSessionToken: claims.STSSessionToken, SessionID: claims.SessionID}
+	sessions, err := listActiveSessions(context.Background(), GlobalSessions, principal)
+	assert.Nil(err)
+	assert.Len(sessions.Sessions, 1)
+	assert.Equal("Firefox", sessions.Sessions[0].UserAgent)
+	assert.True(sessions.Sessions[0].Current)
+
+	authenticated := func() (string, *http.Response) {
+		var authorization string
+		handler := AuthenticationMiddleware(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			authorization = r.Header.Get("Authorization")
+		}))
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/buckets", nil)
+		req.AddCookie(&http.Cookie{Name: "token", Value: *token})
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return authorization, rec.Result()
+	}
+	authorization, resp := authenticated()
+	assert.Contains(authorization, claims.SessionID)
+	assert.Empty(resp.Cookies())
+
+	// a revoked session goes on as anonymous and the browser is told to drop the cookie
+	assert.Nil(revokeCurrentSession(context.Background(), principal))
+	authorization, resp = authenticated()
+	assert.Equal("Bearer Anonymous", authorization)
+	assert.Equal("token", resp.Cookies()[0].Name)
+	assert.Equal(-1, resp.Cookies()[0].MaxAge)
+	assert.ErrorIs(validateSession(context.Background(), principal), session.ErrSessionRevoked)
+}
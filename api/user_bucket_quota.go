@@ -18,10 +18,12 @@ package api
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/minio/console/api/operations"
 	bucektApi "github.com/minio/console/api/operations/bucket"
+	"github.com/minio/madmin-go/v3"
 
 	"github.com/minio/console/models"
 )
@@ -35,6 +37,21 @@ func registerBucketQuotaHandlers(api *operations.ConsoleAPI) {
 		}
 		return bucektApi.NewGetBucketQuotaOK().WithPayload(resp)
 	})
+	// set bucket hard quota
+	api.BucketSetBucketQuotaHandler = bucektApi.SetBucketQuotaHandlerFunc(func(params bucektApi.SetBucketQuotaParams, session *models.Principal) middleware.Responder {
+		resp, err := setBucketQuotaResponse(session, params)
+		if err != nil {
+			return bucektApi.NewSetBucketQuotaDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucektApi.NewSetBucketQuotaOK().WithPayload(resp)
+	})
+	// clear bucket quota
+	api.BucketDeleteBucketQuotaHandler = bucektApi.DeleteBucketQuotaHandlerFunc(func(params bucektApi.DeleteBucketQuotaParams, session *models.Principal) middleware.Responder {
+		if err := deleteBucketQuotaResponse(session, params); err != nil {
+			return bucektApi.NewDeleteBucketQuotaDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucektApi.NewDeleteBucketQuotaNoContent()
+	})
 }
 
 func getBucketQuotaResponse(session *models.Principal, params bucektApi.GetBucketQuotaParams) (*models.BucketQuota, *CodedAPIError) {
@@ -55,13 +72,103 @@ func getBucketQuotaResponse(session *models.Principal, params bucektApi.GetBucke
 	return quota, nil
 }
 
-func getBucketQuota(ctx context.Context, ac *AdminClient, bucket *string) (*models.BucketQuota, error) {
+func getBucketQuota(ctx context.Context, ac MinioAdmin, bucket *string) (*models.BucketQuota, error) {
 	quota, err := ac.getBucketQuota(ctx, *bucket)
 	if err != nil {
 		return nil, err
 	}
 	return &models.BucketQuota{
-		Quota: int64(quota.Quota),
+		Quota: int64(quotaSize(quota)),
 		Type:  string(quota.Type),
 	}, nil
 }
+
+// quotaSize returns the quota limit in bytes, newer MinIO releases report it in
+// Size and keep Quota only for backwards compatibility.
+func quotaSize(quota madmin.BucketQuota) uint64 {
+	if quota.Size > 0 {
+		return quota.Size
+	}
+	return quota.Quota
+}
+
+func setBucketQuotaResponse(session *models.Principal, params bucektApi.SetBucketQuotaParams) (*models.BucketQuota, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	adminClient := AdminClient{Client: mAdmin}
+	if err := setBucketQuota(ctx, adminClient, params.Name, *params.Body.Quota); err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	globalAccountInfoCache.invalidate(sessionCacheKey(session))
+	quota, err := getBucketQuota(ctx, adminClient, &params.Name)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return quota, nil
+}
+
+// setBucketQuota sets a hard quota of size bytes on the bucket
+func setBucketQuota(ctx context.Context, ac MinioAdmin, bucket string, size int64) error {
+	if size <= 0 {
+		return ErrInvalidBucketQuota
+	}
+	return ac.setBucketQuota(ctx, bucket, &madmin.BucketQuota{
+		Quota: uint64(size),
+		Size:  uint64(size),
+		Type:  madmin.HardQuota,
+	})
+}
+
+func deleteBucketQuotaResponse(session *models.Principal, params bucektApi.DeleteBucketQuotaParams) *CodedAPIError {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	adminClient := AdminClient{Client: mAdmin}
+	// an empty quota configuration clears the bucket quota
+	if err := adminClient.setBucketQuota(ctx, params.Name, &madmin.BucketQuota{}); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	globalAccountInfoCache.invalidate(sessionCacheKey(session))
+	return nil
+}
+
+// getBucketQuotaHeadroom returns how many more bytes fit in the bucket before its
+// hard quota is reached. limited is false when the bucket has no quota or it
+// can't be read with the current credentials; MinIO still enforces the quota on
+// write so the check is only meant to fail early with a clear error.
+func getBucketQuotaHeadroom(ctx context.Context, ac MinioAdmin, bucket string) (headroom int64, limited bool) {
+	quota, err := ac.getBucketQuota(ctx, bucket)
+	if err != nil || quota.Type != madmin.HardQuota || quotaSize(quota) == 0 {
+		return 0, false
+	}
+	limit := int64(quotaSize(quota))
+	info, err := ac.AccountInfo(ctx)
+	if err != nil {
+		return 0, false
+	}
+	for _, b := range info.Buckets {
+		if b.Name == bucket {
+			if used := int64(b.Size); used < limit {
+				return limit - used, true
+			}
+			return 0, true
+		}
+	}
+	return limit, true
+}
+
+// checkQuotaHeadroom returns ErrFileTooLarge when an object of size bytes doesn't
+// fit in the remaining headroom
+func checkQuotaHeadroom(bucket string, headroom, size int64) error {
+	if size > headroom {
+		return fmt.Errorf("%w: %d bytes exceed the %d bytes left in the quota of bucket %s", ErrFileTooLarge, size, headroom, bucket)
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	objectApi "github.com/minio/console/api/operations/object"
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_setBucketQuota(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := AdminClientMock{}
+
+	var setQuota *madmin.BucketQuota
+	adminClient.minioSetBucketQuotaMock = func(_ context.Context, _ string, quota *madmin.BucketQuota) error {
+		setQuota = quota
+		return nil
+	}
+	assert.Nil(setBucketQuota(ctx, adminClient, "bucket", 1024))
+	assert.Equal(uint64(1024), setQuota.Size)
+	assert.Equal(madmin.HardQuota, setQuota.Type)
+
+	assert.Equal(ErrInvalidBucketQuota, setBucketQuota(ctx, adminClient, "bucket", 0))
+
+	adminClient.minioSetBucketQuotaMock = func(_ context.Context, _ string, _ *madmin.BucketQuota) error {
+		return errors.New("error")
+	}
+	assert.Equal("error", setBucketQuota(ctx, adminClient, "bucket", 1024).Error())
+}
+
+func Test_getBucketQuotaHeadroom(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := AdminClientMock{}
+	adminClient.minioAccountInfoMock = func(_ context.Context) (madmin.AccountInfo, error) {
+		return madmin.AccountInfo{Buckets: []madmin.BucketAccessInfo{
+			{Name: "other", Size: 10},
+			{Name: "bucket", Size: 600},
+		}}, nil
+	}
+
+	adminClient.minioGetBucketQuotaMock = func(_ context.Context, _ string) (madmin.BucketQuota, error) {
+		return madmin.BucketQuota{Size: 1000, Type: madmin.HardQuota}, nil
+	}
+	headroom, limited := getBucketQuotaHeadroom(ctx, adminClient, "bucket")
+	assert.True(limited)
+	assert.Equal(int64(400), headroom)
+
+	// older servers only report the deprecated quota field
+	adminClient.minioGetBucketQuotaMock = func(_ context.Context, _ string) (madmin.BucketQuota, error) {
+		return madmin.BucketQuota{Quota: 500, Type: madmin.HardQuota}, nil
+	}
+	headroom, limited = getBucketQuotaHeadroom(ctx, adminClient, "bucket")
+	assert.True(limited)
+	assert.Equal(int64(0), headroom)
+
+	// no quota configured
+	adminClient.minioGetBucketQuotaMock = func(_ context.Context, _ string) (madmin.BucketQuota, error) {
+		return madmin.BucketQuota{}, errors.New("quota config not found")
+	}
+	_, limited = getBucketQuotaHeadroom(ctx, adminClient, "bucket")
+	assert.False(limited)
+
+	assert.Nil(checkQuotaHeadroom("bucket", 400, 400))
+	assert.True(errors.Is(checkQuotaHeadroom("bucket", 400, 401), ErrFileTooLarge))
+}
+
+func Test_uploadFilesQuota(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	adminClient := AdminClientMock{}
+	adminClient.minioAccountInfoMock = func(_ context.Context) (madmin.AccountInfo, error) {
+		return madmin.AccountInfo{Buckets: []madmin.BucketAccessInfo{{Name: "bucket", Size: 90}}}, nil
+	}
+	adminClient.minioGetBucketQuotaMock = func(_ context.Context, _ string) (madmin.BucketQuota, error) {
+		return madmin.BucketQuota{Size: 100, Type: madmin.HardQuota}, nil
+	}
+	uploaded := 0
+	minioPutObjectMock = func(_ context.Context, _, _ string, reader io.Reader, _ int64, _ minio.PutObjectOptions) (minio.UploadInfo, error) {
+		_, err := io.Copy(io.Discard, reader)
+		uploaded++
+		return minio.UploadInfo{}, err
+	}
+
+	newParams := func(content string) objectApi.PostBucketsBucketNameObjectsUploadParams {
+		body := &bytes.Buffer{}
+		w := multipart.NewWriter(body)
+		part, err := w.CreateFormFile(swag.FormatInt64(int64(len(content))), "file.txt")
+		assert.Nil(err)
+		_, err = part.Write([]byte(content))
+		assert.Nil(err)
+		assert.Nil(w.Close())
+		req, err := http.NewRequest(http.MethodPost, "/buckets/bucket/objects/upload", body)
+		assert.Nil(err)
+		req.Header.Set("Content-Type", w.FormDataContentType())
+		return objectApi.PostBucketsBucketNameObjectsUploadParams{
+			HTTPRequest: req,
+			BucketName:  "bucket",
+			Prefix:      swag.String("file.txt"),
+		}
+	}
+
+	assert.Nil(uploadFiles(ctx, minClient, adminClient, newParams("0123456789")))
+	assert.Equal(1, uploaded)
+
+	err := uploadFiles(ctx, minClient, adminClient, newParams("0123456789a"))
+	assert.True(errors.Is(err, ErrFileTooLarge))
+	assert.Equal(413, ErrorWithContext(ctx, err).Code)
+	assert.Equal(1, uploaded)
+
+	// the usage of the bucket comes from the account info cache of the session
+	accountInfoCalls := 0
+	adminClient.minioAccountInfoMock = func(_ context.Context) (madmin.AccountInfo, error) {
+		accountInfoCalls++
+		return madmin.AccountInfo{Buckets: []madmin.BucketAccessInfo{{Name: "bucket", Size: 90}}}, nil
+	}
+	session := &models.Principal{SessionID: "upload-quota-session"}
+	defer globalAccountInfoCache.invalidate(sessionCacheKey(session))
+	cachedClient := newCachedAccountInfoClient(session, adminClient)
+	assert.Nil(uploadFiles(ctx, minClient, cachedClient, newParams("0123456789")))
+	assert.Nil(uploadFiles(ctx, minClient, cachedClient, newParams("0123456789")))
+	assert.Equal(3, uploaded)
+	assert.Equal(1, accountInfoCalls)
+}
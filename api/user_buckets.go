@@ -18,10 +18,14 @@ package api
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -83,6 +87,22 @@ func registerBucketsHandlers(api *operations.ConsoleAPI) {
 		}
 		return bucketApi.NewSetBucketVersioningCreated()
 	})
+	// get bucket default retention configuration
+	api.BucketGetBucketRetentionConfigHandler = bucketApi.GetBucketRetentionConfigHandlerFunc(func(params bucketApi.GetBucketRetentionConfigParams, session *models.Principal) middleware.Responder {
+		response, err := getBucketRetentionConfigResponse(session, params)
+		if err != nil {
+			return bucketApi.NewGetBucketRetentionConfigDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucketApi.NewGetBucketRetentionConfigOK().WithPayload(response)
+	})
+	// set bucket default retention configuration
+	api.BucketSetBucketRetentionConfigHandler = bucketApi.SetBucketRetentionConfigHandlerFunc(func(params bucketApi.SetBucketRetentionConfigParams, session *models.Principal) middleware.Responder {
+		response, err := setBucketRetentionConfigResponse(session, params)
+		if err != nil {
+			return bucketApi.NewSetBucketRetentionConfigDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucketApi.NewSetBucketRetentionConfigOK().WithPayload(response)
+	})
 	// get objects rewind for a bucket
 	api.BucketGetBucketRewindHandler = bucketApi.GetBucketRewindHandlerFunc(func(params bucketApi.GetBucketRewindParams, session *models.Principal) middleware.Responder {
 		getBucketRewind, err := getBucketRewindResponse(session, params)
@@ -148,6 +168,7 @@ func setBucketVersioningResponse(session *models.Principal, params bucketApi.Set
 	if err := doSetVersioning(ctx, amcClient, versioningState, excludePrefixes, excludeFolders); err != nil {
 		return ErrorWithContext(ctx, fmt.Errorf("error setting versioning for bucket: %s", err))
 	}
+	globalAccountInfoCache.invalidate(sessionCacheKey(session))
 	return nil
 }
 
@@ -236,22 +257,27 @@ func getListBucketsResponse(session *models.Principal, params bucketApi.ListBuck
 	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
 	defer cancel()
 
+	opts, err := getListBucketsOpts(params)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, err)
+	}
 	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
 	// create a minioClient interface implementation
 	// defining the client to be used
-	adminClient := AdminClient{Client: mAdmin}
+	adminClient := newCachedAccountInfoClient(session, AdminClient{Client: mAdmin})
 	buckets, err := getAccountBuckets(ctx, adminClient)
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
+	buckets, total := filterBuckets(buckets, opts)
 
 	// serialize output
 	listBucketsResponse := &models.ListBucketsResponse{
 		Buckets: buckets,
-		Total:   int64(len(buckets)),
+		Total:   int64(total),
 	}
 	return listBucketsResponse, nil
 }
@@ -262,7 +288,9 @@ func makeBucket(ctx context.Context, client MinioClient, bucketName string, obje
 	return client.makeBucketWithContext(ctx, bucketName, "", objectLocking)
 }
 
-// getMakeBucketResponse performs makeBucket() to create a bucket with its access policy
+// getMakeBucketResponse creates a bucket and applies the settings of the request on top of the
+// ones of the selected template. As with setBucketRetentionConfigResponse, a COMPLIANCE retention
+// must be confirmed with a token before the bucket is created.
 func getMakeBucketResponse(session *models.Principal, params bucketApi.MakeBucketParams) (*models.MakeBucketsResponse, *CodedAPIError) {
 	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
 	defer cancel()
@@ -271,6 +299,41 @@ func getMakeBucketResponse(session *models.Principal, params bucketApi.MakeBucke
 	if br == nil {
 		return nil, ErrorWithContext(ctx, ErrBucketBodyNotInRequest)
 	}
+	bucketName := *br.Name
+	var template *models.BucketTemplate
+	if br.Template != "" {
+		templates, err := loadBucketTemplates(getBucketTemplatesFile())
+		if err != nil {
+			return nil, ErrorWithContext(ctx, err)
+		}
+		if template, err = findBucketTemplate(templates, br.Template); err != nil {
+			return nil, ErrorWithContext(ctx, err)
+		}
+	}
+	var templateSettings *models.BucketSettings
+	if template != nil {
+		templateSettings = template.Settings
+	}
+	setup, err := newBucketSetup(mergeBucketSettings(templateSettings, br.Settings))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	if r := setup.retention; r != nil && *r.Mode == models.ObjectRetentionModeCompliance {
+		confirmationToken := br.ConfirmationToken
+		if confirmationToken == "" {
+			confirmationToken = setup.confirmationToken
+		}
+		if !validComplianceConfirmationToken(session, bucketName, *r.Unit, *r.Validity, confirmationToken, time.Now()) {
+			expiry := time.Now().Add(complianceConfirmationTTL).Unix()
+			return &models.MakeBucketsResponse{
+				BucketName:           bucketName,
+				ConfirmationRequired: true,
+				Warning:              complianceModeWarning,
+				ConfirmationToken:    complianceConfirmationToken(session, bucketName, *r.Unit, *r.Validity, expiry),
+			}, nil
+		}
+	}
+
 	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
@@ -278,22 +341,24 @@ func getMakeBucketResponse(session *models.Principal, params bucketApi.MakeBucke
 	// create a minioClient interface implementation
 	// defining the client to be used
 	minioClient := minioClient{client: mClient}
-
-	if err := makeBucket(ctx, minioClient, *br.Name, false); err != nil {
+	s3Client, err := newS3BucketClient(session, bucketName, "", getClientIP(params.HTTPRequest))
+	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
+	// create a mc S3Client interface implementation
+	// defining the client to be used
+	amcClient := mcClient{client: s3Client}
+	mAdmin, err := NewMinioAdminClient(ctx, session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	adminClient := AdminClient{Client: mAdmin}
 
-	// make sure to delete bucket if an errors occurs after bucket was created
-	defer func() {
-		if err != nil {
-			ErrorWithContext(ctx, fmt.Errorf("error creating bucket: %v", err))
-			if err := removeBucket(minioClient, *br.Name); err != nil {
-				ErrorWithContext(ctx, fmt.Errorf("error removing bucket: %v", err))
-			}
-		}
-	}()
-
-	return &models.MakeBucketsResponse{BucketName: *br.Name}, nil
+	if err := createConfiguredBucket(ctx, minioClient, amcClient, adminClient, bucketName, setup); err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	globalAccountInfoCache.invalidate(sessionCacheKey(session))
+	return &models.MakeBucketsResponse{BucketName: bucketName}, nil
 }
 
 // setBucketAccessPolicy set the access permissions on an existing bucket.
@@ -408,7 +473,7 @@ func getBucketInfoResponse(session *models.Principal, params bucketApi.BucketInf
 	}
 	// create a minioClient interface implementation
 	// defining the client to be used
-	adminClient := AdminClient{Client: mAdmin}
+	adminClient := newCachedAccountInfoClient(session, AdminClient{Client: mAdmin})
 
 	bucket, err := getBucketInfo(ctx, minioClient, adminClient, params.Name)
 	if err != nil {
@@ -501,6 +566,138 @@ func getBucketRetentionConfig(ctx context.Context, client MinioClient, bucketNam
 	return config, nil
 }
 
+// getBucketRetentionConfigResponse returns the default retention configuration of a bucket
+func getBucketRetentionConfigResponse(session *models.Principal, params bucketApi.GetBucketRetentionConfigParams) (*models.GetBucketRetentionConfig, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	config, err := getBucketRetentionConfig(ctx, minioClient, params.BucketName)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return config, nil
+}
+
+// complianceConfirmationTTL is how long a compliance mode confirmation token remains valid
+const complianceConfirmationTTL = 5 * time.Minute
+
+const complianceModeWarning = "COMPLIANCE mode cannot be disabled or shortened once applied: no user, including the root user, " +
+	"will be able to overwrite or delete object versions until their retention period expires. " +
+	"Send this request again with the provided confirmationToken to apply it."
+
+// complianceConfirmationToken computes a token bound to the session, the bucket and the requested retention
+// settings, so a confirmation can't be replayed against a different configuration or by a different user.
+func complianceConfirmationToken(session *models.Principal, bucketName string, unit models.ObjectRetentionUnit, validity int32, expiry int64) string {
+	mac := hmac.New(sha256.New, []byte(session.STSSecretAccessKey))
+	fmt.Fprintf(mac, "%s|%s|%s|%s|%d|%d", session.STSAccessKeyID, bucketName, models.ObjectRetentionModeCompliance, unit, validity, expiry)
+	return fmt.Sprintf("%d.%s", expiry, hex.EncodeToString(mac.Sum(nil)))
+}
+
+// validComplianceConfirmationToken verifies a token previously issued by complianceConfirmationToken
+func validComplianceConfirmationToken(session *models.Principal, bucketName string, unit models.ObjectRetentionUnit, validity int32, confirmationToken string, now time.Time) bool {
+	expiryStr, _, found := strings.Cut(confirmationToken, ".")
+	if !found {
+		return false
+	}
+	expiry, err := strconv.ParseInt(expiryStr, 10, 64)
+	if err != nil || now.Unix() > expiry {
+		return false
+	}
+	expected := complianceConfirmationToken(session, bucketName, unit, validity, expiry)
+	return hmac.Equal([]byte(expected), []byte(confirmationToken))
+}
+
+// validateBucketRetentionConfig checks the retention validity is within the range accepted by MinIO
+func validateBucketRetentionConfig(unit models.ObjectRetentionUnit, validity int32) error {
+	if validity <= 0 {
+		return errors.New("retention validity must be greater than zero")
+	}
+	switch unit {
+	case models.ObjectRetentionUnitDays:
+		if validity > 36500 {
+			return errors.New("retention validity cannot exceed 36500 days")
+		}
+	case models.ObjectRetentionUnitYears:
+		if validity > 100 {
+			return errors.New("retention validity cannot exceed 100 years")
+		}
+	default:
+		return errors.New("invalid retention unit")
+	}
+	return nil
+}
+
+// setBucketRetentionConfig sets the default retention configuration of a bucket
+func setBucketRetentionConfig(ctx context.Context, client MinioClient, bucketName string, mode models.ObjectRetentionMode, unit models.ObjectRetentionUnit, validity int32) error {
+	var retentionMode minio.RetentionMode
+	switch mode {
+	case models.ObjectRetentionModeGovernance:
+		retentionMode = minio.Governance
+	case models.ObjectRetentionModeCompliance:
+		retentionMode = minio.Compliance
+	default:
+		return errors.New("invalid retention mode")
+	}
+	var retentionUnit minio.ValidityUnit
+	switch unit {
+	case models.ObjectRetentionUnitDays:
+		retentionUnit = minio.Days
+	case models.ObjectRetentionUnitYears:
+		retentionUnit = minio.Years
+	default:
+		return errors.New("invalid retention unit")
+	}
+	retentionValidity := uint(validity)
+	return client.setObjectLockConfig(ctx, bucketName, &retentionMode, &retentionValidity, &retentionUnit)
+}
+
+// setBucketRetentionConfigResponse sets the default retention configuration of a bucket. Since COMPLIANCE
+// mode can't be reverted, the first request only returns a warning along with a confirmation token that
+// must be sent back to apply the configuration.
+func setBucketRetentionConfigResponse(session *models.Principal, params bucketApi.SetBucketRetentionConfigParams) (*models.PutBucketRetentionResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if params.Body == nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, ErrBucketBodyNotInRequest)
+	}
+	mode := *params.Body.Mode
+	unit := *params.Body.Unit
+	validity := *params.Body.Validity
+	if err := validateBucketRetentionConfig(unit, validity); err != nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, err)
+	}
+	if mode == models.ObjectRetentionModeCompliance &&
+		!validComplianceConfirmationToken(session, params.BucketName, unit, validity, params.Body.ConfirmationToken, time.Now()) {
+		expiry := time.Now().Add(complianceConfirmationTTL).Unix()
+		return &models.PutBucketRetentionResponse{
+			Applied:              false,
+			ConfirmationRequired: true,
+			Warning:              complianceModeWarning,
+			ConfirmationToken:    complianceConfirmationToken(session, params.BucketName, unit, validity, expiry),
+		}, nil
+	}
+
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	if err := setBucketRetentionConfig(ctx, minioClient, params.BucketName, mode, unit, validity); err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return &models.PutBucketRetentionResponse{Applied: true}, nil
+}
+
 func getBucketRewindResponse(session *models.Principal, params bucketApi.GetBucketRewindParams) (*models.RewindResponse, *CodedAPIError) {
 	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
 	defer cancel()
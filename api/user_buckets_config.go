@@ -0,0 +1,629 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+	"github.com/minio/console/api/operations"
+	bucketApi "github.com/minio/console/api/operations/bucket"
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+	"github.com/minio/minio-go/v7/pkg/notification"
+	"github.com/minio/minio-go/v7/pkg/sse"
+	"github.com/minio/minio-go/v7/pkg/tags"
+)
+
+// sections of a bucket configuration bundle, in the order they are applied
+const (
+	bucketConfigVersioning    = "versioning"
+	bucketConfigRetention     = "retention"
+	bucketConfigEncryption    = "encryption"
+	bucketConfigPolicy        = "policy"
+	bucketConfigTags          = "tags"
+	bucketConfigLifecycle     = "lifecycle"
+	bucketConfigNotifications = "notifications"
+	bucketConfigQuota         = "quota"
+)
+
+var bucketConfigSections = []string{
+	bucketConfigVersioning,
+	bucketConfigRetention,
+	bucketConfigEncryption,
+	bucketConfigPolicy,
+	bucketConfigTags,
+	bucketConfigLifecycle,
+	bucketConfigNotifications,
+	bucketConfigQuota,
+}
+
+const (
+	bucketConfigAdd       = "add"
+	bucketConfigUpdate    = "update"
+	bucketConfigRemove    = "remove"
+	bucketConfigUnchanged = "unchanged"
+	bucketConfigConflict  = "conflict"
+)
+
+// bucketConfigLock is how the retention section of a bundle is compared, object locking can't be
+// changed once the bucket exists so it is part of the section
+type bucketConfigLock struct {
+	Locking   bool                             `json:"locking"`
+	Retention *models.GetBucketRetentionConfig `json:"retention,omitempty"`
+}
+
+func registerBucketsConfigHandlers(api *operations.ConsoleAPI) {
+	// export bucket configuration
+	api.BucketExportBucketConfigHandler = bucketApi.ExportBucketConfigHandlerFunc(func(params bucketApi.ExportBucketConfigParams, session *models.Principal) middleware.Responder {
+		bundle, err := getExportBucketConfigResponse(session, params)
+		if err != nil {
+			return bucketApi.NewExportBucketConfigDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucketApi.NewExportBucketConfigOK().WithPayload(bundle)
+	})
+	// preview or import bucket configuration
+	api.BucketImportBucketConfigHandler = bucketApi.ImportBucketConfigHandlerFunc(func(params bucketApi.ImportBucketConfigParams, session *models.Principal) middleware.Responder {
+		resp, err := getImportBucketConfigResponse(session, params)
+		if err != nil {
+			return bucketApi.NewImportBucketConfigDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucketApi.NewImportBucketConfigOK().WithPayload(resp)
+	})
+}
+
+// bucketEncryptionSettings converts a default encryption configuration to its console representation
+func bucketEncryptionSettings(config *sse.Configuration) *models.BucketEncryptionSettings {
+	if config == nil || len(config.Rules) == 0 {
+		return nil
+	}
+	apply := config.Rules[0].Apply
+	if apply.SSEAlgorithm == "aws:kms" {
+		encryptionType := bucketEncryptionSSEKMS
+		return &models.BucketEncryptionSettings{Type: &encryptionType, KmsKeyID: apply.KmsMasterKeyID}
+	}
+	encryptionType := bucketEncryptionSSES3
+	return &models.BucketEncryptionSettings{Type: &encryptionType}
+}
+
+// bucketNotificationConfigs flattens the topic, queue and lambda configurations of a bucket
+func bucketNotificationConfigs(config notification.Configuration) []*models.BucketNotificationConfig {
+	var configs []*models.BucketNotificationConfig
+	add := func(arn string, c notification.Config) {
+		n := &models.BucketNotificationConfig{ID: c.ID, Arn: &arn}
+		for _, event := range c.Events {
+			n.Events = append(n.Events, string(event))
+		}
+		if c.Filter != nil {
+			for _, rule := range c.Filter.S3Key.FilterRules {
+				switch rule.Name {
+				case "prefix":
+					n.Prefix = rule.Value
+				case "suffix":
+					n.Suffix = rule.Value
+				}
+			}
+		}
+		configs = append(configs, n)
+	}
+	for _, c := range config.LambdaConfigs {
+		add(c.Lambda, c.Config)
+	}
+	for _, c := range config.TopicConfigs {
+		add(c.Topic, c.Config)
+	}
+	for _, c := range config.QueueConfigs {
+		add(c.Queue, c.Config)
+	}
+	return configs
+}
+
+// bucketNotificationConfiguration builds the bucket notification configuration, the ARN service
+// tells whether each target is a queue, a topic or a lambda
+func bucketNotificationConfiguration(configs []*models.BucketNotificationConfig) (notification.Configuration, error) {
+	var config notification.Configuration
+	for _, n := range configs {
+		arn, err := notification.NewArnFromString(*n.Arn)
+		if err != nil {
+			return config, err
+		}
+		c := notification.NewConfig(arn)
+		c.ID = n.ID
+		for _, event := range n.Events {
+			c.AddEvents(notification.EventType(event))
+		}
+		if n.Prefix != "" {
+			c.AddFilterPrefix(n.Prefix)
+		}
+		if n.Suffix != "" {
+			c.AddFilterSuffix(n.Suffix)
+		}
+		switch arn.Service {
+		case "sqs":
+			config.QueueConfigs = append(config.QueueConfigs, notification.QueueConfig{Config: c, Queue: arn.String()})
+		case "sns":
+			config.TopicConfigs = append(config.TopicConfigs, notification.TopicConfig{Config: c, Topic: arn.String()})
+		case "lambda":
+			config.LambdaConfigs = append(config.LambdaConfigs, notification.LambdaConfig{Config: c, Lambda: arn.String()})
+		default:
+			return config, fmt.Errorf("notification target %s not supported", *n.Arn)
+		}
+	}
+	return config, nil
+}
+
+// lifecycleBundleValue converts a lifecycle configuration to the generic JSON value stored in bundles
+func lifecycleBundleValue(lifecycleConfig *lifecycle.Configuration) (interface{}, error) {
+	if lifecycleConfig == nil || lifecycleConfig.Empty() {
+		return nil, nil
+	}
+	data, err := json.Marshal(lifecycleConfig)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// bundleLifecycleConfig converts the lifecycle of a bundle back to a lifecycle configuration
+func bundleLifecycleConfig(value interface{}) (*lifecycle.Configuration, error) {
+	if value == nil {
+		return lifecycle.NewConfiguration(), nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalLifecycleConfig(data, lifecycleFormatJSON)
+}
+
+// exportBucketConfig collects every piece of configuration of a bucket in a single bundle
+func exportBucketConfig(ctx context.Context, client MinioClient, adminClient MinioAdmin, bucketName string) (*models.BucketConfigBundle, error) {
+	bundle := &models.BucketConfigBundle{Bucket: bucketName}
+
+	versioning, err := client.getBucketVersioning(ctx, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	if versioning.Enabled() {
+		bundle.Versioning = &models.SetBucketVersioning{Enabled: true, ExcludeFolders: versioning.ExcludeFolders}
+		for _, excluded := range versioning.ExcludedPrefixes {
+			bundle.Versioning.ExcludePrefixes = append(bundle.Versioning.ExcludePrefixes, excluded.Prefix)
+		}
+	}
+
+	if bundle.Policy, err = client.getBucketPolicy(ctx, bucketName); err != nil {
+		return nil, err
+	}
+
+	bucketTags, err := client.GetBucketTagging(ctx, bucketName)
+	if err != nil && minio.ToErrorResponse(err).Code != "NoSuchTagSet" {
+		return nil, err
+	}
+	if bucketTags != nil && len(bucketTags.ToMap()) > 0 {
+		bundle.Tags = bucketTags.ToMap()
+	}
+
+	encryption, err := client.getBucketEncryption(ctx, bucketName)
+	if err != nil && minio.ToErrorResponse(err).Code != "ServerSideEncryptionConfigurationNotFoundError" {
+		return nil, err
+	}
+	bundle.Encryption = bucketEncryptionSettings(encryption)
+
+	lock, _, _, _, err := client.getObjectLockConfig(ctx, bucketName)
+	if err != nil && minio.ToErrorResponse(err).Code != "ObjectLockConfigurationNotFoundError" {
+		return nil, err
+	}
+	if lock == "Enabled" {
+		bundle.Locking = true
+		retention, err := getBucketRetentionConfig(ctx, client, bucketName)
+		if err != nil {
+			return nil, err
+		}
+		if retention.Mode != "" {
+			bundle.Retention = retention
+		}
+	}
+
+	lifecycleConfig, err := getBucketLifecycle(ctx, client, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	if bundle.Lifecycle, err = lifecycleBundleValue(lifecycleConfig); err != nil {
+		return nil, err
+	}
+
+	notifications, err := client.getBucketNotification(ctx, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	bundle.Notifications = bucketNotificationConfigs(notifications)
+
+	// buckets without a quota, or whose quota can't be read by the user, are exported without it
+	if quota, err := adminClient.getBucketQuota(ctx, bucketName); err == nil {
+		bundle.Quota = int64(quotaSize(quota))
+	}
+	return bundle, nil
+}
+
+// rewritePolicyResources points the resources of a policy written for another bucket to bucketName
+func rewritePolicyResources(policy, fromBucket, bucketName string) string {
+	if fromBucket == "" || fromBucket == bucketName {
+		return policy
+	}
+	re := regexp.MustCompile(`arn:aws:s3:::` + regexp.QuoteMeta(fromBucket) + `(["/])`)
+	return re.ReplaceAllString(policy, "arn:aws:s3:::"+bucketName+"$1")
+}
+
+// normalizeBucketConfigBundle validates a bundle and rewrites it so it can be compared with and applied
+// to bucketName
+func normalizeBucketConfigBundle(bundle *models.BucketConfigBundle, bucketName string) (*models.BucketConfigBundle, error) {
+	invalid := func(section string, err error) error {
+		return fmt.Errorf("%w: %s: %v", ErrInvalidBucketConfigBundle, section, err)
+	}
+	normalized := *bundle
+	normalized.Bucket = bucketName
+	if normalized.Policy != "" {
+		normalized.Policy = rewritePolicyResources(normalized.Policy, bundle.Bucket, bucketName)
+		if !json.Valid([]byte(normalized.Policy)) {
+			return nil, invalid(bucketConfigPolicy, fmt.Errorf("policy is not valid JSON"))
+		}
+	}
+	if normalized.Encryption != nil {
+		if _, err := bucketEncryptionConfig(normalized.Encryption); err != nil {
+			return nil, invalid(bucketConfigEncryption, err)
+		}
+	}
+	if len(normalized.Tags) > 0 {
+		if _, err := tags.NewTags(normalized.Tags, false); err != nil {
+			return nil, invalid(bucketConfigTags, err)
+		}
+	}
+	if r := normalized.Retention; r != nil && r.Mode != "" {
+		if !normalized.Locking {
+			return nil, invalid(bucketConfigRetention, fmt.Errorf("retention requires object locking"))
+		}
+		if r.Mode != models.ObjectRetentionModeGovernance && r.Mode != models.ObjectRetentionModeCompliance {
+			return nil, invalid(bucketConfigRetention, fmt.Errorf("invalid retention mode"))
+		}
+		if err := validateBucketRetentionConfig(r.Unit, r.Validity); err != nil {
+			return nil, invalid(bucketConfigRetention, err)
+		}
+	} else {
+		normalized.Retention = nil
+	}
+	if normalized.Lifecycle != nil {
+		lifecycleConfig, err := bundleLifecycleConfig(normalized.Lifecycle)
+		if err != nil {
+			return nil, invalid(bucketConfigLifecycle, err)
+		}
+		if normalized.Lifecycle, err = lifecycleBundleValue(lifecycleConfig); err != nil {
+			return nil, invalid(bucketConfigLifecycle, err)
+		}
+	}
+	if _, err := bucketNotificationConfiguration(normalized.Notifications); err != nil {
+		return nil, invalid(bucketConfigNotifications, err)
+	}
+	if normalized.Quota < 0 {
+		return nil, invalid(bucketConfigQuota, ErrInvalidBucketQuota)
+	}
+	return &normalized, nil
+}
+
+// bucketConfigValue returns the value of a bundle section used to compare bundles, nil when the
+// section is not configured
+func bucketConfigValue(bundle *models.BucketConfigBundle, section string) interface{} {
+	switch section {
+	case bucketConfigVersioning:
+		if v := bundle.Versioning; v != nil && v.Enabled {
+			versioning := models.SetBucketVersioning{Enabled: true, ExcludeFolders: v.ExcludeFolders}
+			if len(v.ExcludePrefixes) > 0 {
+				versioning.ExcludePrefixes = v.ExcludePrefixes
+			}
+			return versioning
+		}
+	case bucketConfigRetention:
+		if bundle.Locking {
+			return bucketConfigLock{Locking: true, Retention: bundle.Retention}
+		}
+	case bucketConfigEncryption:
+		if bundle.Encryption != nil {
+			return bundle.Encryption
+		}
+	case bucketConfigPolicy:
+		var policy interface{}
+		if bundle.Policy != "" && json.Unmarshal([]byte(bundle.Policy), &policy) == nil {
+			return policy
+		}
+	case bucketConfigTags:
+		if len(bundle.Tags) > 0 {
+			return bundle.Tags
+		}
+	case bucketConfigLifecycle:
+		return bundle.Lifecycle
+	case bucketConfigNotifications:
+		if len(bundle.Notifications) > 0 {
+			return bundle.Notifications
+		}
+	case bucketConfigQuota:
+		if bundle.Quota > 0 {
+			return bundle.Quota
+		}
+	}
+	return nil
+}
+
+func bucketConfigString(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
+
+// planBucketConfigImport compares the current configuration of a bucket with a normalized bundle and
+// returns the change needed on every selected section
+func planBucketConfigImport(current, desired *models.BucketConfigBundle, sections []string) ([]*models.BucketConfigChange, error) {
+	selected := map[string]bool{}
+	for _, section := range sections {
+		selected[section] = true
+	}
+	for section := range selected {
+		if !slices.Contains(bucketConfigSections, section) {
+			return nil, fmt.Errorf("%w: unknown section %s", ErrInvalidBucketConfigBundle, section)
+		}
+	}
+	var changes []*models.BucketConfigChange
+	for _, section := range bucketConfigSections {
+		if len(selected) > 0 && !selected[section] {
+			continue
+		}
+		change := &models.BucketConfigChange{
+			Section: section,
+			Current: bucketConfigString(bucketConfigValue(current, section)),
+			Desired: bucketConfigString(bucketConfigValue(desired, section)),
+		}
+		switch {
+		case change.Current == change.Desired:
+			change.Action = bucketConfigUnchanged
+		case change.Current == "":
+			change.Action = bucketConfigAdd
+		case change.Desired == "":
+			change.Action = bucketConfigRemove
+		default:
+			change.Action = bucketConfigUpdate
+		}
+		switch {
+		case section == bucketConfigRetention && desired.Locking && !current.Locking:
+			change.Action = bucketConfigConflict
+			change.Message = "object locking can only be enabled when the bucket is created"
+		case section == bucketConfigRetention && !desired.Locking && current.Locking:
+			change.Action = bucketConfigConflict
+			change.Message = "object locking can't be disabled"
+		case section == bucketConfigVersioning && change.Action == bucketConfigRemove && current.Locking:
+			change.Action = bucketConfigConflict
+			change.Message = "versioning can't be suspended on buckets with object locking"
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// applyBucketConfigSection applies the desired configuration of a single section
+func applyBucketConfigSection(ctx context.Context, client MinioClient, mcClient MCClient, adminClient MinioAdmin, bucketName string, desired *models.BucketConfigBundle, change *models.BucketConfigChange) error {
+	remove := change.Action == bucketConfigRemove
+	switch change.Section {
+	case bucketConfigVersioning:
+		if remove {
+			return doSetVersioning(ctx, mcClient, VersionSuspend, nil, false)
+		}
+		return doSetVersioning(ctx, mcClient, VersionEnable, desired.Versioning.ExcludePrefixes, desired.Versioning.ExcludeFolders)
+	case bucketConfigRetention:
+		if r := desired.Retention; r != nil {
+			return setBucketRetentionConfig(ctx, client, bucketName, r.Mode, r.Unit, r.Validity)
+		}
+		// an object lock configuration without mode clears the default retention
+		return client.setObjectLockConfig(ctx, bucketName, nil, nil, nil)
+	case bucketConfigEncryption:
+		if remove {
+			return client.removeBucketEncryption(ctx, bucketName)
+		}
+		encryption, err := bucketEncryptionConfig(desired.Encryption)
+		if err != nil {
+			return err
+		}
+		return client.setBucketEncryption(ctx, bucketName, encryption)
+	case bucketConfigPolicy:
+		// an empty policy removes the bucket policy
+		return client.setBucketPolicyWithContext(ctx, bucketName, desired.Policy)
+	case bucketConfigTags:
+		if remove {
+			return client.RemoveBucketTagging(ctx, bucketName)
+		}
+		bucketTags, err := tags.NewTags(desired.Tags, false)
+		if err != nil {
+			return err
+		}
+		return client.SetBucketTagging(ctx, bucketName, bucketTags)
+	case bucketConfigLifecycle:
+		lifecycleConfig, err := bundleLifecycleConfig(desired.Lifecycle)
+		if err != nil {
+			return err
+		}
+		if remove {
+			// an empty lifecycle configuration removes it
+			return client.setBucketLifecycle(ctx, bucketName, lifecycleConfig)
+		}
+		for i := range lifecycleConfig.Rules {
+			if lifecycleConfig.Rules[i].ID == "" {
+				lifecycleConfig.Rules[i].ID = uuid.NewString()
+			}
+		}
+		return setValidatedBucketLifecycle(ctx, client, adminClient, bucketName, lifecycleConfig)
+	case bucketConfigNotifications:
+		config, err := bucketNotificationConfiguration(desired.Notifications)
+		if err != nil {
+			return err
+		}
+		return client.setBucketNotification(ctx, bucketName, config)
+	case bucketConfigQuota:
+		if remove {
+			// an empty quota configuration clears the bucket quota
+			return adminClient.setBucketQuota(ctx, bucketName, &madmin.BucketQuota{})
+		}
+		return setBucketQuota(ctx, adminClient, bucketName, desired.Quota)
+	}
+	return nil
+}
+
+// applyBucketConfigImport applies the planned changes section by section. Sections applied before a
+// failure are kept, the error names the section that could not be applied.
+func applyBucketConfigImport(ctx context.Context, client MinioClient, mcClient MCClient, adminClient MinioAdmin, bucketName string, desired *models.BucketConfigBundle, changes []*models.BucketConfigChange) error {
+	var conflicts []string
+	for _, change := range changes {
+		if change.Action == bucketConfigConflict {
+			conflicts = append(conflicts, fmt.Sprintf("%s: %s", change.Section, change.Message))
+		}
+	}
+	if len(conflicts) > 0 {
+		return fmt.Errorf("%w: %s", ErrBucketConfigConflict, strings.Join(conflicts, ", "))
+	}
+	for _, change := range changes {
+		if change.Action == bucketConfigUnchanged {
+			continue
+		}
+		if err := applyBucketConfigSection(ctx, client, mcClient, adminClient, bucketName, desired, change); err != nil {
+			return fmt.Errorf("unable to apply bucket %s configuration: %w", change.Section, err)
+		}
+	}
+	return nil
+}
+
+// complianceRetentionChange returns the retention change when the import sets a COMPLIANCE retention
+func complianceRetentionChange(desired *models.BucketConfigBundle, changes []*models.BucketConfigChange) *models.GetBucketRetentionConfig {
+	for _, change := range changes {
+		if change.Section != bucketConfigRetention || (change.Action != bucketConfigAdd && change.Action != bucketConfigUpdate) {
+			continue
+		}
+		if desired.Retention != nil && desired.Retention.Mode == models.ObjectRetentionModeCompliance {
+			return desired.Retention
+		}
+	}
+	return nil
+}
+
+func getExportBucketConfigResponse(session *models.Principal, params bucketApi.ExportBucketConfigParams) (*models.BucketConfigBundle, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+	mAdmin, err := NewMinioAdminClient(ctx, session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	adminClient := AdminClient{Client: mAdmin}
+
+	bundle, err := exportBucketConfig(ctx, minioClient, adminClient, params.BucketName)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return bundle, nil
+}
+
+// getImportBucketConfigResponse previews the changes a bundle makes to a bucket and applies them unless
+// it is a dry run. As when setting the retention directly, a COMPLIANCE retention has to be confirmed.
+func getImportBucketConfigResponse(session *models.Principal, params bucketApi.ImportBucketConfigParams) (*models.BucketConfigImportResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	req := params.Body
+	if req == nil || req.Bundle == nil {
+		return nil, ErrorWithContext(ctx, ErrBucketBodyNotInRequest)
+	}
+	desired, err := normalizeBucketConfigBundle(req.Bundle, params.BucketName)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+	s3Client, err := newS3BucketClient(session, params.BucketName, "", getClientIP(params.HTTPRequest))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a mc S3Client interface implementation
+	// defining the client to be used
+	amcClient := mcClient{client: s3Client}
+	mAdmin, err := NewMinioAdminClient(ctx, session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	adminClient := AdminClient{Client: mAdmin}
+
+	current, err := exportBucketConfig(ctx, minioClient, adminClient, params.BucketName)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	changes, err := planBucketConfigImport(current, desired, req.Sections)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	resp := &models.BucketConfigImportResponse{Changes: changes}
+	if r := complianceRetentionChange(desired, changes); r != nil &&
+		!validComplianceConfirmationToken(session, params.BucketName, r.Unit, r.Validity, req.ConfirmationToken, time.Now()) {
+		expiry := time.Now().Add(complianceConfirmationTTL).Unix()
+		resp.ConfirmationRequired = true
+		resp.Warning = complianceModeWarning
+		resp.ConfirmationToken = complianceConfirmationToken(session, params.BucketName, r.Unit, r.Validity, expiry)
+		return resp, nil
+	}
+	if req.DryRun {
+		return resp, nil
+	}
+	err = applyBucketConfigImport(ctx, minioClient, amcClient, adminClient, params.BucketName, desired, changes)
+	// sections applied before a failure may show in the bucket list too
+	globalAccountInfoCache.invalidate(sessionCacheKey(session))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	resp.Applied = true
+	return resp, nil
+}
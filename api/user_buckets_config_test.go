@@ -0,0 +1,242 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+	"github.com/minio/minio-go/v7/pkg/notification"
+	"github.com/minio/minio-go/v7/pkg/sse"
+	"github.com/minio/minio-go/v7/pkg/tags"
+	"github.com/stretchr/testify/assert"
+)
+
+const testBucketPolicy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["*"]},` +
+	`"Action":["s3:GetObject"],"Resource":["arn:aws:s3:::staging/*"]}]}`
+
+func Test_exportBucketConfig(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	retentionMode := minio.Governance
+	retentionUnit := minio.Days
+	retentionValidity := uint(30)
+	queueArn := "arn:minio:sqs::1:webhook"
+	client := minioClientMock{
+		getBucketVersioningMock: func(_ context.Context, _ string) (minio.BucketVersioningConfiguration, error) {
+			return minio.BucketVersioningConfiguration{
+				Status:           "Enabled",
+				ExcludedPrefixes: []minio.ExcludedPrefix{{Prefix: "tmp/"}},
+			}, nil
+		},
+		getBucketPolicyMock: func(_ string) (string, error) {
+			return testBucketPolicy, nil
+		},
+		getBucketEncryptionMock: func(_ context.Context, _ string) (*sse.Configuration, error) {
+			return sse.NewConfigurationSSEKMS("key"), nil
+		},
+		getObjectLockConfigMock: func(_ context.Context, _ string) (string, *minio.RetentionMode, *uint, *minio.ValidityUnit, error) {
+			return "Enabled", &retentionMode, &retentionValidity, &retentionUnit, nil
+		},
+		getBucketObjectLockConfigMock: func(_ context.Context, _ string) (*minio.RetentionMode, *uint, *minio.ValidityUnit, error) {
+			return &retentionMode, &retentionValidity, &retentionUnit, nil
+		},
+		getLifecycleRulesMock: func(_ context.Context, _ string) (*lifecycle.Configuration, error) {
+			return nil, minio.ErrorResponse{Code: "NoSuchLifecycleConfiguration"}
+		},
+		getBucketNotificationMock: func(_ context.Context, _ string) (notification.Configuration, error) {
+			arn, _ := notification.NewArnFromString(queueArn)
+			c := notification.NewConfig(arn)
+			c.AddEvents(notification.ObjectCreatedAll)
+			c.AddFilterPrefix("photos/")
+			var config notification.Configuration
+			config.AddQueue(c)
+			return config, nil
+		},
+	}
+	client.getBucketTaggingMock = func(_ context.Context, _ string) (*tags.Tags, error) {
+		return nil, minio.ErrorResponse{Code: "NoSuchTagSet"}
+	}
+	adminClient := AdminClientMock{
+		minioGetBucketQuotaMock: func(_ context.Context, _ string) (madmin.BucketQuota, error) {
+			return madmin.BucketQuota{Size: 1024, Type: madmin.HardQuota}, nil
+		},
+	}
+
+	bundle, err := exportBucketConfig(ctx, client, adminClient, "staging")
+	assert.Nil(err)
+	assert.Equal("staging", bundle.Bucket)
+	assert.Equal(&models.SetBucketVersioning{Enabled: true, ExcludePrefixes: []string{"tmp/"}}, bundle.Versioning)
+	assert.Equal(testBucketPolicy, bundle.Policy)
+	assert.Empty(bundle.Tags)
+	assert.Equal(&models.BucketEncryptionSettings{Type: swag.String(bucketEncryptionSSEKMS), KmsKeyID: "key"}, bundle.Encryption)
+	assert.True(bundle.Locking)
+	assert.Equal(&models.GetBucketRetentionConfig{Mode: models.ObjectRetentionModeGovernance, Unit: models.ObjectRetentionUnitDays, Validity: 30}, bundle.Retention)
+	assert.Nil(bundle.Lifecycle)
+	assert.Equal([]*models.BucketNotificationConfig{{Arn: &queueArn, Events: []string{"s3:ObjectCreated:*"}, Prefix: "photos/"}}, bundle.Notifications)
+	assert.Equal(int64(1024), bundle.Quota)
+
+	// the quota is left out when it can't be read
+	adminClient.minioGetBucketQuotaMock = func(_ context.Context, _ string) (madmin.BucketQuota, error) {
+		return madmin.BucketQuota{}, errors.New("access denied")
+	}
+	bundle, err = exportBucketConfig(ctx, client, adminClient, "staging")
+	assert.Nil(err)
+	assert.Equal(int64(0), bundle.Quota)
+
+	// errors other than a missing configuration fail the export
+	client.getBucketTaggingMock = func(_ context.Context, _ string) (*tags.Tags, error) {
+		return nil, errors.New("access denied")
+	}
+	_, err = exportBucketConfig(ctx, client, adminClient, "staging")
+	assert.Equal("access denied", err.Error())
+}
+
+func Test_normalizeBucketConfigBundle(t *testing.T) {
+	assert := assert.New(t)
+	bundle, err := normalizeBucketConfigBundle(&models.BucketConfigBundle{
+		Bucket: "staging",
+		Policy: testBucketPolicy,
+		Lifecycle: map[string]interface{}{"Rules": []interface{}{map[string]interface{}{
+			"ID": "expire", "Status": "Enabled", "Expiration": map[string]interface{}{"Days": 7},
+		}}},
+	}, "production")
+	assert.Nil(err)
+	assert.Equal("production", bundle.Bucket)
+	assert.Contains(bundle.Policy, "arn:aws:s3:::production/*")
+	lifecycleConfig, err := bundleLifecycleConfig(bundle.Lifecycle)
+	assert.Nil(err)
+	assert.Equal(lifecycle.ExpirationDays(7), lifecycleConfig.Rules[0].Expiration.Days)
+
+	// buckets sharing a prefix with the source bucket are left untouched
+	assert.Equal(`"arn:aws:s3:::staging-logs/*"`, rewritePolicyResources(`"arn:aws:s3:::staging-logs/*"`, "staging", "production"))
+
+	for _, invalid := range []*models.BucketConfigBundle{
+		{Policy: "{"},
+		{Retention: &models.GetBucketRetentionConfig{Mode: models.ObjectRetentionModeGovernance, Unit: models.ObjectRetentionUnitDays, Validity: 1}},
+		{Notifications: []*models.BucketNotificationConfig{{Arn: swag.String("arn:minio:s3::1:webhook")}}},
+		{Encryption: &models.BucketEncryptionSettings{Type: swag.String(bucketEncryptionSSEKMS)}},
+		{Quota: -1},
+	} {
+		_, err := normalizeBucketConfigBundle(invalid, "production")
+		assert.ErrorIs(err, ErrInvalidBucketConfigBundle)
+	}
+}
+
+func Test_planBucketConfigImport(t *testing.T) {
+	assert := assert.New(t)
+	current := &models.BucketConfigBundle{
+		Bucket:     "production",
+		Versioning: &models.SetBucketVersioning{Enabled: true, ExcludePrefixes: []string{}},
+		Tags:       map[string]string{"team": "audit"},
+		Quota:      1024,
+	}
+	desired := &models.BucketConfigBundle{
+		Bucket:     "production",
+		Versioning: &models.SetBucketVersioning{Enabled: true},
+		Tags:       map[string]string{"team": "platform"},
+		Policy:     testBucketPolicy,
+		Locking:    true,
+	}
+	changes, err := planBucketConfigImport(current, desired, nil)
+	assert.Nil(err)
+	actions := map[string]string{}
+	for _, change := range changes {
+		actions[change.Section] = change.Action
+	}
+	assert.Equal(map[string]string{
+		bucketConfigVersioning:    bucketConfigUnchanged,
+		bucketConfigRetention:     bucketConfigConflict,
+		bucketConfigEncryption:    bucketConfigUnchanged,
+		bucketConfigPolicy:        bucketConfigAdd,
+		bucketConfigTags:          bucketConfigUpdate,
+		bucketConfigLifecycle:     bucketConfigUnchanged,
+		bucketConfigNotifications: bucketConfigUnchanged,
+		bucketConfigQuota:         bucketConfigRemove,
+	}, actions)
+	assert.Equal(`{"team":"audit"}`, changes[4].Current)
+	assert.Equal(`{"team":"platform"}`, changes[4].Desired)
+
+	// conflicting sections can be left out of the import
+	changes, err = planBucketConfigImport(current, desired, []string{bucketConfigTags, bucketConfigQuota})
+	assert.Nil(err)
+	assert.Len(changes, 2)
+
+	_, err = planBucketConfigImport(current, desired, []string{"replication"})
+	assert.ErrorIs(err, ErrInvalidBucketConfigBundle)
+}
+
+func Test_applyBucketConfigImport(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	var applied []string
+	client := minioClientMock{
+		setBucketPolicyWithContextMock: func(_ context.Context, _, policy string) error {
+			applied = append(applied, "policy")
+			assert.Equal(testBucketPolicy, policy)
+			return nil
+		},
+		setBucketTaggingMock: func(_ context.Context, _ string, bucketTags *tags.Tags) error {
+			applied = append(applied, "tags")
+			assert.Equal(map[string]string{"team": "platform"}, bucketTags.ToMap())
+			return nil
+		},
+		setBucketNotificationMock: func(_ context.Context, _ string, config notification.Configuration) error {
+			applied = append(applied, "notifications")
+			assert.Len(config.QueueConfigs, 1)
+			return errors.New("ARN not found")
+		},
+	}
+	adminClient := AdminClientMock{
+		minioSetBucketQuotaMock: func(_ context.Context, _ string, quota *madmin.BucketQuota) error {
+			applied = append(applied, "quota")
+			assert.Equal(&madmin.BucketQuota{}, quota)
+			return nil
+		},
+	}
+	current := &models.BucketConfigBundle{Quota: 1024}
+	desired := &models.BucketConfigBundle{
+		Policy:        testBucketPolicy,
+		Tags:          map[string]string{"team": "platform"},
+		Notifications: []*models.BucketNotificationConfig{{Arn: swag.String("arn:minio:sqs::1:webhook"), Events: []string{"s3:ObjectCreated:*"}}},
+	}
+
+	changes, err := planBucketConfigImport(current, desired, []string{bucketConfigPolicy, bucketConfigTags, bucketConfigQuota})
+	assert.Nil(err)
+	assert.Nil(applyBucketConfigImport(ctx, client, s3ClientMock{}, adminClient, "production", desired, changes))
+	assert.Equal([]string{"policy", "tags", "quota"}, applied)
+
+	// sections are applied in order until one fails
+	applied = nil
+	changes, _ = planBucketConfigImport(current, desired, nil)
+	err = applyBucketConfigImport(ctx, client, s3ClientMock{}, adminClient, "production", desired, changes)
+	assert.Equal("unable to apply bucket notifications configuration: ARN not found", err.Error())
+	assert.Equal([]string{"policy", "tags", "notifications"}, applied)
+
+	// nothing is applied when a section conflicts with the bucket
+	applied = nil
+	changes, _ = planBucketConfigImport(current, &models.BucketConfigBundle{Locking: true, Policy: testBucketPolicy}, nil)
+	err = applyBucketConfigImport(ctx, client, s3ClientMock{}, adminClient, "production", desired, changes)
+	assert.ErrorIs(err, ErrBucketConfigConflict)
+	assert.Empty(applied)
+}
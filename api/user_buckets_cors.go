@@ -0,0 +1,406 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/api/operations"
+	bucketApi "github.com/minio/console/api/operations/bucket"
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7/pkg/cors"
+)
+
+// maxCorsRules is the maximum number of rules S3 accepts in a CORS configuration
+const maxCorsRules = 100
+
+// corsMethods are the methods that can be allowed by a CORS rule
+var corsMethods = []string{"GET", "PUT", "HEAD", "POST", "DELETE"}
+
+func registerBucketsCorsHandlers(api *operations.ConsoleAPI) {
+	// get bucket CORS configuration
+	api.BucketGetBucketCorsHandler = bucketApi.GetBucketCorsHandlerFunc(func(params bucketApi.GetBucketCorsParams, session *models.Principal) middleware.Responder {
+		resp, err := getBucketCorsResponse(session, params)
+		if err != nil {
+			return bucketApi.NewGetBucketCorsDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucketApi.NewGetBucketCorsOK().WithPayload(resp)
+	})
+	// set bucket CORS configuration
+	api.BucketSetBucketCorsHandler = bucketApi.SetBucketCorsHandlerFunc(func(params bucketApi.SetBucketCorsParams, session *models.Principal) middleware.Responder {
+		if err := getSetBucketCorsResponse(session, params); err != nil {
+			return bucketApi.NewSetBucketCorsDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucketApi.NewSetBucketCorsOK()
+	})
+	// remove bucket CORS configuration
+	api.BucketDeleteBucketCorsHandler = bucketApi.DeleteBucketCorsHandlerFunc(func(params bucketApi.DeleteBucketCorsParams, session *models.Principal) middleware.Responder {
+		if err := getDeleteBucketCorsResponse(session, params); err != nil {
+			return bucketApi.NewDeleteBucketCorsDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucketApi.NewDeleteBucketCorsNoContent()
+	})
+	// evaluate a request against the CORS rules
+	api.BucketTestBucketCorsHandler = bucketApi.TestBucketCorsHandlerFunc(func(params bucketApi.TestBucketCorsParams, session *models.Principal) middleware.Responder {
+		resp, err := getTestBucketCorsResponse(session, params)
+		if err != nil {
+			return bucketApi.NewTestBucketCorsDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucketApi.NewTestBucketCorsOK().WithPayload(resp)
+	})
+}
+
+func corsRuleToModel(rule cors.Rule) *models.CorsRule {
+	return &models.CorsRule{
+		ID:             rule.ID,
+		AllowedOrigins: rule.AllowedOrigin,
+		AllowedMethods: rule.AllowedMethod,
+		AllowedHeaders: rule.AllowedHeader,
+		ExposeHeaders:  rule.ExposeHeader,
+		MaxAgeSeconds:  int64(rule.MaxAgeSeconds),
+	}
+}
+
+// trimValues removes surrounding spaces and empty values
+func trimValues(values []string) []string {
+	var trimmed []string
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			trimmed = append(trimmed, v)
+		}
+	}
+	return trimmed
+}
+
+func corsRuleFromModel(rule *models.CorsRule) cors.Rule {
+	methods := trimValues(rule.AllowedMethods)
+	for i := range methods {
+		methods[i] = strings.ToUpper(methods[i])
+	}
+	return cors.Rule{
+		ID:            strings.TrimSpace(rule.ID),
+		AllowedOrigin: trimValues(rule.AllowedOrigins),
+		AllowedMethod: methods,
+		AllowedHeader: trimValues(rule.AllowedHeaders),
+		ExposeHeader:  trimValues(rule.ExposeHeaders),
+		MaxAgeSeconds: int(rule.MaxAgeSeconds),
+	}
+}
+
+// isHeaderToken returns true if s only contains characters allowed in HTTP header names
+func isHeaderToken(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case strings.ContainsRune("!#$%&'*+-.^_`|~", c):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// validateCorsRule checks the rule follows the same restrictions S3 applies to CORS rules
+func validateCorsRule(position int, rule cors.Rule) error {
+	name := strconv.Itoa(position)
+	if rule.ID != "" {
+		name = fmt.Sprintf("%d (%s)", position, rule.ID)
+	}
+	if len(rule.ID) > 255 {
+		return fmt.Errorf("CORS rule %s: ID cannot be longer than 255 characters", name)
+	}
+	if len(rule.AllowedOrigin) == 0 {
+		return fmt.Errorf("CORS rule %s: at least one allowed origin is required", name)
+	}
+	for _, origin := range rule.AllowedOrigin {
+		if strings.Count(origin, "*") > 1 {
+			return fmt.Errorf("CORS rule %s: origin %s can contain at most one wildcard", name, origin)
+		}
+		if origin == "*" {
+			continue
+		}
+		scheme, host, ok := strings.Cut(origin, "://")
+		if !ok || scheme == "" || host == "" {
+			return fmt.Errorf("CORS rule %s: origin %s must include the scheme, e.g. https://example.com", name, origin)
+		}
+		if strings.Contains(host, "/") {
+			return fmt.Errorf("CORS rule %s: origin %s cannot include a path", name, origin)
+		}
+	}
+	if len(rule.AllowedMethod) == 0 {
+		return fmt.Errorf("CORS rule %s: at least one allowed method is required", name)
+	}
+	for _, method := range rule.AllowedMethod {
+		if !slices.Contains(corsMethods, method) {
+			return fmt.Errorf("CORS rule %s: unsupported method %s, allowed methods are %s", name, method, strings.Join(corsMethods, ", "))
+		}
+	}
+	for _, header := range rule.AllowedHeader {
+		if strings.Count(header, "*") > 1 || !isHeaderToken(header) {
+			return fmt.Errorf("CORS rule %s: invalid allowed header %s", name, header)
+		}
+	}
+	for _, header := range rule.ExposeHeader {
+		if strings.Contains(header, "*") || !isHeaderToken(header) {
+			return fmt.Errorf("CORS rule %s: invalid expose header %s", name, header)
+		}
+	}
+	if rule.MaxAgeSeconds < 0 {
+		return fmt.Errorf("CORS rule %s: max age cannot be negative", name)
+	}
+	return nil
+}
+
+// corsRulesFromModel converts and validates the rules of a CORS configuration
+func corsRulesFromModel(rules []*models.CorsRule) ([]cors.Rule, error) {
+	if len(rules) == 0 {
+		return nil, fmt.Errorf("%w: at least one rule is required, remove the configuration to disable CORS", ErrInvalidCorsConfig)
+	}
+	if len(rules) > maxCorsRules {
+		return nil, fmt.Errorf("%w: a configuration cannot have more than %d rules", ErrInvalidCorsConfig, maxCorsRules)
+	}
+	corsRules := make([]cors.Rule, 0, len(rules))
+	for i, r := range rules {
+		if r == nil {
+			return nil, fmt.Errorf("%w: CORS rule %d is empty", ErrInvalidCorsConfig, i+1)
+		}
+		rule := corsRuleFromModel(r)
+		if err := validateCorsRule(i+1, rule); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidCorsConfig, err)
+		}
+		corsRules = append(corsRules, rule)
+	}
+	return corsRules, nil
+}
+
+// getBucketCors returns the CORS rules of the bucket, an empty list is returned when
+// the bucket doesn't have a CORS configuration
+func getBucketCors(ctx context.Context, client MinioClient, bucketName string) ([]cors.Rule, error) {
+	corsConfig, err := client.getBucketCors(ctx, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	if corsConfig == nil {
+		return []cors.Rule{}, nil
+	}
+	return corsConfig.CORSRules, nil
+}
+
+func getBucketCorsResponse(session *models.Principal, params bucketApi.GetBucketCorsParams) (*models.BucketCorsConfiguration, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+	rules, err := getBucketCors(ctx, minioClient, params.BucketName)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	resp := &models.BucketCorsConfiguration{Rules: []*models.CorsRule{}}
+	for _, rule := range rules {
+		resp.Rules = append(resp.Rules, corsRuleToModel(rule))
+	}
+	return resp, nil
+}
+
+// setBucketCors validates and replaces the CORS configuration of the bucket
+func setBucketCors(ctx context.Context, client MinioClient, bucketName string, config *models.BucketCorsConfiguration) error {
+	rules, err := corsRulesFromModel(config.Rules)
+	if err != nil {
+		return err
+	}
+	return client.setBucketCors(ctx, bucketName, cors.NewConfig(rules))
+}
+
+func getSetBucketCorsResponse(session *models.Principal, params bucketApi.SetBucketCorsParams) *CodedAPIError {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+	if err := setBucketCors(ctx, minioClient, params.BucketName, params.Body); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
+
+func getDeleteBucketCorsResponse(session *models.Principal, params bucketApi.DeleteBucketCorsParams) *CodedAPIError {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+	if err := minioClient.setBucketCors(ctx, params.BucketName, nil); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
+
+// corsWildcardMatch matches value against a pattern containing at most one `*`
+func corsWildcardMatch(pattern, value string) bool {
+	prefix, suffix, found := strings.Cut(pattern, "*")
+	if !found {
+		return pattern == value
+	}
+	return len(value) >= len(prefix)+len(suffix) && strings.HasPrefix(value, prefix) && strings.HasSuffix(value, suffix)
+}
+
+func corsMatchAny(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if corsWildcardMatch(strings.ToLower(pattern), strings.ToLower(value)) {
+			return true
+		}
+	}
+	return false
+}
+
+// evaluateCorsRequest evaluates a cross-origin request the way MinIO does: rules are checked
+// in order and the first rule allowing the origin, the method and every requested header is
+// applied.
+func evaluateCorsRequest(rules []cors.Rule, origin, method string, requestHeaders []string) *models.CorsTestResponse {
+	method = strings.ToUpper(method)
+	headers := trimValues(requestHeaders)
+	resp := &models.CorsTestResponse{Evaluations: []*models.CorsRuleEvaluation{}}
+
+	for i, rule := range rules {
+		eval := &models.CorsRuleEvaluation{
+			Rule:           int32(i + 1),
+			ID:             rule.ID,
+			OriginAllowed:  corsMatchAny(rule.AllowedOrigin, origin),
+			MethodAllowed:  slices.Contains(rule.AllowedMethod, method),
+			HeadersAllowed: true,
+		}
+		var deniedHeader string
+		for _, header := range headers {
+			if !corsMatchAny(rule.AllowedHeader, header) {
+				eval.HeadersAllowed = false
+				deniedHeader = header
+				break
+			}
+		}
+		switch {
+		case !eval.OriginAllowed:
+			eval.Reason = fmt.Sprintf("origin %s is not allowed", origin)
+		case !eval.MethodAllowed:
+			eval.Reason = fmt.Sprintf("method %s is not allowed", method)
+		case !eval.HeadersAllowed:
+			eval.Reason = fmt.Sprintf("header %s is not allowed", deniedHeader)
+		case resp.Allowed:
+			eval.Reason = fmt.Sprintf("matches, but rule %d takes precedence", resp.MatchedRule)
+		default:
+			eval.Reason = "matches"
+			resp.Allowed = true
+			resp.MatchedRule = eval.Rule
+			resp.ResponseHeaders = corsResponseHeaders(rule, origin, headers)
+		}
+		resp.Evaluations = append(resp.Evaluations, eval)
+	}
+
+	switch {
+	case len(rules) == 0:
+		resp.Explanation = "the bucket has no CORS configuration, browsers will block cross-origin requests"
+	case resp.Allowed:
+		resp.Explanation = fmt.Sprintf("rule %d allows %s requests from %s", resp.MatchedRule, method, origin)
+		if id := rules[resp.MatchedRule-1].ID; id != "" {
+			resp.Explanation = fmt.Sprintf("rule %d (%s) allows %s requests from %s", resp.MatchedRule, id, method, origin)
+		}
+	default:
+		resp.Explanation = fmt.Sprintf("no rule allows %s requests from %s", method, origin)
+		if len(headers) > 0 {
+			resp.Explanation += " with headers " + strings.Join(headers, ", ")
+		}
+		resp.Explanation += ", browsers will block the request"
+	}
+	return resp
+}
+
+// corsResponseHeaders returns the headers sent back by MinIO when rule is applied to a request
+func corsResponseHeaders(rule cors.Rule, origin string, requestHeaders []string) map[string]string {
+	headers := map[string]string{
+		"Access-Control-Allow-Origin":  origin,
+		"Access-Control-Allow-Methods": strings.Join(rule.AllowedMethod, ", "),
+		"Vary":                         "Origin",
+	}
+	if len(requestHeaders) > 0 {
+		headers["Access-Control-Allow-Headers"] = strings.Join(requestHeaders, ", ")
+	}
+	if len(rule.ExposeHeader) > 0 {
+		headers["Access-Control-Expose-Headers"] = strings.Join(rule.ExposeHeader, ", ")
+	}
+	if rule.MaxAgeSeconds > 0 {
+		headers["Access-Control-Max-Age"] = strconv.Itoa(rule.MaxAgeSeconds)
+	}
+	return headers
+}
+
+// testBucketCors evaluates the request against the rules provided in the request, or the bucket
+// configuration when no rules are provided
+func testBucketCors(ctx context.Context, client MinioClient, bucketName string, req *models.CorsTestRequest) (*models.CorsTestResponse, error) {
+	origin := strings.TrimSpace(*req.Origin)
+	method := strings.TrimSpace(*req.Method)
+	if origin == "" || method == "" {
+		return nil, fmt.Errorf("%w: origin and method are required", ErrInvalidCorsConfig)
+	}
+	var rules []cors.Rule
+	var err error
+	if len(req.Rules) > 0 {
+		rules, err = corsRulesFromModel(req.Rules)
+	} else {
+		rules, err = getBucketCors(ctx, client, bucketName)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return evaluateCorsRequest(rules, origin, method, req.RequestHeaders), nil
+}
+
+func getTestBucketCorsResponse(session *models.Principal, params bucketApi.TestBucketCorsParams) (*models.CorsTestResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+	resp, err := testBucketCors(ctx, minioClient, params.BucketName, params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,165 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7/pkg/cors"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_setBucketCors(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+
+	var setConfig *cors.Config
+	minClient.setBucketCorsMock = func(_ context.Context, _ string, corsConfig *cors.Config) error {
+		setConfig = corsConfig
+		return nil
+	}
+
+	err := setBucketCors(ctx, minClient, "bucket", &models.BucketCorsConfiguration{
+		Rules: []*models.CorsRule{
+			{
+				ID:             "upload",
+				AllowedOrigins: []string{"https://*.example.com"},
+				AllowedMethods: []string{"put", " POST"},
+				AllowedHeaders: []string{"*"},
+				ExposeHeaders:  []string{"ETag"},
+				MaxAgeSeconds:  3000,
+			},
+		},
+	})
+	assert.Nil(err)
+	assert.Len(setConfig.CORSRules, 1)
+	assert.Equal([]string{"PUT", "POST"}, setConfig.CORSRules[0].AllowedMethod)
+	assert.Equal(3000, setConfig.CORSRules[0].MaxAgeSeconds)
+
+	tests := []struct {
+		name string
+		rule *models.CorsRule
+	}{
+		{"no origins", &models.CorsRule{AllowedMethods: []string{"GET"}}},
+		{"two wildcards", &models.CorsRule{AllowedOrigins: []string{"https://*.*.com"}, AllowedMethods: []string{"GET"}}},
+		{"missing scheme", &models.CorsRule{AllowedOrigins: []string{"example.com"}, AllowedMethods: []string{"GET"}}},
+		{"origin with path", &models.CorsRule{AllowedOrigins: []string{"https://example.com/app"}, AllowedMethods: []string{"GET"}}},
+		{"no methods", &models.CorsRule{AllowedOrigins: []string{"*"}}},
+		{"unsupported method", &models.CorsRule{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"PATCH"}}},
+		{"invalid header", &models.CorsRule{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"GET"}, AllowedHeaders: []string{"x amz"}}},
+		{"wildcard expose header", &models.CorsRule{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"GET"}, ExposeHeaders: []string{"x-amz-*"}}},
+		{"negative max age", &models.CorsRule{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"GET"}, MaxAgeSeconds: -1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(_ *testing.T) {
+			err := setBucketCors(ctx, minClient, "bucket", &models.BucketCorsConfiguration{Rules: []*models.CorsRule{tt.rule}})
+			assert.True(errors.Is(err, ErrInvalidCorsConfig), err)
+		})
+	}
+
+	err = setBucketCors(ctx, minClient, "bucket", &models.BucketCorsConfiguration{})
+	assert.True(errors.Is(err, ErrInvalidCorsConfig))
+}
+
+func Test_evaluateCorsRequest(t *testing.T) {
+	assert := assert.New(t)
+	rules := []cors.Rule{
+		{
+			ID:            "read",
+			AllowedOrigin: []string{"*"},
+			AllowedMethod: []string{"GET", "HEAD"},
+		},
+		{
+			ID:            "upload",
+			AllowedOrigin: []string{"https://*.example.com"},
+			AllowedMethod: []string{"PUT", "POST"},
+			AllowedHeader: []string{"content-type", "x-amz-*"},
+			ExposeHeader:  []string{"ETag"},
+			MaxAgeSeconds: 600,
+		},
+		{
+			AllowedOrigin: []string{"https://app.example.com"},
+			AllowedMethod: []string{"PUT"},
+			AllowedHeader: []string{"*"},
+		},
+	}
+
+	resp := evaluateCorsRequest(rules, "https://app.example.com", "put", []string{"Content-Type", "X-Amz-Meta-Owner"})
+	assert.True(resp.Allowed)
+	assert.Equal(int32(2), resp.MatchedRule)
+	assert.Equal("rule 2 (upload) allows PUT requests from https://app.example.com", resp.Explanation)
+	assert.Equal("https://app.example.com", resp.ResponseHeaders["Access-Control-Allow-Origin"])
+	assert.Equal("ETag", resp.ResponseHeaders["Access-Control-Expose-Headers"])
+	assert.Equal("600", resp.ResponseHeaders["Access-Control-Max-Age"])
+	assert.Len(resp.Evaluations, 3)
+	assert.Equal("method PUT is not allowed", resp.Evaluations[0].Reason)
+	assert.Equal("matches", resp.Evaluations[1].Reason)
+	assert.Equal("matches, but rule 2 takes precedence", resp.Evaluations[2].Reason)
+
+	// only the last rule allows any header
+	resp = evaluateCorsRequest(rules, "https://app.example.com", "PUT", []string{"Authorization"})
+	assert.True(resp.Allowed)
+	assert.Equal(int32(3), resp.MatchedRule)
+	assert.Equal("header Authorization is not allowed", resp.Evaluations[1].Reason)
+
+	resp = evaluateCorsRequest(rules, "https://example.net", "POST", nil)
+	assert.False(resp.Allowed)
+	assert.Equal(int32(0), resp.MatchedRule)
+	assert.Equal("no rule allows POST requests from https://example.net, browsers will block the request", resp.Explanation)
+	assert.Equal("origin https://example.net is not allowed", resp.Evaluations[1].Reason)
+
+	resp = evaluateCorsRequest(nil, "https://example.net", "GET", nil)
+	assert.False(resp.Allowed)
+	assert.Empty(resp.Evaluations)
+}
+
+func Test_testBucketCors(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	minClient.getBucketCorsMock = func(_ context.Context, _ string) (*cors.Config, error) {
+		return nil, nil
+	}
+
+	// bucket without configuration
+	resp, err := testBucketCors(ctx, minClient, "bucket", &models.CorsTestRequest{
+		Origin: swag.String("https://app.example.com"),
+		Method: swag.String("GET"),
+	})
+	assert.Nil(err)
+	assert.False(resp.Allowed)
+
+	// draft rules are evaluated instead of the bucket configuration
+	resp, err = testBucketCors(ctx, minClient, "bucket", &models.CorsTestRequest{
+		Origin: swag.String("https://app.example.com"),
+		Method: swag.String("GET"),
+		Rules:  []*models.CorsRule{{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"GET"}}},
+	})
+	assert.Nil(err)
+	assert.True(resp.Allowed)
+
+	_, err = testBucketCors(ctx, minClient, "bucket", &models.CorsTestRequest{
+		Origin: swag.String(""),
+		Method: swag.String("GET"),
+	})
+	assert.True(errors.Is(err, ErrInvalidCorsConfig))
+}
@@ -0,0 +1,350 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/api/operations"
+	bucketApi "github.com/minio/console/api/operations/bucket"
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+)
+
+const (
+	objectDiffOnlyInSource = "only_in_source"
+	objectDiffOnlyInTarget = "only_in_target"
+	objectDiffDifferent    = "different"
+
+	objectDiffReasonSize     = "size"
+	objectDiffReasonETag     = "etag"
+	objectDiffReasonModified = "modified"
+)
+
+var objectDiffCSVHeader = []string{
+	"key", "diff", "reasons", "source_size", "target_size", "source_etag", "target_etag",
+	"source_last_modified", "target_last_modified",
+}
+
+type objectDiffOpts struct {
+	SourceBucket string
+	SourcePrefix string
+	TargetBucket string
+	TargetPrefix string
+}
+
+// ObjectDiff describes an object that is not the same in the source and the target locations,
+// keys are relative to the compared prefixes
+type ObjectDiff struct {
+	Key            string   `json:"key"`
+	Diff           string   `json:"diff"`
+	Reasons        []string `json:"reasons,omitempty"`
+	SourceSize     int64    `json:"source_size,omitempty"`
+	TargetSize     int64    `json:"target_size,omitempty"`
+	SourceETag     string   `json:"source_etag,omitempty"`
+	TargetETag     string   `json:"target_etag,omitempty"`
+	SourceModified string   `json:"source_last_modified,omitempty"`
+	TargetModified string   `json:"target_last_modified,omitempty"`
+}
+
+// ObjectDiffSummary is sent once the comparison is over
+type ObjectDiffSummary struct {
+	SourceObjects int64 `json:"source_objects"`
+	TargetObjects int64 `json:"target_objects"`
+	OnlyInSource  int64 `json:"only_in_source"`
+	OnlyInTarget  int64 `json:"only_in_target"`
+	Different     int64 `json:"different"`
+	Identical     int64 `json:"identical"`
+}
+
+func registerBucketsDiffHandlers(api *operations.ConsoleAPI) {
+	// download bucket differences as csv
+	api.BucketDownloadBucketDiffHandler = bucketApi.DownloadBucketDiffHandlerFunc(func(params bucketApi.DownloadBucketDiffParams, session *models.Principal) middleware.Responder {
+		resp, err := getDownloadBucketDiffResponse(session, params)
+		if err != nil {
+			return bucketApi.NewDownloadBucketDiffDefault(err.Code).WithPayload(err.APIError)
+		}
+		return resp
+	})
+}
+
+func newObjectDiffOpts(sourceBucket, sourcePrefix, targetBucket, targetPrefix string) (*objectDiffOpts, error) {
+	if sourceBucket == "" || targetBucket == "" {
+		return nil, ErrBucketNameNotInRequest
+	}
+	if sourceBucket == targetBucket && sourcePrefix == targetPrefix {
+		return nil, ErrInvalidDiffLocations
+	}
+	return &objectDiffOpts{
+		SourceBucket: sourceBucket,
+		SourcePrefix: sourcePrefix,
+		TargetBucket: targetBucket,
+		TargetPrefix: targetPrefix,
+	}, nil
+}
+
+func formatDiffTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// compareObjects returns the reasons why the target object is not the same as the source object,
+// the modification time only matters when the target is older than the source
+func compareObjects(source, target minio.ObjectInfo) []string {
+	var reasons []string
+	if source.Size != target.Size {
+		reasons = append(reasons, objectDiffReasonSize)
+	}
+	if source.ETag != target.ETag {
+		reasons = append(reasons, objectDiffReasonETag)
+	}
+	if source.LastModified.After(target.LastModified) {
+		reasons = append(reasons, objectDiffReasonModified)
+	}
+	return reasons
+}
+
+// checkListAccess lists a single object of the location so permission errors are reported
+// before the results start streaming
+func checkListAccess(ctx context.Context, client MinioClient, bucket, prefix string) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	for obj := range client.listObjects(ctx, bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true, MaxKeys: 1}) {
+		if obj.Err != nil {
+			return obj.Err
+		}
+		break
+	}
+	return nil
+}
+
+// checkDiffLocations checks both locations can be listed
+func checkDiffLocations(ctx context.Context, client MinioClient, opts *objectDiffOpts) error {
+	if err := checkListAccess(ctx, client, opts.SourceBucket, opts.SourcePrefix); err != nil {
+		return err
+	}
+	return checkListAccess(ctx, client, opts.TargetBucket, opts.TargetPrefix)
+}
+
+// diffObjects compares the current version of the objects in both locations, the same way `mc diff`
+// does, both listings are lexically sorted so they are merged as they are received
+func diffObjects(ctx context.Context, client MinioClient, opts *objectDiffOpts, fn func(ObjectDiff) error) (*ObjectDiffSummary, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sourceCh := client.listObjects(ctx, opts.SourceBucket, minio.ListObjectsOptions{Prefix: opts.SourcePrefix, Recursive: true})
+	targetCh := client.listObjects(ctx, opts.TargetBucket, minio.ListObjectsOptions{Prefix: opts.TargetPrefix, Recursive: true})
+	next := func(ch <-chan minio.ObjectInfo, prefix string) (*minio.ObjectInfo, error) {
+		obj, ok := <-ch
+		if !ok {
+			return nil, ctx.Err()
+		}
+		if obj.Err != nil {
+			return nil, obj.Err
+		}
+		obj.Key = strings.TrimPrefix(obj.Key, prefix)
+		return &obj, nil
+	}
+
+	summary := &ObjectDiffSummary{}
+	source, err := next(sourceCh, opts.SourcePrefix)
+	if err != nil {
+		return nil, err
+	}
+	target, err := next(targetCh, opts.TargetPrefix)
+	if err != nil {
+		return nil, err
+	}
+	for source != nil || target != nil {
+		var diff *ObjectDiff
+		advanceSource, advanceTarget := false, false
+		switch {
+		case target == nil || (source != nil && source.Key < target.Key):
+			diff = &ObjectDiff{
+				Key:            source.Key,
+				Diff:           objectDiffOnlyInSource,
+				SourceSize:     source.Size,
+				SourceETag:     source.ETag,
+				SourceModified: formatDiffTime(source.LastModified),
+			}
+			summary.OnlyInSource++
+			advanceSource = true
+		case source == nil || target.Key < source.Key:
+			diff = &ObjectDiff{
+				Key:            target.Key,
+				Diff:           objectDiffOnlyInTarget,
+				TargetSize:     target.Size,
+				TargetETag:     target.ETag,
+				TargetModified: formatDiffTime(target.LastModified),
+			}
+			summary.OnlyInTarget++
+			advanceTarget = true
+		default:
+			if reasons := compareObjects(*source, *target); len(reasons) > 0 {
+				diff = &ObjectDiff{
+					Key:            source.Key,
+					Diff:           objectDiffDifferent,
+					Reasons:        reasons,
+					SourceSize:     source.Size,
+					TargetSize:     target.Size,
+					SourceETag:     source.ETag,
+					TargetETag:     target.ETag,
+					SourceModified: formatDiffTime(source.LastModified),
+					TargetModified: formatDiffTime(target.LastModified),
+				}
+				summary.Different++
+			} else {
+				summary.Identical++
+			}
+			advanceSource, advanceTarget = true, true
+		}
+		if diff != nil {
+			if err := fn(*diff); err != nil {
+				return nil, err
+			}
+		}
+		if advanceSource {
+			summary.SourceObjects++
+			if source, err = next(sourceCh, opts.SourcePrefix); err != nil {
+				return nil, err
+			}
+		}
+		if advanceTarget {
+			summary.TargetObjects++
+			if target, err = next(targetCh, opts.TargetPrefix); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return summary, nil
+}
+
+func objectDiffCSVRecord(diff ObjectDiff) []string {
+	record := []string{diff.Key, diff.Diff, strings.Join(diff.Reasons, ";"), "", "", diff.SourceETag, diff.TargetETag, diff.SourceModified, diff.TargetModified}
+	if diff.Diff != objectDiffOnlyInTarget {
+		record[3] = strconv.FormatInt(diff.SourceSize, 10)
+	}
+	if diff.Diff != objectDiffOnlyInSource {
+		record[4] = strconv.FormatInt(diff.TargetSize, 10)
+	}
+	return record
+}
+
+// writeObjectDiffCSV streams the differences as CSV, when the comparison fails midway a last
+// record with the error is written since the response status was already sent
+func writeObjectDiffCSV(ctx context.Context, client MinioClient, opts *objectDiffOpts, w *csv.Writer) error {
+	if err := w.Write(objectDiffCSVHeader); err != nil {
+		return err
+	}
+	_, err := diffObjects(ctx, client, opts, func(diff ObjectDiff) error {
+		return w.Write(objectDiffCSVRecord(diff))
+	})
+	if err != nil {
+		record := make([]string, len(objectDiffCSVHeader))
+		record[1], record[2] = "error", err.Error()
+		if werr := w.Write(record); werr != nil {
+			return werr
+		}
+	}
+	w.Flush()
+	if err != nil {
+		return err
+	}
+	return w.Error()
+}
+
+func getDownloadBucketDiffResponse(session *models.Principal, params bucketApi.DownloadBucketDiffParams) (middleware.Responder, *CodedAPIError) {
+	ctx := params.HTTPRequest.Context()
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	var prefix, targetPrefix string
+	if params.Prefix != nil {
+		prefix = *params.Prefix
+	}
+	if params.TargetPrefix != nil {
+		targetPrefix = *params.TargetPrefix
+	}
+	opts, err := newObjectDiffOpts(params.BucketName, prefix, params.TargetBucket, targetPrefix)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	if err := checkDiffLocations(ctx, minioClient, opts); err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+		fileName := url.PathEscape(fmt.Sprintf("%s-%s-diff.csv", opts.SourceBucket, opts.TargetBucket))
+		rw.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", fileName))
+		rw.Header().Set("Content-Type", "text/csv")
+		if err := writeObjectDiffCSV(ctx, minioClient, opts, csv.NewWriter(rw)); err != nil {
+			LogError("unable to write bucket differences: %v", err)
+		}
+	}), nil
+}
+
+// streamObjectsDiff handles `diff` requests of the objectManager websocket
+func streamObjectsDiff(ctx context.Context, client MinioClient, request ObjectsRequest, send func(WSResponse)) {
+	const diffsPerBatch = 1000
+	sendError := func(err error) {
+		send(WSResponse{
+			RequestID:  request.RequestID,
+			Error:      ErrorWithContext(ctx, err),
+			Prefix:     request.Prefix,
+			BucketName: request.BucketName,
+		})
+	}
+	opts, err := newObjectDiffOpts(request.BucketName, request.Prefix, request.TargetBucket, request.TargetPrefix)
+	if err != nil {
+		sendError(err)
+		return
+	}
+	var buffer []ObjectDiff
+	summary, err := diffObjects(ctx, client, opts, func(diff ObjectDiff) error {
+		buffer = append(buffer, diff)
+		if len(buffer) >= diffsPerBatch {
+			send(WSResponse{RequestID: request.RequestID, Diff: buffer})
+			buffer = nil
+		}
+		return nil
+	})
+	if len(buffer) > 0 {
+		send(WSResponse{RequestID: request.RequestID, Diff: buffer})
+	}
+	if err != nil {
+		sendError(err)
+		return
+	}
+	send(WSResponse{RequestID: request.RequestID, DiffSummary: summary})
+	send(WSResponse{RequestID: request.RequestID, RequestEnd: true})
+}
@@ -0,0 +1,137 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+func listBucketsObjectsFrom(objects map[string][]minio.ObjectInfo) func(ctx context.Context, bucket string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+	return func(ctx context.Context, bucket string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		return listObjectsFrom(objects[bucket])(ctx, bucket, opts)
+	}
+}
+
+func Test_diffObjects(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	t1 := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Hour)
+	minioListObjectsMock = listBucketsObjectsFrom(map[string][]minio.ObjectInfo{
+		"source": {
+			{Key: "data/a.txt", Size: 1, ETag: "a", LastModified: t1},
+			{Key: "data/b.txt", Size: 2, ETag: "b", LastModified: t1},
+			{Key: "data/c.txt", Size: 3, ETag: "c", LastModified: t2},
+			{Key: "data/e.txt", Size: 5, ETag: "e", LastModified: t1},
+		},
+		"target": {
+			{Key: "a.txt", Size: 1, ETag: "a", LastModified: t2},
+			{Key: "c.txt", Size: 4, ETag: "x", LastModified: t1},
+			{Key: "d.txt", Size: 4, ETag: "d", LastModified: t1},
+		},
+	})
+
+	opts, err := newObjectDiffOpts("source", "data/", "target", "")
+	assert.Nil(err)
+	var diffs []ObjectDiff
+	summary, err := diffObjects(ctx, minioClientMock{}, opts, func(diff ObjectDiff) error {
+		diffs = append(diffs, diff)
+		return nil
+	})
+	assert.Nil(err)
+	assert.Equal(&ObjectDiffSummary{SourceObjects: 4, TargetObjects: 3, OnlyInSource: 2, OnlyInTarget: 1, Different: 1, Identical: 1}, summary)
+	assert.Len(diffs, 4)
+	assert.Equal(ObjectDiff{Key: "b.txt", Diff: objectDiffOnlyInSource, SourceSize: 2, SourceETag: "b", SourceModified: "2023-06-01T00:00:00Z"}, diffs[0])
+	assert.Equal("c.txt", diffs[1].Key)
+	assert.Equal(objectDiffDifferent, diffs[1].Diff)
+	assert.Equal([]string{objectDiffReasonSize, objectDiffReasonETag, objectDiffReasonModified}, diffs[1].Reasons)
+	assert.Equal(ObjectDiff{Key: "d.txt", Diff: objectDiffOnlyInTarget, TargetSize: 4, TargetETag: "d", TargetModified: "2023-06-01T00:00:00Z"}, diffs[2])
+	assert.Equal("e.txt", diffs[3].Key)
+
+	// listing errors stop the comparison
+	minioListObjectsMock = listBucketsObjectsFrom(map[string][]minio.ObjectInfo{
+		"source": {{Key: "a.txt"}},
+		"target": {{Err: errors.New("access denied")}},
+	})
+	_, err = diffObjects(ctx, minioClientMock{}, opts, func(_ ObjectDiff) error { return nil })
+	assert.Equal("access denied", err.Error())
+
+	_, err = newObjectDiffOpts("source", "data/", "source", "data/")
+	assert.Equal(ErrInvalidDiffLocations, err)
+}
+
+func Test_writeObjectDiffCSV(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minioListObjectsMock = listBucketsObjectsFrom(map[string][]minio.ObjectInfo{
+		"source": {{Key: "a.txt", Size: 1, ETag: "a"}},
+		"target": {{Key: "b.txt", Size: 2, ETag: "b"}},
+	})
+	opts, err := newObjectDiffOpts("source", "", "target", "")
+	assert.Nil(err)
+
+	var buf bytes.Buffer
+	assert.Nil(writeObjectDiffCSV(ctx, minioClientMock{}, opts, csv.NewWriter(&buf)))
+	records, err := csv.NewReader(&buf).ReadAll()
+	assert.Nil(err)
+	assert.Equal([][]string{
+		objectDiffCSVHeader,
+		{"a.txt", objectDiffOnlyInSource, "", "1", "", "a", "", "", ""},
+		{"b.txt", objectDiffOnlyInTarget, "", "", "2", "", "b", "", ""},
+	}, records)
+
+	// errors are reported in the last record
+	minioListObjectsMock = listBucketsObjectsFrom(map[string][]minio.ObjectInfo{
+		"source": {{Err: errors.New("access denied")}},
+	})
+	buf.Reset()
+	assert.NotNil(writeObjectDiffCSV(ctx, minioClientMock{}, opts, csv.NewWriter(&buf)))
+	records, err = csv.NewReader(&buf).ReadAll()
+	assert.Nil(err)
+	assert.Equal("error", records[len(records)-1][1])
+	assert.Equal("access denied", records[len(records)-1][2])
+}
+
+func Test_streamObjectsDiff(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minioListObjectsMock = listBucketsObjectsFrom(map[string][]minio.ObjectInfo{
+		"source": {{Key: "a.txt", Size: 1, ETag: "a"}},
+	})
+	var frames []WSResponse
+	send := func(r WSResponse) {
+		frames = append(frames, r)
+	}
+	streamObjectsDiff(ctx, minioClientMock{}, ObjectsRequest{Mode: "diff", BucketName: "source", TargetBucket: "target", RequestID: 1}, send)
+	assert.Len(frames, 3)
+	assert.Len(frames[0].Diff, 1)
+	assert.Equal(int64(1), frames[1].DiffSummary.OnlyInSource)
+	assert.True(frames[2].RequestEnd)
+
+	frames = nil
+	streamObjectsDiff(ctx, minioClientMock{}, ObjectsRequest{Mode: "diff", BucketName: "source", RequestID: 2}, send)
+	assert.Len(frames, 1)
+	assert.Equal(400, frames[0].Error.Code)
+}
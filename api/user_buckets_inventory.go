@@ -0,0 +1,416 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/api/operations"
+	bucketApi "github.com/minio/console/api/operations/bucket"
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+	"github.com/parquet-go/parquet-go"
+)
+
+const (
+	inventoryFormatCSV     = "csv"
+	inventoryFormatJSONL   = "jsonl"
+	inventoryFormatParquet = "parquet"
+
+	// inventoryParquetBatch is the number of records handed to the parquet writer at once
+	inventoryParquetBatch = 1000
+)
+
+var (
+	inventoryContentTypes = map[string]string{
+		inventoryFormatCSV:     "text/csv",
+		inventoryFormatJSONL:   "application/x-ndjson",
+		inventoryFormatParquet: "application/vnd.apache.parquet",
+	}
+	inventoryCSVHeader = []string{
+		"key", "size", "etag", "last_modified", "version_id", "is_latest", "is_delete_marker", "storage_class",
+		"tags", "retention_mode", "retain_until_date", "legal_hold",
+	}
+)
+
+type inventoryOpts struct {
+	BucketName string
+	Prefix     string
+	Format     string
+	Versions   bool
+	Metadata   bool
+}
+
+// InventoryRecord is a single object version of the inventory, tags are encoded as a query
+// string the same way the x-amz-tagging header does
+type InventoryRecord struct {
+	Key             string     `json:"key" parquet:"key"`
+	Size            int64      `json:"size" parquet:"size"`
+	ETag            string     `json:"etag" parquet:"etag"`
+	LastModified    time.Time  `json:"last_modified" parquet:"last_modified,timestamp(millisecond)"`
+	VersionID       string     `json:"version_id,omitempty" parquet:"version_id"`
+	IsLatest        bool       `json:"is_latest" parquet:"is_latest"`
+	IsDeleteMarker  bool       `json:"is_delete_marker,omitempty" parquet:"is_delete_marker"`
+	StorageClass    string     `json:"storage_class,omitempty" parquet:"storage_class"`
+	Tags            string     `json:"tags,omitempty" parquet:"tags"`
+	RetentionMode   string     `json:"retention_mode,omitempty" parquet:"retention_mode"`
+	RetainUntilDate *time.Time `json:"retain_until_date,omitempty" parquet:"retain_until_date,optional"`
+	LegalHold       string     `json:"legal_hold,omitempty" parquet:"legal_hold"`
+}
+
+func registerBucketsInventoryHandlers(api *operations.ConsoleAPI) {
+	// download bucket inventory
+	api.BucketDownloadBucketInventoryHandler = bucketApi.DownloadBucketInventoryHandlerFunc(func(params bucketApi.DownloadBucketInventoryParams, session *models.Principal) middleware.Responder {
+		resp, err := getDownloadBucketInventoryResponse(session, params)
+		if err != nil {
+			return bucketApi.NewDownloadBucketInventoryDefault(err.Code).WithPayload(err.APIError)
+		}
+		return resp
+	})
+	// write bucket inventory into a bucket
+	api.BucketExportBucketInventoryHandler = bucketApi.ExportBucketInventoryHandlerFunc(func(params bucketApi.ExportBucketInventoryParams, session *models.Principal) middleware.Responder {
+		result, err := getExportBucketInventoryResponse(session, params)
+		if err != nil {
+			return bucketApi.NewExportBucketInventoryDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucketApi.NewExportBucketInventoryCreated().WithPayload(result)
+	})
+}
+
+func newInventoryOpts(bucketName, prefix, format string, versions, metadata bool) (*inventoryOpts, error) {
+	if bucketName == "" {
+		return nil, ErrBucketNameNotInRequest
+	}
+	if format == "" {
+		format = inventoryFormatCSV
+	}
+	if _, ok := inventoryContentTypes[format]; !ok {
+		return nil, fmt.Errorf("%w: unsupported format %s", ErrInvalidInventoryFormat, format)
+	}
+	return &inventoryOpts{
+		BucketName: bucketName,
+		Prefix:     prefix,
+		Format:     format,
+		Versions:   versions,
+		Metadata:   metadata,
+	}, nil
+}
+
+// inventoryWriter serializes inventory records, Close must be called to complete the output
+type inventoryWriter interface {
+	Write(record InventoryRecord) error
+	// Abort ends the output after a failure, the error is recorded when the format allows it
+	Abort(err error) error
+	Close() error
+}
+
+type csvInventoryWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvInventoryWriter) Write(record InventoryRecord) error {
+	var retainUntil string
+	if record.RetainUntilDate != nil {
+		retainUntil = record.RetainUntilDate.UTC().Format(time.RFC3339)
+	}
+	return c.w.Write([]string{
+		record.Key,
+		strconv.FormatInt(record.Size, 10),
+		record.ETag,
+		formatDiffTime(record.LastModified),
+		record.VersionID,
+		strconv.FormatBool(record.IsLatest),
+		strconv.FormatBool(record.IsDeleteMarker),
+		record.StorageClass,
+		record.Tags,
+		record.RetentionMode,
+		retainUntil,
+		record.LegalHold,
+	})
+}
+
+func (c *csvInventoryWriter) Abort(err error) error {
+	record := make([]string, len(inventoryCSVHeader))
+	record[0] = "error: " + err.Error()
+	if werr := c.w.Write(record); werr != nil {
+		return werr
+	}
+	return c.Close()
+}
+
+func (c *csvInventoryWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+type jsonInventoryWriter struct {
+	enc *json.Encoder
+}
+
+func (j *jsonInventoryWriter) Write(record InventoryRecord) error {
+	return j.enc.Encode(record)
+}
+
+func (j *jsonInventoryWriter) Abort(err error) error {
+	return j.enc.Encode(map[string]string{"error": err.Error()})
+}
+
+func (j *jsonInventoryWriter) Close() error {
+	return nil
+}
+
+type parquetInventoryWriter struct {
+	w      *parquet.GenericWriter[InventoryRecord]
+	buffer []InventoryRecord
+}
+
+func (p *parquetInventoryWriter) Write(record InventoryRecord) error {
+	p.buffer = append(p.buffer, record)
+	if len(p.buffer) < inventoryParquetBatch {
+		return nil
+	}
+	return p.flush()
+}
+
+func (p *parquetInventoryWriter) flush() error {
+	if len(p.buffer) == 0 {
+		return nil
+	}
+	_, err := p.w.Write(p.buffer)
+	p.buffer = p.buffer[:0]
+	return err
+}
+
+// Abort leaves the file without footer so readers reject the incomplete inventory
+func (p *parquetInventoryWriter) Abort(_ error) error {
+	return nil
+}
+
+func (p *parquetInventoryWriter) Close() error {
+	if err := p.flush(); err != nil {
+		return err
+	}
+	return p.w.Close()
+}
+
+func newInventoryWriter(format string, w io.Writer) (inventoryWriter, error) {
+	switch format {
+	case inventoryFormatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(inventoryCSVHeader); err != nil {
+			return nil, err
+		}
+		return &csvInventoryWriter{w: cw}, nil
+	case inventoryFormatJSONL:
+		return &jsonInventoryWriter{enc: json.NewEncoder(w)}, nil
+	case inventoryFormatParquet:
+		return &parquetInventoryWriter{w: parquet.NewGenericWriter[InventoryRecord](w)}, nil
+	}
+	return nil, fmt.Errorf("%w: unsupported format %s", ErrInvalidInventoryFormat, format)
+}
+
+// inventoryObjectMetadata adds the retention status of an object version, it is only looked
+// up when the bucket has object locking enabled
+func inventoryObjectMetadata(ctx context.Context, client MinioClient, bucketName string, record *InventoryRecord) error {
+	mode, retainUntil, err := client.getObjectRetention(ctx, bucketName, record.Key, record.VersionID)
+	if err != nil && minio.ToErrorResponse(err).Code != "NoSuchObjectLockConfiguration" {
+		return err
+	}
+	if mode != nil {
+		record.RetentionMode = string(*mode)
+		record.RetainUntilDate = retainUntil
+	}
+	legalHold, err := client.getObjectLegalHold(ctx, bucketName, record.Key, minio.GetObjectLegalHoldOptions{VersionID: record.VersionID})
+	if err != nil && minio.ToErrorResponse(err).Code != "NoSuchObjectLockConfiguration" {
+		return err
+	}
+	if legalHold != nil {
+		record.LegalHold = string(*legalHold)
+	}
+	return nil
+}
+
+// listInventory lists the bucket recursively and calls fn with every object, or every object
+// version when versions are requested
+func listInventory(ctx context.Context, client MinioClient, opts *inventoryOpts, fn func(InventoryRecord) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var locking bool
+	if opts.Metadata {
+		lock, _, _, _, err := client.getObjectLockConfig(ctx, opts.BucketName)
+		locking = err == nil && lock == "Enabled"
+	}
+	for obj := range client.listObjects(ctx, opts.BucketName, minio.ListObjectsOptions{
+		Prefix:       opts.Prefix,
+		Recursive:    true,
+		WithVersions: opts.Versions,
+		WithMetadata: opts.Metadata,
+	}) {
+		if obj.Err != nil {
+			return obj.Err
+		}
+		record := InventoryRecord{
+			Key:            obj.Key,
+			Size:           obj.Size,
+			ETag:           obj.ETag,
+			LastModified:   obj.LastModified,
+			VersionID:      obj.VersionID,
+			IsLatest:       obj.IsLatest || !opts.Versions,
+			IsDeleteMarker: obj.IsDeleteMarker,
+			StorageClass:   obj.StorageClass,
+		}
+		if len(obj.UserTags) > 0 {
+			tags := url.Values{}
+			for k, v := range obj.UserTags {
+				tags.Set(k, v)
+			}
+			record.Tags = tags.Encode()
+		}
+		if locking && !obj.IsDeleteMarker {
+			if err := inventoryObjectMetadata(ctx, client, opts.BucketName, &record); err != nil {
+				return err
+			}
+		}
+		if err := fn(record); err != nil {
+			return err
+		}
+	}
+	return ctx.Err()
+}
+
+// writeInventory writes the inventory in the requested format and returns the number of records
+func writeInventory(ctx context.Context, client MinioClient, opts *inventoryOpts, w io.Writer) (int64, error) {
+	iw, err := newInventoryWriter(opts.Format, w)
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	err = listInventory(ctx, client, opts, func(record InventoryRecord) error {
+		count++
+		return iw.Write(record)
+	})
+	if err != nil {
+		if aerr := iw.Abort(err); aerr != nil {
+			LogError("unable to record inventory error: %v", aerr)
+		}
+		return count, err
+	}
+	return count, iw.Close()
+}
+
+func inventoryFileName(opts *inventoryOpts, now time.Time) string {
+	return fmt.Sprintf("%s-inventory-%s.%s", opts.BucketName, now.UTC().Format("20060102T150405Z"), opts.Format)
+}
+
+func getDownloadBucketInventoryResponse(session *models.Principal, params bucketApi.DownloadBucketInventoryParams) (middleware.Responder, *CodedAPIError) {
+	ctx := params.HTTPRequest.Context()
+	var prefix, format string
+	if params.Prefix != nil {
+		prefix = *params.Prefix
+	}
+	if params.Format != nil {
+		format = *params.Format
+	}
+	opts, err := newInventoryOpts(params.BucketName, prefix, format, params.Versions != nil && *params.Versions, params.Metadata != nil && *params.Metadata)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	if err := checkListAccess(ctx, minioClient, opts.BucketName, opts.Prefix); err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+		fileName := url.PathEscape(inventoryFileName(opts, time.Now()))
+		rw.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", fileName))
+		rw.Header().Set("Content-Type", inventoryContentTypes[opts.Format])
+		if _, err := writeInventory(ctx, minioClient, opts, rw); err != nil {
+			LogError("unable to write bucket inventory: %v", err)
+		}
+	}), nil
+}
+
+// exportInventory streams the inventory into an object of the destination bucket as it is listed
+func exportInventory(ctx context.Context, client MinioClient, opts *inventoryOpts, destBucket, destPrefix string, now time.Time) (*models.InventoryExportResult, error) {
+	object := destPrefix + inventoryFileName(opts, now)
+	pr, pw := io.Pipe()
+	var count int64
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		var err error
+		count, err = writeInventory(ctx, client, opts, pw)
+		pw.CloseWithError(err)
+	}()
+	info, err := client.putObject(ctx, destBucket, object, pr, -1, minio.PutObjectOptions{ContentType: inventoryContentTypes[opts.Format]})
+	// unblock the listing when the upload fails
+	pr.CloseWithError(err)
+	<-done
+	if err != nil {
+		return nil, err
+	}
+	return &models.InventoryExportResult{
+		Bucket:  destBucket,
+		Object:  object,
+		Format:  opts.Format,
+		Objects: count,
+		Size:    info.Size,
+	}, nil
+}
+
+func getExportBucketInventoryResponse(session *models.Principal, params bucketApi.ExportBucketInventoryParams) (*models.InventoryExportResult, *CodedAPIError) {
+	ctx := params.HTTPRequest.Context()
+	body := params.Body
+	if body == nil || body.DestinationBucket == nil {
+		return nil, ErrorWithContext(ctx, ErrBucketBodyNotInRequest)
+	}
+	opts, err := newInventoryOpts(params.BucketName, body.Prefix, body.Format, body.Versions, body.Metadata)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	result, err := exportInventory(ctx, minioClient, opts, *body.DestinationBucket, body.DestinationPrefix, time.Now())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return result, nil
+}
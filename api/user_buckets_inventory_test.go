@@ -0,0 +1,161 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_listInventory(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	t1 := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	retainUntil := t1.Add(24 * time.Hour)
+	minioListObjectsMock = listObjectsFrom([]minio.ObjectInfo{
+		{Key: "a.txt", Size: 1, ETag: "a", LastModified: t1, VersionID: "v2", IsLatest: true, StorageClass: "STANDARD", UserTags: minio.URLMap{"team": "audit", "env": "prod"}},
+		{Key: "a.txt", Size: 2, ETag: "b", LastModified: t1, VersionID: "v1"},
+		{Key: "b.txt", VersionID: "v3", IsLatest: true, IsDeleteMarker: true},
+	})
+	client := minioClientMock{
+		getObjectLockConfigMock: func(_ context.Context, _ string) (string, *minio.RetentionMode, *uint, *minio.ValidityUnit, error) {
+			return "Enabled", nil, nil, nil, nil
+		},
+	}
+	var retentionLookups []string
+	minioGetObjectRetentionMock = func(_ context.Context, _, objectName, versionID string) (*minio.RetentionMode, *time.Time, error) {
+		retentionLookups = append(retentionLookups, objectName+"@"+versionID)
+		if versionID == "v1" {
+			return nil, nil, minio.ErrorResponse{Code: "NoSuchObjectLockConfiguration"}
+		}
+		mode := minio.Governance
+		return &mode, &retainUntil, nil
+	}
+	minioGetObjectLegalHoldMock = func(_ context.Context, _, _ string, _ minio.GetObjectLegalHoldOptions) (*minio.LegalHoldStatus, error) {
+		status := minio.LegalHoldDisabled
+		return &status, nil
+	}
+
+	opts, err := newInventoryOpts("bucket", "", "", true, true)
+	assert.Nil(err)
+	assert.Equal(inventoryFormatCSV, opts.Format)
+	var records []InventoryRecord
+	assert.Nil(listInventory(ctx, client, opts, func(record InventoryRecord) error {
+		records = append(records, record)
+		return nil
+	}))
+	assert.Len(records, 3)
+	assert.Equal(InventoryRecord{
+		Key: "a.txt", Size: 1, ETag: "a", LastModified: t1, VersionID: "v2", IsLatest: true, StorageClass: "STANDARD",
+		Tags: "env=prod&team=audit", RetentionMode: "GOVERNANCE", RetainUntilDate: &retainUntil, LegalHold: "OFF",
+	}, records[0])
+	assert.Equal("", records[1].RetentionMode)
+	assert.True(records[2].IsDeleteMarker)
+	// delete markers have no retention
+	assert.Equal([]string{"a.txt@v2", "a.txt@v1"}, retentionLookups)
+
+	_, err = newInventoryOpts("bucket", "", "xml", false, false)
+	assert.ErrorIs(err, ErrInvalidInventoryFormat)
+}
+
+func Test_writeInventory(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	t1 := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	objects := []minio.ObjectInfo{
+		{Key: "a.txt", Size: 1, ETag: "a", LastModified: t1},
+		{Key: "b.txt", Size: 2, ETag: "b", LastModified: t1},
+	}
+	minioListObjectsMock = listObjectsFrom(objects)
+
+	opts, _ := newInventoryOpts("bucket", "", inventoryFormatCSV, false, false)
+	var buf bytes.Buffer
+	count, err := writeInventory(ctx, minioClientMock{}, opts, &buf)
+	assert.Nil(err)
+	assert.Equal(int64(2), count)
+	records, err := csv.NewReader(&buf).ReadAll()
+	assert.Nil(err)
+	assert.Equal(inventoryCSVHeader, records[0])
+	assert.Equal([]string{"a.txt", "1", "a", "2023-06-01T00:00:00Z", "", "true", "false", "", "", "", "", ""}, records[1])
+
+	opts, _ = newInventoryOpts("bucket", "", inventoryFormatJSONL, false, false)
+	buf.Reset()
+	_, err = writeInventory(ctx, minioClientMock{}, opts, &buf)
+	assert.Nil(err)
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Len(lines, 2)
+	var record InventoryRecord
+	assert.Nil(json.Unmarshal([]byte(lines[1]), &record))
+	assert.Equal("b.txt", record.Key)
+
+	opts, _ = newInventoryOpts("bucket", "", inventoryFormatParquet, false, false)
+	buf.Reset()
+	_, err = writeInventory(ctx, minioClientMock{}, opts, &buf)
+	assert.Nil(err)
+	rows, err := parquet.Read[InventoryRecord](bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.Nil(err)
+	assert.Len(rows, 2)
+	assert.Equal("a.txt", rows[0].Key)
+	assert.True(rows[0].LastModified.Equal(t1))
+
+	// listing errors are recorded at the end of the output
+	minioListObjectsMock = listObjectsFrom([]minio.ObjectInfo{objects[0], {Err: errors.New("access denied")}})
+	opts, _ = newInventoryOpts("bucket", "", inventoryFormatCSV, false, false)
+	buf.Reset()
+	count, err = writeInventory(ctx, minioClientMock{}, opts, &buf)
+	assert.Equal("access denied", err.Error())
+	assert.Equal(int64(1), count)
+	records, err = csv.NewReader(&buf).ReadAll()
+	assert.Nil(err)
+	assert.Equal("error: access denied", records[len(records)-1][0])
+}
+
+func Test_exportInventory(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	minioListObjectsMock = listObjectsFrom([]minio.ObjectInfo{{Key: "a.txt", Size: 1}})
+	var uploaded bytes.Buffer
+	minioPutObjectMock = func(_ context.Context, bucketName, objectName string, reader io.Reader, _ int64, opts minio.PutObjectOptions) (minio.UploadInfo, error) {
+		n, err := io.Copy(&uploaded, reader)
+		return minio.UploadInfo{Bucket: bucketName, Key: objectName, Size: n}, err
+	}
+
+	opts, _ := newInventoryOpts("bucket", "", inventoryFormatJSONL, false, false)
+	result, err := exportInventory(ctx, minioClientMock{}, opts, "reports", "inventory/", now)
+	assert.Nil(err)
+	assert.Equal("reports", result.Bucket)
+	assert.Equal("inventory/bucket-inventory-20230601T120000Z.jsonl", result.Object)
+	assert.Equal(int64(1), result.Objects)
+	assert.Equal(int64(uploaded.Len()), result.Size)
+
+	// failed listings abort the upload
+	minioListObjectsMock = listObjectsFrom([]minio.ObjectInfo{{Err: errors.New("access denied")}})
+	_, err = exportInventory(ctx, minioClientMock{}, opts, "reports", "", now)
+	assert.Equal("access denied", err.Error())
+}
@@ -0,0 +1,600 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+	"github.com/minio/console/api/operations"
+	bucketApi "github.com/minio/console/api/operations/bucket"
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+)
+
+const (
+	lifecycleDateFormat = "2006-01-02"
+
+	lifecycleFormatXML  = "xml"
+	lifecycleFormatJSON = "json"
+)
+
+func registerBucketsLifecycleHandlers(api *operations.ConsoleAPI) {
+	// list lifecycle rules
+	api.BucketGetBucketLifecycleHandler = bucketApi.GetBucketLifecycleHandlerFunc(func(params bucketApi.GetBucketLifecycleParams, session *models.Principal) middleware.Responder {
+		listBucketLifecycleResponse, err := getBucketLifecycleResponse(session, params)
+		if err != nil {
+			return bucketApi.NewGetBucketLifecycleDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucketApi.NewGetBucketLifecycleOK().WithPayload(listBucketLifecycleResponse)
+	})
+	// add lifecycle rule
+	api.BucketAddBucketLifecycleHandler = bucketApi.AddBucketLifecycleHandlerFunc(func(params bucketApi.AddBucketLifecycleParams, session *models.Principal) middleware.Responder {
+		err := getAddBucketLifecycleResponse(session, params)
+		if err != nil {
+			return bucketApi.NewAddBucketLifecycleDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucketApi.NewAddBucketLifecycleCreated()
+	})
+	// update lifecycle rule
+	api.BucketUpdateBucketLifecycleHandler = bucketApi.UpdateBucketLifecycleHandlerFunc(func(params bucketApi.UpdateBucketLifecycleParams, session *models.Principal) middleware.Responder {
+		err := getEditBucketLifecycleRule(session, params)
+		if err != nil {
+			return bucketApi.NewUpdateBucketLifecycleDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucketApi.NewUpdateBucketLifecycleOK()
+	})
+	// delete lifecycle rule
+	api.BucketDeleteBucketLifecycleRuleHandler = bucketApi.DeleteBucketLifecycleRuleHandlerFunc(func(params bucketApi.DeleteBucketLifecycleRuleParams, session *models.Principal) middleware.Responder {
+		err := getDeleteBucketLifecycleRule(session, params)
+		if err != nil {
+			return bucketApi.NewDeleteBucketLifecycleRuleDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucketApi.NewDeleteBucketLifecycleRuleNoContent()
+	})
+	// export lifecycle configuration
+	api.BucketExportBucketLifecycleHandler = bucketApi.ExportBucketLifecycleHandlerFunc(func(params bucketApi.ExportBucketLifecycleParams, session *models.Principal) middleware.Responder {
+		resp, err := getExportBucketLifecycleResponse(session, params)
+		if err != nil {
+			return bucketApi.NewExportBucketLifecycleDefault(err.Code).WithPayload(err.APIError)
+		}
+		return resp
+	})
+	// import lifecycle configuration
+	api.BucketImportBucketLifecycleHandler = bucketApi.ImportBucketLifecycleHandlerFunc(func(params bucketApi.ImportBucketLifecycleParams, session *models.Principal) middleware.Responder {
+		err := getImportBucketLifecycleResponse(session, params)
+		if err != nil {
+			return bucketApi.NewImportBucketLifecycleDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucketApi.NewImportBucketLifecycleCreated()
+	})
+}
+
+// getBucketLifecycle fetches the lifecycle configuration of a bucket, an empty configuration
+// is returned if the bucket doesn't have one
+func getBucketLifecycle(ctx context.Context, client MinioClient, bucketName string) (*lifecycle.Configuration, error) {
+	lifecycleConfig, err := client.getLifecycleRules(ctx, bucketName)
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchLifecycleConfiguration" {
+			return lifecycle.NewConfiguration(), nil
+		}
+		return nil, err
+	}
+	if lifecycleConfig == nil {
+		return lifecycle.NewConfiguration(), nil
+	}
+	return lifecycleConfig, nil
+}
+
+// lifecycleRuleToModel converts a lifecycle.Rule to its console representation
+func lifecycleRuleToModel(rule lifecycle.Rule) *models.ObjectBucketLifecycle {
+	prefix := rule.Prefix
+	var ruleTags []lifecycle.Tag
+	switch {
+	case !rule.RuleFilter.And.IsEmpty():
+		prefix = rule.RuleFilter.And.Prefix
+		ruleTags = rule.RuleFilter.And.Tags
+	case rule.RuleFilter.Prefix != "":
+		prefix = rule.RuleFilter.Prefix
+	}
+	if !rule.RuleFilter.Tag.IsEmpty() {
+		ruleTags = append(ruleTags, rule.RuleFilter.Tag)
+	}
+	var tags []*models.LifecycleTag
+	for _, tag := range ruleTags {
+		tags = append(tags, &models.LifecycleTag{Key: tag.Key, Value: tag.Value})
+	}
+
+	expiration := &models.LifecycleExpiration{
+		Days:                              int64(rule.Expiration.Days),
+		DeleteMarker:                      rule.Expiration.IsDeleteMarkerExpirationEnabled(),
+		NoncurrentExpirationDays:          int64(rule.NoncurrentVersionExpiration.NoncurrentDays),
+		NewerNoncurrentExpirationVersions: int64(rule.NoncurrentVersionExpiration.NewerNoncurrentVersions),
+	}
+	if !rule.Expiration.IsDateNull() {
+		expiration.Date = rule.Expiration.Date.Format(lifecycleDateFormat)
+	}
+
+	transition := &models.LifecycleTransition{
+		Days:                     int64(rule.Transition.Days),
+		StorageClass:             rule.Transition.StorageClass,
+		NoncurrentTransitionDays: int64(rule.NoncurrentVersionTransition.NoncurrentDays),
+		NoncurrentStorageClass:   rule.NoncurrentVersionTransition.StorageClass,
+	}
+	if !rule.Transition.IsDateNull() {
+		transition.Date = rule.Transition.Date.Format(lifecycleDateFormat)
+	}
+
+	return &models.ObjectBucketLifecycle{
+		ID:                           rule.ID,
+		Prefix:                       prefix,
+		Status:                       rule.Status,
+		Tags:                         tags,
+		Expiration:                   expiration,
+		Transition:                   transition,
+		AbortIncompleteMultipartDays: int64(rule.AbortIncompleteMultipartUpload.DaysAfterInitiation),
+	}
+}
+
+// getBucketLifecycleResponse lists the lifecycle rules of a bucket
+func getBucketLifecycleResponse(session *models.Principal, params bucketApi.GetBucketLifecycleParams) (*models.BucketLifecycleResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	lifecycleConfig, err := getBucketLifecycle(ctx, minioClient, params.BucketName)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	rules := []*models.ObjectBucketLifecycle{}
+	for _, rule := range lifecycleConfig.Rules {
+		rules = append(rules, lifecycleRuleToModel(rule))
+	}
+	return &models.BucketLifecycleResponse{Lifecycle: rules}, nil
+}
+
+// parseLifecycleDate parses a YYYY-MM-DD date, which must fall after the current date
+func parseLifecycleDate(date string) (lifecycle.ExpirationDate, error) {
+	parsedDate, err := time.Parse(lifecycleDateFormat, date)
+	if err != nil {
+		return lifecycle.ExpirationDate{}, fmt.Errorf("invalid date %s, expected format is YYYY-MM-DD", date)
+	}
+	if !parsedDate.After(time.Now()) {
+		return lifecycle.ExpirationDate{}, fmt.Errorf("date %s falls before or on today's date", date)
+	}
+	return lifecycle.ExpirationDate{Time: parsedDate}, nil
+}
+
+// lifecycleRuleFromRequest builds a lifecycle.Rule with the provided id out of a lifecycle rule request
+func lifecycleRuleFromRequest(id string, req *models.LifecycleRuleRequest) (lifecycle.Rule, error) {
+	rule := lifecycle.Rule{
+		ID:     id,
+		Status: "Enabled",
+	}
+	if req.Disable {
+		rule.Status = "Disabled"
+	}
+
+	var tags []lifecycle.Tag
+	for _, tag := range req.Tags {
+		if tag == nil {
+			continue
+		}
+		if tag.Key == "" {
+			return lifecycle.Rule{}, errors.New("lifecycle tag filters require a key")
+		}
+		tags = append(tags, lifecycle.Tag{Key: tag.Key, Value: tag.Value})
+	}
+	// a filter with more than one predicate must be wrapped in an And element
+	switch {
+	case len(tags) > 1 || (len(tags) == 1 && req.Prefix != ""):
+		rule.RuleFilter.And = lifecycle.And{Prefix: req.Prefix, Tags: tags}
+	case len(tags) == 1:
+		rule.RuleFilter.Tag = tags[0]
+	default:
+		rule.RuleFilter.Prefix = req.Prefix
+	}
+
+	if req.ExpiryDate != "" {
+		date, err := parseLifecycleDate(req.ExpiryDate)
+		if err != nil {
+			return lifecycle.Rule{}, err
+		}
+		rule.Expiration.Date = date
+	}
+	rule.Expiration.Days = lifecycle.ExpirationDays(req.ExpiryDays)
+	rule.Expiration.DeleteMarker = lifecycle.ExpireDeleteMarker(req.ExpiredObjectDeleteMarker)
+	rule.NoncurrentVersionExpiration.NoncurrentDays = lifecycle.ExpirationDays(req.NoncurrentversionExpirationDays)
+	rule.NoncurrentVersionExpiration.NewerNoncurrentVersions = int(req.NewerNoncurrentversionExpirationVersions)
+	rule.AbortIncompleteMultipartUpload.DaysAfterInitiation = lifecycle.ExpirationDays(req.AbortIncompleteMultipartDays)
+
+	if req.TransitionDate != "" {
+		date, err := parseLifecycleDate(req.TransitionDate)
+		if err != nil {
+			return lifecycle.Rule{}, err
+		}
+		rule.Transition.Date = date
+	}
+	rule.Transition.Days = lifecycle.ExpirationDays(req.TransitionDays)
+	rule.Transition.StorageClass = req.StorageClass
+	rule.NoncurrentVersionTransition.NoncurrentDays = lifecycle.ExpirationDays(req.NoncurrentversionTransitionDays)
+	rule.NoncurrentVersionTransition.StorageClass = req.NoncurrentversionTransitionStorageClass
+
+	return rule, nil
+}
+
+// validateLifecycleRule checks the rule is well-formed before sending it to MinIO, this mirrors the
+// checks done by `mc ilm rule add` so errors are reported back to the user in a friendlier way.
+func validateLifecycleRule(rule lifecycle.Rule) error {
+	if rule.ID == "" {
+		return errors.New("lifecycle rule ID cannot be empty")
+	}
+	if len(rule.ID) > 255 {
+		return errors.New("lifecycle rule ID cannot be longer than 255 characters")
+	}
+	if rule.Status != "Enabled" && rule.Status != "Disabled" {
+		return fmt.Errorf("invalid status %s for lifecycle rule %s", rule.Status, rule.ID)
+	}
+	if rule.Expiration.Days < 0 || rule.Transition.Days < 0 ||
+		rule.NoncurrentVersionExpiration.NoncurrentDays < 0 || rule.NoncurrentVersionExpiration.NewerNoncurrentVersions < 0 ||
+		rule.NoncurrentVersionTransition.NoncurrentDays < 0 || rule.AbortIncompleteMultipartUpload.DaysAfterInitiation < 0 {
+		return fmt.Errorf("lifecycle rule %s cannot have negative values", rule.ID)
+	}
+	if rule.Expiration.IsNull() && rule.Transition.IsNull() &&
+		rule.NoncurrentVersionExpiration.IsDaysNull() && rule.NoncurrentVersionExpiration.NewerNoncurrentVersions == 0 &&
+		rule.NoncurrentVersionTransition.IsStorageClassEmpty() && rule.AbortIncompleteMultipartUpload.IsDaysNull() &&
+		rule.DelMarkerExpiration.IsNull() {
+		return fmt.Errorf("lifecycle rule %s must specify at least one action", rule.ID)
+	}
+	expirationParams := 0
+	if !rule.Expiration.IsDaysNull() {
+		expirationParams++
+	}
+	if !rule.Expiration.IsDateNull() {
+		expirationParams++
+	}
+	if rule.Expiration.IsDeleteMarkerExpirationEnabled() {
+		expirationParams++
+	}
+	if expirationParams > 1 {
+		return fmt.Errorf("lifecycle rule %s can only specify one of expiration days, expiration date or expired object delete marker", rule.ID)
+	}
+	if !rule.Transition.IsDaysNull() && !rule.Transition.IsDateNull() {
+		return fmt.Errorf("lifecycle rule %s can only specify one of transition days or transition date", rule.ID)
+	}
+	if (!rule.Transition.IsDaysNull() || !rule.Transition.IsDateNull()) && rule.Transition.IsNull() {
+		return fmt.Errorf("lifecycle rule %s requires a tier to transition objects to", rule.ID)
+	}
+	if !rule.Transition.IsDateNull() && !rule.Expiration.IsDateNull() && rule.Expiration.Date.Before(rule.Transition.Date.Time) {
+		return fmt.Errorf("lifecycle rule %s must transition objects before expiring them", rule.ID)
+	}
+	if !rule.NoncurrentVersionTransition.IsDaysNull() && rule.NoncurrentVersionTransition.IsStorageClassEmpty() {
+		return fmt.Errorf("lifecycle rule %s requires a tier to transition noncurrent versions to", rule.ID)
+	}
+	if (!rule.RuleFilter.And.IsEmpty() || rule.RuleFilter.Tag.Key != "") && rule.Expiration.IsDeleteMarkerExpirationEnabled() {
+		return fmt.Errorf("lifecycle rule %s cannot expire delete markers when filtering by tags", rule.ID)
+	}
+	return nil
+}
+
+// validateLifecycleConfig validates every rule of the configuration and that the tiers used by
+// transitions are configured in MinIO
+func validateLifecycleConfig(ctx context.Context, adminClient MinioAdmin, lifecycleConfig *lifecycle.Configuration) error {
+	ids := map[string]bool{}
+	usedTiers := map[string]bool{}
+	for _, rule := range lifecycleConfig.Rules {
+		if err := validateLifecycleRule(rule); err != nil {
+			return err
+		}
+		if ids[rule.ID] {
+			return fmt.Errorf("lifecycle rule ID %s is duplicated", rule.ID)
+		}
+		ids[rule.ID] = true
+		if rule.Transition.StorageClass != "" {
+			usedTiers[rule.Transition.StorageClass] = true
+		}
+		if rule.NoncurrentVersionTransition.StorageClass != "" {
+			usedTiers[rule.NoncurrentVersionTransition.StorageClass] = true
+		}
+	}
+	if len(usedTiers) == 0 {
+		return nil
+	}
+	tiers, err := adminClient.listTiers(ctx)
+	if err != nil {
+		return err
+	}
+	configuredTiers := map[string]bool{}
+	for _, tier := range tiers {
+		configuredTiers[tier.Name] = true
+	}
+	for tier := range usedTiers {
+		if !configuredTiers[tier] {
+			return fmt.Errorf("tier %s is not configured", tier)
+		}
+	}
+	return nil
+}
+
+// setValidatedBucketLifecycle validates the lifecycle configuration and applies it to the bucket
+func setValidatedBucketLifecycle(ctx context.Context, client MinioClient, adminClient MinioAdmin, bucketName string, lifecycleConfig *lifecycle.Configuration) error {
+	if err := validateLifecycleConfig(ctx, adminClient, lifecycleConfig); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidLifecycleConfig, err)
+	}
+	return client.setBucketLifecycle(ctx, bucketName, lifecycleConfig)
+}
+
+// addBucketLifecycle adds a new rule to the bucket's lifecycle configuration
+func addBucketLifecycle(ctx context.Context, client MinioClient, adminClient MinioAdmin, bucketName string, req *models.LifecycleRuleRequest) error {
+	id := req.ID
+	if id == "" {
+		id = uuid.NewString()
+	}
+	rule, err := lifecycleRuleFromRequest(id, req)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidLifecycleConfig, err)
+	}
+	lifecycleConfig, err := getBucketLifecycle(ctx, client, bucketName)
+	if err != nil {
+		return err
+	}
+	lifecycleConfig.Rules = append(lifecycleConfig.Rules, rule)
+	return setValidatedBucketLifecycle(ctx, client, adminClient, bucketName, lifecycleConfig)
+}
+
+// editBucketLifecycle replaces the rule with the provided id in the bucket's lifecycle configuration
+func editBucketLifecycle(ctx context.Context, client MinioClient, adminClient MinioAdmin, bucketName, ruleID string, req *models.LifecycleRuleRequest) error {
+	rule, err := lifecycleRuleFromRequest(ruleID, req)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidLifecycleConfig, err)
+	}
+	lifecycleConfig, err := getBucketLifecycle(ctx, client, bucketName)
+	if err != nil {
+		return err
+	}
+	if lifecycleConfig.Empty() {
+		return ErrBucketLifeCycleNotConfigured
+	}
+	found := false
+	for i := range lifecycleConfig.Rules {
+		if lifecycleConfig.Rules[i].ID == ruleID {
+			lifecycleConfig.Rules[i] = rule
+			found = true
+			break
+		}
+	}
+	if !found {
+		return ErrNotFound
+	}
+	return setValidatedBucketLifecycle(ctx, client, adminClient, bucketName, lifecycleConfig)
+}
+
+// deleteBucketLifecycle removes the rule with the provided id from the bucket's lifecycle configuration,
+// once the last rule is removed the lifecycle configuration is removed from the bucket
+func deleteBucketLifecycle(ctx context.Context, client MinioClient, bucketName, ruleID string) error {
+	lifecycleConfig, err := getBucketLifecycle(ctx, client, bucketName)
+	if err != nil {
+		return err
+	}
+	if lifecycleConfig.Empty() {
+		return ErrBucketLifeCycleNotConfigured
+	}
+	rules := []lifecycle.Rule{}
+	for _, rule := range lifecycleConfig.Rules {
+		if rule.ID != ruleID {
+			rules = append(rules, rule)
+		}
+	}
+	if len(rules) == len(lifecycleConfig.Rules) {
+		return ErrNotFound
+	}
+	lifecycleConfig.Rules = rules
+	return client.setBucketLifecycle(ctx, bucketName, lifecycleConfig)
+}
+
+func getAddBucketLifecycleResponse(session *models.Principal, params bucketApi.AddBucketLifecycleParams) *CodedAPIError {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	adminClient := AdminClient{Client: mAdmin}
+
+	if err := addBucketLifecycle(ctx, minioClient, adminClient, params.BucketName, params.Body); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
+
+func getEditBucketLifecycleRule(session *models.Principal, params bucketApi.UpdateBucketLifecycleParams) *CodedAPIError {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	adminClient := AdminClient{Client: mAdmin}
+
+	if err := editBucketLifecycle(ctx, minioClient, adminClient, params.BucketName, params.LifecycleID, params.Body); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
+
+func getDeleteBucketLifecycleRule(session *models.Principal, params bucketApi.DeleteBucketLifecycleRuleParams) *CodedAPIError {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	if err := deleteBucketLifecycle(ctx, minioClient, params.BucketName, params.LifecycleID); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
+
+// marshalLifecycleConfig encodes a lifecycle configuration in the requested format
+func marshalLifecycleConfig(lifecycleConfig *lifecycle.Configuration, format string) ([]byte, error) {
+	switch format {
+	case lifecycleFormatXML:
+		data, err := xml.MarshalIndent(lifecycleConfig, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte(xml.Header), data...), nil
+	case lifecycleFormatJSON:
+		return json.MarshalIndent(lifecycleConfig, "", "  ")
+	}
+	return nil, fmt.Errorf("unsupported lifecycle format %s", format)
+}
+
+// unmarshalLifecycleConfig decodes a lifecycle configuration in the provided format
+func unmarshalLifecycleConfig(data []byte, format string) (*lifecycle.Configuration, error) {
+	lifecycleConfig := lifecycle.NewConfiguration()
+	var err error
+	switch format {
+	case "", lifecycleFormatXML:
+		err = xml.Unmarshal(data, lifecycleConfig)
+	case lifecycleFormatJSON:
+		err = json.Unmarshal(data, lifecycleConfig)
+	default:
+		return nil, fmt.Errorf("unsupported lifecycle format %s", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return lifecycleConfig, nil
+}
+
+func getExportBucketLifecycleResponse(session *models.Principal, params bucketApi.ExportBucketLifecycleParams) (middleware.Responder, *CodedAPIError) {
+	ctx := params.HTTPRequest.Context()
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	format := lifecycleFormatXML
+	if params.Format != nil {
+		format = *params.Format
+	}
+	lifecycleConfig, err := getBucketLifecycle(ctx, minioClient, params.BucketName)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	if lifecycleConfig.Empty() {
+		return nil, ErrorWithContext(ctx, ErrBucketLifeCycleNotConfigured)
+	}
+	data, err := marshalLifecycleConfig(lifecycleConfig, format)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, err)
+	}
+
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+		fileName := url.PathEscape(fmt.Sprintf("%s-lifecycle.%s", params.BucketName, format))
+		rw.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", fileName))
+		if format == lifecycleFormatJSON {
+			rw.Header().Set("Content-Type", "application/json")
+		} else {
+			rw.Header().Set("Content-Type", "application/xml")
+		}
+		if _, err := rw.Write(data); err != nil {
+			LogError("unable to write lifecycle configuration: %v", err)
+		}
+	}), nil
+}
+
+// importBucketLifecycle replaces the lifecycle configuration of a bucket with the provided document.
+// Rules without an ID get a generated one, the same way MinIO would do.
+func importBucketLifecycle(ctx context.Context, client MinioClient, adminClient MinioAdmin, bucketName string, req *models.LifecycleImportRequest) error {
+	lifecycleConfig, err := unmarshalLifecycleConfig([]byte(*req.Configuration), req.Format)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidLifecycleConfig, err)
+	}
+	if lifecycleConfig.Empty() {
+		return fmt.Errorf("%w: at least one rule is required", ErrInvalidLifecycleConfig)
+	}
+	for i := range lifecycleConfig.Rules {
+		if lifecycleConfig.Rules[i].ID == "" {
+			lifecycleConfig.Rules[i].ID = uuid.NewString()
+		}
+	}
+	return setValidatedBucketLifecycle(ctx, client, adminClient, bucketName, lifecycleConfig)
+}
+
+func getImportBucketLifecycleResponse(session *models.Principal, params bucketApi.ImportBucketLifecycleParams) *CodedAPIError {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	adminClient := AdminClient{Client: mAdmin}
+
+	if err := importBucketLifecycle(ctx, minioClient, adminClient, params.BucketName, params.Body); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
@@ -0,0 +1,335 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_getBucketLifecycle(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+
+	// buckets without lifecycle configuration return an empty configuration
+	minClient.getLifecycleRulesMock = func(_ context.Context, _ string) (*lifecycle.Configuration, error) {
+		return nil, minio.ErrorResponse{Code: "NoSuchLifecycleConfiguration"}
+	}
+	lifecycleConfig, err := getBucketLifecycle(ctx, minClient, "bucket")
+	assert.Nil(err)
+	assert.True(lifecycleConfig.Empty())
+
+	minClient.getLifecycleRulesMock = func(_ context.Context, _ string) (*lifecycle.Configuration, error) {
+		return nil, errors.New("error")
+	}
+	_, err = getBucketLifecycle(ctx, minClient, "bucket")
+	assert.Equal("error", err.Error())
+}
+
+func Test_lifecycleRuleToModel(t *testing.T) {
+	assert := assert.New(t)
+	rule := lifecycle.Rule{
+		ID:     "rule",
+		Status: "Enabled",
+		RuleFilter: lifecycle.Filter{And: lifecycle.And{
+			Prefix: "logs/",
+			Tags:   []lifecycle.Tag{{Key: "app", Value: "console"}},
+		}},
+		Expiration:                     lifecycle.Expiration{Days: 30},
+		NoncurrentVersionExpiration:    lifecycle.NoncurrentVersionExpiration{NoncurrentDays: 7, NewerNoncurrentVersions: 2},
+		Transition:                     lifecycle.Transition{Days: 10, StorageClass: "WARM"},
+		AbortIncompleteMultipartUpload: lifecycle.AbortIncompleteMultipartUpload{DaysAfterInitiation: 3},
+	}
+	result := lifecycleRuleToModel(rule)
+	assert.Equal("rule", result.ID)
+	assert.Equal("logs/", result.Prefix)
+	assert.Equal([]*models.LifecycleTag{{Key: "app", Value: "console"}}, result.Tags)
+	assert.Equal(int64(30), result.Expiration.Days)
+	assert.Equal(int64(7), result.Expiration.NoncurrentExpirationDays)
+	assert.Equal(int64(2), result.Expiration.NewerNoncurrentExpirationVersions)
+	assert.Equal(int64(10), result.Transition.Days)
+	assert.Equal("WARM", result.Transition.StorageClass)
+	assert.Equal(int64(3), result.AbortIncompleteMultipartDays)
+}
+
+func Test_lifecycleRuleFromRequest(t *testing.T) {
+	assert := assert.New(t)
+
+	// prefix only filter
+	rule, err := lifecycleRuleFromRequest("id", &models.LifecycleRuleRequest{Prefix: "logs/", ExpiryDays: 10})
+	assert.Nil(err)
+	assert.Equal("logs/", rule.RuleFilter.Prefix)
+	assert.Equal("Enabled", rule.Status)
+
+	// prefix and tags are combined with And
+	rule, err = lifecycleRuleFromRequest("id", &models.LifecycleRuleRequest{
+		Prefix:     "logs/",
+		Tags:       []*models.LifecycleTag{{Key: "a", Value: "b"}},
+		ExpiryDays: 10,
+		Disable:    true,
+	})
+	assert.Nil(err)
+	assert.Equal("logs/", rule.RuleFilter.And.Prefix)
+	assert.Equal([]lifecycle.Tag{{Key: "a", Value: "b"}}, rule.RuleFilter.And.Tags)
+	assert.Equal("Disabled", rule.Status)
+
+	// a single tag doesn't need an And
+	rule, err = lifecycleRuleFromRequest("id", &models.LifecycleRuleRequest{
+		Tags:       []*models.LifecycleTag{{Key: "a", Value: "b"}},
+		ExpiryDays: 10,
+	})
+	assert.Nil(err)
+	assert.Equal(lifecycle.Tag{Key: "a", Value: "b"}, rule.RuleFilter.Tag)
+
+	_, err = lifecycleRuleFromRequest("id", &models.LifecycleRuleRequest{Tags: []*models.LifecycleTag{{Value: "b"}}})
+	assert.NotNil(err)
+
+	_, err = lifecycleRuleFromRequest("id", &models.LifecycleRuleRequest{ExpiryDate: "01/01/2030"})
+	assert.NotNil(err)
+
+	_, err = lifecycleRuleFromRequest("id", &models.LifecycleRuleRequest{ExpiryDate: "2000-01-01"})
+	assert.NotNil(err)
+
+	future := time.Now().AddDate(1, 0, 0).Format(lifecycleDateFormat)
+	rule, err = lifecycleRuleFromRequest("id", &models.LifecycleRuleRequest{TransitionDate: future, StorageClass: "WARM"})
+	assert.Nil(err)
+	assert.Equal(future, rule.Transition.Date.Format(lifecycleDateFormat))
+	assert.Equal("WARM", rule.Transition.StorageClass)
+}
+
+func Test_validateLifecycleRule(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *models.LifecycleRuleRequest
+		wantErr bool
+	}{
+		{
+			name: "expiration",
+			req:  &models.LifecycleRuleRequest{ExpiryDays: 10},
+		},
+		{
+			name: "delete marker cleanup",
+			req:  &models.LifecycleRuleRequest{ExpiredObjectDeleteMarker: true},
+		},
+		{
+			name: "abort incomplete multipart uploads",
+			req:  &models.LifecycleRuleRequest{AbortIncompleteMultipartDays: 2},
+		},
+		{
+			name: "noncurrent versions",
+			req:  &models.LifecycleRuleRequest{NoncurrentversionExpirationDays: 2, NewerNoncurrentversionExpirationVersions: 1},
+		},
+		{
+			name: "transition",
+			req:  &models.LifecycleRuleRequest{TransitionDays: 10, StorageClass: "WARM"},
+		},
+		{
+			name:    "no action",
+			req:     &models.LifecycleRuleRequest{Prefix: "logs/"},
+			wantErr: true,
+		},
+		{
+			name:    "more than one expiration parameter",
+			req:     &models.LifecycleRuleRequest{ExpiryDays: 10, ExpiredObjectDeleteMarker: true},
+			wantErr: true,
+		},
+		{
+			name:    "transition without tier",
+			req:     &models.LifecycleRuleRequest{TransitionDays: 10},
+			wantErr: true,
+		},
+		{
+			name:    "noncurrent transition without tier",
+			req:     &models.LifecycleRuleRequest{NoncurrentversionTransitionDays: 10},
+			wantErr: true,
+		},
+		{
+			name:    "negative values",
+			req:     &models.LifecycleRuleRequest{ExpiryDays: -1},
+			wantErr: true,
+		},
+		{
+			name:    "delete markers with tags",
+			req:     &models.LifecycleRuleRequest{ExpiredObjectDeleteMarker: true, Tags: []*models.LifecycleTag{{Key: "a"}}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := lifecycleRuleFromRequest("id", tt.req)
+			assert.Nil(t, err)
+			err = validateLifecycleRule(rule)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateLifecycleRule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_addBucketLifecycle(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	adminClient := AdminClientMock{}
+
+	var setConfig *lifecycle.Configuration
+	minClient.getLifecycleRulesMock = func(_ context.Context, _ string) (*lifecycle.Configuration, error) {
+		return &lifecycle.Configuration{Rules: []lifecycle.Rule{{ID: "existing", Status: "Enabled", Expiration: lifecycle.Expiration{Days: 1}}}}, nil
+	}
+	minClient.setBucketLifecycleMock = func(_ context.Context, _ string, config *lifecycle.Configuration) error {
+		setConfig = config
+		return nil
+	}
+	adminClient.minioListTiersMock = func(_ context.Context) ([]*madmin.TierConfig, error) {
+		return []*madmin.TierConfig{{Name: "WARM"}}, nil
+	}
+
+	err := addBucketLifecycle(ctx, minClient, adminClient, "bucket", &models.LifecycleRuleRequest{TransitionDays: 10, StorageClass: "WARM"})
+	assert.Nil(err)
+	assert.Len(setConfig.Rules, 2)
+	assert.NotEmpty(setConfig.Rules[1].ID)
+
+	// tiers must be configured
+	err = addBucketLifecycle(ctx, minClient, adminClient, "bucket", &models.LifecycleRuleRequest{TransitionDays: 10, StorageClass: "COLD"})
+	assert.True(errors.Is(err, ErrInvalidLifecycleConfig))
+
+	// IDs must be unique
+	err = addBucketLifecycle(ctx, minClient, adminClient, "bucket", &models.LifecycleRuleRequest{ID: "existing", ExpiryDays: 1})
+	assert.True(errors.Is(err, ErrInvalidLifecycleConfig))
+
+	// invalid rules are not written
+	setConfig = nil
+	err = addBucketLifecycle(ctx, minClient, adminClient, "bucket", &models.LifecycleRuleRequest{Prefix: "logs/"})
+	assert.True(errors.Is(err, ErrInvalidLifecycleConfig))
+	assert.Nil(setConfig)
+}
+
+func Test_editBucketLifecycle(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	adminClient := AdminClientMock{}
+
+	var setConfig *lifecycle.Configuration
+	minClient.getLifecycleRulesMock = func(_ context.Context, _ string) (*lifecycle.Configuration, error) {
+		return &lifecycle.Configuration{Rules: []lifecycle.Rule{{ID: "existing", Status: "Enabled", Expiration: lifecycle.Expiration{Days: 1}}}}, nil
+	}
+	minClient.setBucketLifecycleMock = func(_ context.Context, _ string, config *lifecycle.Configuration) error {
+		setConfig = config
+		return nil
+	}
+
+	err := editBucketLifecycle(ctx, minClient, adminClient, "bucket", "existing", &models.LifecycleRuleRequest{ExpiryDays: 5, Prefix: "logs/"})
+	assert.Nil(err)
+	assert.Len(setConfig.Rules, 1)
+	assert.Equal(lifecycle.ExpirationDays(5), setConfig.Rules[0].Expiration.Days)
+	assert.Equal("logs/", setConfig.Rules[0].RuleFilter.Prefix)
+
+	err = editBucketLifecycle(ctx, minClient, adminClient, "bucket", "other", &models.LifecycleRuleRequest{ExpiryDays: 5})
+	assert.Equal(ErrNotFound, err)
+
+	minClient.getLifecycleRulesMock = func(_ context.Context, _ string) (*lifecycle.Configuration, error) {
+		return nil, minio.ErrorResponse{Code: "NoSuchLifecycleConfiguration"}
+	}
+	err = editBucketLifecycle(ctx, minClient, adminClient, "bucket", "existing", &models.LifecycleRuleRequest{ExpiryDays: 5})
+	assert.Equal(ErrBucketLifeCycleNotConfigured, err)
+}
+
+func Test_deleteBucketLifecycle(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+
+	var setConfig *lifecycle.Configuration
+	minClient.getLifecycleRulesMock = func(_ context.Context, _ string) (*lifecycle.Configuration, error) {
+		return &lifecycle.Configuration{Rules: []lifecycle.Rule{{ID: "a"}, {ID: "b"}}}, nil
+	}
+	minClient.setBucketLifecycleMock = func(_ context.Context, _ string, config *lifecycle.Configuration) error {
+		setConfig = config
+		return nil
+	}
+
+	err := deleteBucketLifecycle(ctx, minClient, "bucket", "a")
+	assert.Nil(err)
+	assert.Equal([]lifecycle.Rule{{ID: "b"}}, setConfig.Rules)
+
+	err = deleteBucketLifecycle(ctx, minClient, "bucket", "c")
+	assert.Equal(ErrNotFound, err)
+}
+
+func Test_importExportBucketLifecycle(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	adminClient := AdminClientMock{}
+
+	original := &lifecycle.Configuration{Rules: []lifecycle.Rule{
+		{
+			ID:                          "expire",
+			Status:                      "Enabled",
+			RuleFilter:                  lifecycle.Filter{Prefix: "logs/"},
+			Expiration:                  lifecycle.Expiration{Days: 30},
+			NoncurrentVersionExpiration: lifecycle.NoncurrentVersionExpiration{NoncurrentDays: 5},
+		},
+	}}
+
+	for _, format := range []string{lifecycleFormatXML, lifecycleFormatJSON} {
+		data, err := marshalLifecycleConfig(original, format)
+		assert.Nil(err)
+
+		var setConfig *lifecycle.Configuration
+		minClient.setBucketLifecycleMock = func(_ context.Context, _ string, config *lifecycle.Configuration) error {
+			setConfig = config
+			return nil
+		}
+		err = importBucketLifecycle(ctx, minClient, adminClient, "bucket", &models.LifecycleImportRequest{
+			Format:        format,
+			Configuration: swag.String(string(data)),
+		})
+		assert.Nil(err, format)
+		assert.Len(setConfig.Rules, 1, format)
+		assert.Equal("expire", setConfig.Rules[0].ID, format)
+		assert.Equal("logs/", setConfig.Rules[0].RuleFilter.Prefix, format)
+		assert.Equal(lifecycle.ExpirationDays(30), setConfig.Rules[0].Expiration.Days, format)
+		assert.Equal(lifecycle.ExpirationDays(5), setConfig.Rules[0].NoncurrentVersionExpiration.NoncurrentDays, format)
+	}
+
+	_, err := marshalLifecycleConfig(original, "yaml")
+	assert.NotNil(err)
+
+	err = importBucketLifecycle(ctx, minClient, adminClient, "bucket", &models.LifecycleImportRequest{
+		Format:        lifecycleFormatXML,
+		Configuration: swag.String("not xml"),
+	})
+	assert.True(errors.Is(err, ErrInvalidLifecycleConfig))
+
+	err = importBucketLifecycle(ctx, minClient, adminClient, "bucket", &models.LifecycleImportRequest{
+		Format:        lifecycleFormatXML,
+		Configuration: swag.String("<LifecycleConfiguration></LifecycleConfiguration>"),
+	})
+	assert.True(errors.Is(err, ErrInvalidLifecycleConfig))
+}
@@ -0,0 +1,237 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	bucketApi "github.com/minio/console/api/operations/bucket"
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v3"
+)
+
+const (
+	bucketSortName    = "name"
+	bucketSortSize    = "size"
+	bucketSortObjects = "objects"
+	bucketSortCreated = "created"
+)
+
+type bucketTagFilter struct {
+	key      string
+	value    string
+	hasValue bool
+}
+
+type listBucketsOpts struct {
+	Prefix     string
+	Search     string
+	Tags       []bucketTagFilter
+	SortBy     string
+	Descending bool
+	Offset     int
+	Limit      int
+}
+
+func getListBucketsOpts(params bucketApi.ListBucketsParams) (*listBucketsOpts, error) {
+	opts := &listBucketsOpts{SortBy: bucketSortName}
+	if params.Prefix != nil {
+		opts.Prefix = *params.Prefix
+	}
+	if params.Search != nil {
+		opts.Search = strings.ToLower(*params.Search)
+	}
+	if params.Tags != nil {
+		for _, tag := range strings.Split(*params.Tags, ",") {
+			key, value, hasValue := strings.Cut(strings.TrimSpace(tag), "=")
+			if key == "" {
+				continue
+			}
+			opts.Tags = append(opts.Tags, bucketTagFilter{key: key, value: value, hasValue: hasValue})
+		}
+	}
+	if params.SortBy != nil && *params.SortBy != "" {
+		opts.SortBy = *params.SortBy
+		if !slices.Contains([]string{bucketSortName, bucketSortSize, bucketSortObjects, bucketSortCreated}, opts.SortBy) {
+			return nil, fmt.Errorf("invalid sort_by %s", opts.SortBy)
+		}
+	}
+	if params.SortOrder != nil {
+		switch *params.SortOrder {
+		case "", "asc":
+		case "desc":
+			opts.Descending = true
+		default:
+			return nil, fmt.Errorf("invalid sort_order %s", *params.SortOrder)
+		}
+	}
+	if params.Offset != nil {
+		opts.Offset = int(*params.Offset)
+	}
+	if params.Limit != nil {
+		opts.Limit = int(*params.Limit)
+	}
+	if opts.Offset < 0 || opts.Limit < 0 {
+		return nil, fmt.Errorf("offset and limit can't be negative")
+	}
+	return opts, nil
+}
+
+func (opts *listBucketsOpts) match(bucket *models.Bucket) bool {
+	name := *bucket.Name
+	if !strings.HasPrefix(name, opts.Prefix) {
+		return false
+	}
+	if opts.Search != "" && !strings.Contains(strings.ToLower(name), opts.Search) {
+		return false
+	}
+	for _, tag := range opts.Tags {
+		if bucket.Details == nil {
+			return false
+		}
+		value, ok := bucket.Details.Tags[tag.key]
+		if !ok || (tag.hasValue && value != tag.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// filterBuckets returns one page of the buckets matching the filters along with the number of matches
+func filterBuckets(buckets []*models.Bucket, opts *listBucketsOpts) ([]*models.Bucket, int) {
+	matches := []*models.Bucket{}
+	for _, bucket := range buckets {
+		if opts.match(bucket) {
+			matches = append(matches, bucket)
+		}
+	}
+	compare := func(a, b *models.Bucket) int {
+		var c int
+		switch opts.SortBy {
+		case bucketSortSize:
+			c = cmp.Compare(a.Size, b.Size)
+		case bucketSortObjects:
+			c = cmp.Compare(a.Objects, b.Objects)
+		case bucketSortCreated:
+			createdA, _ := time.Parse(time.RFC3339, a.CreationDate)
+			createdB, _ := time.Parse(time.RFC3339, b.CreationDate)
+			c = createdA.Compare(createdB)
+		}
+		if c == 0 {
+			c = strings.Compare(*a.Name, *b.Name)
+		}
+		if opts.Descending {
+			return -c
+		}
+		return c
+	}
+	slices.SortStableFunc(matches, compare)
+
+	total := len(matches)
+	if opts.Offset >= total {
+		return []*models.Bucket{}, total
+	}
+	matches = matches[opts.Offset:]
+	if opts.Limit > 0 && opts.Limit < len(matches) {
+		matches = matches[:opts.Limit]
+	}
+	return matches, total
+}
+
+type accountInfoCacheEntry struct {
+	info    madmin.AccountInfo
+	expires time.Time
+}
+
+// accountInfoCache keeps the account info of every session for a short time, the bucket list and
+// every bucket details view need it and it is expensive to compute with many buckets
+type accountInfoCache struct {
+	mu      sync.Mutex
+	entries map[string]accountInfoCacheEntry
+}
+
+var globalAccountInfoCache = &accountInfoCache{entries: map[string]accountInfoCacheEntry{}}
+
+func (c *accountInfoCache) get(key string, now time.Time) (madmin.AccountInfo, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || now.After(entry.expires) {
+		return madmin.AccountInfo{}, false
+	}
+	return entry.info, true
+}
+
+func (c *accountInfoCache) set(key string, info madmin.AccountInfo, now time.Time, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// drop expired entries so the cache doesn't grow with every session
+	for k, entry := range c.entries {
+		if now.After(entry.expires) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = accountInfoCacheEntry{info: info, expires: now.Add(ttl)}
+}
+
+// invalidate drops the cached account info of a session, used after changes that show in the bucket list
+func (c *accountInfoCache) invalidate(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+}
+
+// cachedAccountInfoClient serves AccountInfo from globalAccountInfoCache
+type cachedAccountInfoClient struct {
+	MinioAdmin
+	key string
+}
+
+func newCachedAccountInfoClient(session *models.Principal, client MinioAdmin) cachedAccountInfoClient {
+	return cachedAccountInfoClient{MinioAdmin: client, key: sessionCacheKey(session)}
+}
+
+// sessionCacheKey identifies the session whose results are cached, users with the same name don't share
+// them. The session ID is kept when the credentials of the session are renewed, the STS access key
+// identifies the sessions without an ID.
+func sessionCacheKey(session *models.Principal) string {
+	if session.SessionID != "" {
+		return session.SessionID
+	}
+	return session.STSAccessKeyID
+}
+
+func (c cachedAccountInfoClient) AccountInfo(ctx context.Context) (madmin.AccountInfo, error) {
+	if info, ok := globalAccountInfoCache.get(c.key, time.Now()); ok {
+		return info, nil
+	}
+	info, err := c.MinioAdmin.AccountInfo(ctx)
+	if err != nil {
+		return info, err
+	}
+	globalAccountInfoCache.set(c.key, info, time.Now(), getAccountInfoCacheTTL())
+	return info, nil
+}
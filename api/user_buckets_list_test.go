@@ -0,0 +1,120 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	bucketApi "github.com/minio/console/api/operations/bucket"
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_filterBuckets(t *testing.T) {
+	assert := assert.New(t)
+	bucket := func(name string, size int64, created string, tags map[string]string) *models.Bucket {
+		return &models.Bucket{Name: swag.String(name), Size: size, CreationDate: created, Details: &models.BucketDetails{Tags: tags}}
+	}
+	buckets := []*models.Bucket{
+		bucket("logs-2023", 30, "2023-01-01T00:00:00Z", map[string]string{"team": "platform", "env": "prod"}),
+		bucket("Audit-Logs", 10, "2022-01-01T00:00:00Z", map[string]string{"team": "audit"}),
+		bucket("logs-2022", 20, "2022-06-01T00:00:00Z", map[string]string{"team": "platform", "env": "dev"}),
+		bucket("backups", 40, "2021-01-01T00:00:00Z", nil),
+	}
+	names := func(buckets []*models.Bucket) []string {
+		var names []string
+		for _, b := range buckets {
+			names = append(names, *b.Name)
+		}
+		return names
+	}
+
+	opts, err := getListBucketsOpts(bucketApi.ListBucketsParams{})
+	assert.Nil(err)
+	page, total := filterBuckets(buckets, opts)
+	assert.Equal(4, total)
+	assert.Equal([]string{"Audit-Logs", "backups", "logs-2022", "logs-2023"}, names(page))
+
+	opts, _ = getListBucketsOpts(bucketApi.ListBucketsParams{Search: swag.String("LOGS")})
+	page, total = filterBuckets(buckets, opts)
+	assert.Equal(3, total)
+	assert.Equal([]string{"Audit-Logs", "logs-2022", "logs-2023"}, names(page))
+
+	opts, _ = getListBucketsOpts(bucketApi.ListBucketsParams{Prefix: swag.String("logs-"), Tags: swag.String("team=platform, env"), SortBy: swag.String("size"), SortOrder: swag.String("desc")})
+	page, _ = filterBuckets(buckets, opts)
+	assert.Equal([]string{"logs-2023", "logs-2022"}, names(page))
+
+	opts, _ = getListBucketsOpts(bucketApi.ListBucketsParams{SortBy: swag.String("created"), Offset: swag.Int32(1), Limit: swag.Int32(2)})
+	page, total = filterBuckets(buckets, opts)
+	assert.Equal(4, total)
+	assert.Equal([]string{"Audit-Logs", "logs-2022"}, names(page))
+
+	opts, _ = getListBucketsOpts(bucketApi.ListBucketsParams{Offset: swag.Int32(10)})
+	page, total = filterBuckets(buckets, opts)
+	assert.Equal(4, total)
+	assert.Empty(page)
+
+	for _, params := range []bucketApi.ListBucketsParams{
+		{SortBy: swag.String("owner")},
+		{SortOrder: swag.String("random")},
+		{Limit: swag.Int32(-1)},
+	} {
+		_, err = getListBucketsOpts(params)
+		assert.NotNil(err)
+	}
+}
+
+func Test_cachedAccountInfoClient(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	calls := 0
+	adminClient := AdminClientMock{
+		minioAccountInfoMock: func(_ context.Context) (madmin.AccountInfo, error) {
+			calls++
+			return madmin.AccountInfo{Buckets: []madmin.BucketAccessInfo{{Name: "bucket"}}}, nil
+		},
+	}
+	session := &models.Principal{AccountAccessKey: "account-info-test", SessionID: "account-info-session"}
+	client := newCachedAccountInfoClient(session, adminClient)
+
+	for i := 0; i < 3; i++ {
+		info, err := client.AccountInfo(ctx)
+		assert.Nil(err)
+		assert.Equal("bucket", info.Buckets[0].Name)
+	}
+	assert.Equal(1, calls)
+
+	// other sessions have their own entry, even of a user with the same name
+	_, err := newCachedAccountInfoClient(&models.Principal{AccountAccessKey: "account-info-test", SessionID: "other-session"}, adminClient).AccountInfo(ctx)
+	assert.Nil(err)
+	assert.Equal(2, calls)
+
+	globalAccountInfoCache.invalidate(sessionCacheKey(session))
+	_, err = client.AccountInfo(ctx)
+	assert.Nil(err)
+	assert.Equal(3, calls)
+
+	// expired entries are fetched again
+	_, ok := globalAccountInfoCache.get(sessionCacheKey(session), time.Now().Add(time.Hour))
+	assert.False(ok)
+	globalAccountInfoCache.invalidate(sessionCacheKey(session))
+	globalAccountInfoCache.invalidate("other-session")
+}
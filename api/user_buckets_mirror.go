@@ -0,0 +1,582 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+	"github.com/minio/console/api/operations"
+	bucketApi "github.com/minio/console/api/operations/bucket"
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+)
+
+const (
+	mirrorJobPlanning  = "planning"
+	mirrorJobRunning   = "running"
+	mirrorJobPaused    = "paused"
+	mirrorJobCanceling = "canceling"
+	mirrorJobCompleted = "completed"
+	mirrorJobFailed    = "failed"
+	mirrorJobCanceled  = "canceled"
+
+	mirrorActionCopy   = "copy"
+	mirrorActionRemove = "remove"
+	mirrorActionList   = "list"
+
+	// maxCopyObjectSize is the largest object a single CopyObject call accepts, larger objects are
+	// copied part by part
+	maxCopyObjectSize = 5 << 30
+	// maxMirrorJobErrors limits the failures kept in the job report
+	maxMirrorJobErrors = 100
+	// mirrorJobRetention is how long finished jobs are listed before being discarded
+	mirrorJobRetention = 24 * time.Hour
+	// mirrorProgressInterval is how often the job progress is streamed over the websocket
+	mirrorProgressInterval = time.Second
+)
+
+type mirrorOpts struct {
+	objectDiffOpts
+	NewerOnly        bool
+	Overwrite        bool
+	RemoveExtraneous bool
+}
+
+type mirrorAction struct {
+	Action string
+	Key    string
+	Size   int64
+}
+
+// mirrorJob is a mirror running in the background of the console, it outlives the request that
+// started it and is followed through the REST API or the objectManager websocket
+type mirrorJob struct {
+	mu     sync.Mutex
+	owner  string
+	opts   *mirrorOpts
+	report models.MirrorJob
+	// deadline is when the credentials of the session that started the job stop working, zero if unknown
+	deadline time.Time
+	// bytes of the copies already attempted, failed ones included, used to estimate the remaining time
+	processedBytes int64
+	transferStart  time.Time
+	finishedAt     time.Time
+	pausedAt       time.Time
+	pausedFor      time.Duration
+	// resume is closed when a paused job is resumed, nil while the job is not paused
+	resume chan struct{}
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+type mirrorJobRegistry struct {
+	mu   sync.Mutex
+	jobs map[string]*mirrorJob
+}
+
+var globalMirrorJobs = &mirrorJobRegistry{jobs: map[string]*mirrorJob{}}
+
+func registerBucketsMirrorHandlers(api *operations.ConsoleAPI) {
+	// start mirror job
+	api.BucketStartBucketMirrorHandler = bucketApi.StartBucketMirrorHandlerFunc(func(params bucketApi.StartBucketMirrorParams, session *models.Principal) middleware.Responder {
+		job, err := getStartBucketMirrorResponse(session, params)
+		if err != nil {
+			return bucketApi.NewStartBucketMirrorDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucketApi.NewStartBucketMirrorCreated().WithPayload(job)
+	})
+	// list mirror jobs
+	api.BucketListMirrorJobsHandler = bucketApi.ListMirrorJobsHandlerFunc(func(_ bucketApi.ListMirrorJobsParams, session *models.Principal) middleware.Responder {
+		return bucketApi.NewListMirrorJobsOK().WithPayload(getListMirrorJobsResponse(session))
+	})
+	// get mirror job
+	api.BucketGetMirrorJobHandler = bucketApi.GetMirrorJobHandlerFunc(func(params bucketApi.GetMirrorJobParams, session *models.Principal) middleware.Responder {
+		job, err := getMirrorJobResponse(session, params)
+		if err != nil {
+			return bucketApi.NewGetMirrorJobDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucketApi.NewGetMirrorJobOK().WithPayload(job)
+	})
+	// cancel or remove mirror job
+	api.BucketDeleteMirrorJobHandler = bucketApi.DeleteMirrorJobHandlerFunc(func(params bucketApi.DeleteMirrorJobParams, session *models.Principal) middleware.Responder {
+		if err := getDeleteMirrorJobResponse(session, params); err != nil {
+			return bucketApi.NewDeleteMirrorJobDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucketApi.NewDeleteMirrorJobNoContent()
+	})
+	// pause mirror job
+	api.BucketPauseMirrorJobHandler = bucketApi.PauseMirrorJobHandlerFunc(func(params bucketApi.PauseMirrorJobParams, session *models.Principal) middleware.Responder {
+		job, err := getPauseMirrorJobResponse(session, params)
+		if err != nil {
+			return bucketApi.NewPauseMirrorJobDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucketApi.NewPauseMirrorJobOK().WithPayload(job)
+	})
+	// resume mirror job
+	api.BucketResumeMirrorJobHandler = bucketApi.ResumeMirrorJobHandlerFunc(func(params bucketApi.ResumeMirrorJobParams, session *models.Principal) middleware.Responder {
+		job, err := getResumeMirrorJobResponse(session, params)
+		if err != nil {
+			return bucketApi.NewResumeMirrorJobDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucketApi.NewResumeMirrorJobOK().WithPayload(job)
+	})
+}
+
+func newMirrorOpts(sourceBucket string, req *models.MirrorJobRequest) (*mirrorOpts, error) {
+	if req == nil || req.TargetBucket == nil {
+		return nil, ErrBucketBodyNotInRequest
+	}
+	diffOpts, err := newObjectDiffOpts(sourceBucket, req.Prefix, *req.TargetBucket, req.TargetPrefix)
+	if err != nil {
+		return nil, err
+	}
+	return &mirrorOpts{
+		objectDiffOpts:   *diffOpts,
+		NewerOnly:        req.NewerOnly,
+		Overwrite:        req.Overwrite,
+		RemoveExtraneous: req.RemoveExtraneous,
+	}, nil
+}
+
+// mirrorDiffAction decides what to do with a difference between the source and the target, the
+// same way `mc mirror` does: missing objects are always copied, objects that differ are only
+// overwritten when asked to and extraneous objects are only removed when asked to
+func mirrorDiffAction(opts *mirrorOpts, diff ObjectDiff) (action string, skipped bool) {
+	switch diff.Diff {
+	case objectDiffOnlyInSource:
+		return mirrorActionCopy, false
+	case objectDiffDifferent:
+		if opts.NewerOnly && slices.Contains(diff.Reasons, objectDiffReasonModified) {
+			return mirrorActionCopy, false
+		}
+		if opts.Overwrite && !opts.NewerOnly {
+			return mirrorActionCopy, false
+		}
+		return "", true
+	case objectDiffOnlyInTarget:
+		if opts.RemoveExtraneous {
+			return mirrorActionRemove, false
+		}
+	}
+	return "", false
+}
+
+// planMirror compares both locations and returns the copies and removals needed for the target to
+// mirror the source
+func planMirror(ctx context.Context, client MinioClient, opts *mirrorOpts, job *mirrorJob) ([]mirrorAction, error) {
+	var actions []mirrorAction
+	_, err := diffObjects(ctx, client, &opts.objectDiffOpts, func(diff ObjectDiff) error {
+		action, skipped := mirrorDiffAction(opts, diff)
+		job.mu.Lock()
+		defer job.mu.Unlock()
+		switch {
+		case skipped:
+			job.report.SkippedObjects++
+		case action == mirrorActionCopy:
+			actions = append(actions, mirrorAction{Action: action, Key: diff.Key, Size: diff.SourceSize})
+			job.report.TotalObjects++
+			job.report.TotalBytes += diff.SourceSize
+		case action == mirrorActionRemove:
+			actions = append(actions, mirrorAction{Action: action, Key: diff.Key})
+			job.report.TotalObjects++
+		}
+		return nil
+	})
+	return actions, err
+}
+
+// copyMirrorObject copies an object inside the deployment, the data never leaves the server
+func copyMirrorObject(ctx context.Context, client MinioClient, opts *mirrorOpts, action mirrorAction) error {
+	src := minio.CopySrcOptions{Bucket: opts.SourceBucket, Object: opts.SourcePrefix + action.Key}
+	dst := minio.CopyDestOptions{Bucket: opts.TargetBucket, Object: opts.TargetPrefix + action.Key}
+	if action.Size > maxCopyObjectSize {
+		// the source metadata is kept since a single source is composed
+		_, err := client.composeObject(ctx, dst, src)
+		return err
+	}
+	_, err := client.copyObject(ctx, dst, src)
+	return err
+}
+
+func newMirrorJob(owner string, opts *mirrorOpts, now time.Time) *mirrorJob {
+	return &mirrorJob{
+		owner: owner,
+		opts:  opts,
+		report: models.MirrorJob{
+			ID:               uuid.NewString(),
+			SourceBucket:     opts.SourceBucket,
+			SourcePrefix:     opts.SourcePrefix,
+			TargetBucket:     opts.TargetBucket,
+			TargetPrefix:     opts.TargetPrefix,
+			NewerOnly:        opts.NewerOnly,
+			Overwrite:        opts.Overwrite,
+			RemoveExtraneous: opts.RemoveExtraneous,
+			Status:           mirrorJobPlanning,
+			Started:          now.UTC().Format(time.RFC3339),
+		},
+		done: make(chan struct{}),
+	}
+}
+
+// run plans the mirror and applies it, mcClient is used to remove objects from the target bucket
+func (j *mirrorJob) run(ctx context.Context, client MinioClient, mcClient MCClient) {
+	defer close(j.done)
+	actions, err := planMirror(ctx, client, j.opts, j)
+	if err != nil {
+		if j.credentialsExpired(time.Now()) {
+			err = ErrMirrorJobCredentialsExpired
+		}
+		j.finish(ctx, mirrorAction{Action: mirrorActionList}, err)
+		return
+	}
+
+	j.mu.Lock()
+	if j.report.Status == mirrorJobPlanning {
+		j.report.Status = mirrorJobRunning
+	}
+	j.transferStart = time.Now()
+	j.mu.Unlock()
+
+	for _, action := range actions {
+		if j.waitIfPaused(ctx) != nil {
+			break
+		}
+		// the job fails once its credentials expire instead of failing every remaining object
+		if j.credentialsExpired(time.Now()) {
+			j.finish(ctx, mirrorAction{}, ErrMirrorJobCredentialsExpired)
+			return
+		}
+		var err error
+		if action.Action == mirrorActionCopy {
+			err = copyMirrorObject(ctx, client, j.opts, action)
+		} else {
+			err = deleteSingleObject(ctx, mcClient, j.opts.TargetBucket, j.opts.TargetPrefix+action.Key, "", false)
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		j.record(action, err)
+	}
+	j.finish(ctx, mirrorAction{}, nil)
+}
+
+func (j *mirrorJob) credentialsExpired(now time.Time) bool {
+	return !j.deadline.IsZero() && !now.Before(j.deadline)
+}
+
+func (j *mirrorJob) record(action mirrorAction, err error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.processedBytes += action.Size
+	switch {
+	case err != nil:
+		j.report.FailedObjects++
+		if len(j.report.Errors) < maxMirrorJobErrors {
+			j.report.Errors = append(j.report.Errors, &models.MirrorJobError{Key: action.Key, Action: action.Action, Error: err.Error()})
+		}
+	case action.Action == mirrorActionCopy:
+		j.report.CopiedObjects++
+		j.report.CopiedBytes += action.Size
+	default:
+		j.report.RemovedObjects++
+	}
+}
+
+func (j *mirrorJob) finish(ctx context.Context, action mirrorAction, err error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.finishedAt = time.Now()
+	j.report.Finished = j.finishedAt.UTC().Format(time.RFC3339)
+	if j.resume != nil {
+		j.pausedFor += j.finishedAt.Sub(j.pausedAt)
+		j.resume = nil
+	}
+	switch {
+	case ctx.Err() != nil:
+		j.report.Status = mirrorJobCanceled
+	case err != nil:
+		j.report.Status = mirrorJobFailed
+		j.report.Errors = append(j.report.Errors, &models.MirrorJobError{Key: action.Key, Action: action.Action, Error: err.Error()})
+	default:
+		j.report.Status = mirrorJobCompleted
+	}
+}
+
+func (j *mirrorJob) waitIfPaused(ctx context.Context) error {
+	j.mu.Lock()
+	resume := j.resume
+	j.mu.Unlock()
+	if resume == nil {
+		return ctx.Err()
+	}
+	select {
+	case <-resume:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (j *mirrorJob) pause(now time.Time) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.report.Status != mirrorJobRunning {
+		return ErrInvalidMirrorJobState
+	}
+	j.report.Status = mirrorJobPaused
+	j.pausedAt = now
+	j.resume = make(chan struct{})
+	return nil
+}
+
+func (j *mirrorJob) unpause(now time.Time) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.report.Status != mirrorJobPaused {
+		return ErrInvalidMirrorJobState
+	}
+	j.report.Status = mirrorJobRunning
+	j.pausedFor += now.Sub(j.pausedAt)
+	close(j.resume)
+	j.resume = nil
+	return nil
+}
+
+// stop cancels the job without waiting for it, the job is canceling until the action in progress returns
+func (j *mirrorJob) stop() {
+	j.mu.Lock()
+	if j.finishedAt.IsZero() {
+		j.report.Status = mirrorJobCanceling
+	}
+	j.mu.Unlock()
+	j.cancel()
+}
+
+func (j *mirrorJob) finished() bool {
+	select {
+	case <-j.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// snapshot returns the job report with the transfer rate and the estimated time left
+func (j *mirrorJob) snapshot(now time.Time) *models.MirrorJob {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	report := j.report
+	report.Errors = append([]*models.MirrorJobError{}, j.report.Errors...)
+	if j.transferStart.IsZero() {
+		return &report
+	}
+	end := now
+	if !j.finishedAt.IsZero() {
+		end = j.finishedAt
+	}
+	elapsed := end.Sub(j.transferStart) - j.pausedFor
+	if j.resume != nil {
+		elapsed -= now.Sub(j.pausedAt)
+	}
+	if elapsed <= 0 || j.processedBytes == 0 {
+		return &report
+	}
+	rate := float64(j.processedBytes) / elapsed.Seconds()
+	report.BytesPerSecond = int64(rate)
+	if j.finishedAt.IsZero() {
+		report.EtaSeconds = int64(float64(report.TotalBytes-j.processedBytes) / rate)
+	}
+	return &report
+}
+
+func (r *mirrorJobRegistry) add(job *mirrorJob, now time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	// finished jobs are kept for a while so their report can be read
+	for id, j := range r.jobs {
+		if j.finished() && now.Sub(j.finishedAt) > mirrorJobRetention {
+			delete(r.jobs, id)
+		}
+	}
+	r.jobs[job.report.ID] = job
+}
+
+func (r *mirrorJobRegistry) get(owner, id string) (*mirrorJob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	job, ok := r.jobs[id]
+	if !ok || job.owner != owner {
+		return nil, ErrMirrorJobNotFound
+	}
+	return job, nil
+}
+
+func (r *mirrorJobRegistry) list(owner string) []*mirrorJob {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var jobs []*mirrorJob
+	for _, job := range r.jobs {
+		if job.owner == owner {
+			jobs = append(jobs, job)
+		}
+	}
+	// latest jobs first
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].report.Started > jobs[j].report.Started
+	})
+	return jobs
+}
+
+func (r *mirrorJobRegistry) remove(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.jobs, id)
+}
+
+func getStartBucketMirrorResponse(session *models.Principal, params bucketApi.StartBucketMirrorParams) (*models.MirrorJob, *CodedAPIError) {
+	ctx := params.HTTPRequest.Context()
+	opts, err := newMirrorOpts(params.BucketName, params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	clientIP := getClientIP(params.HTTPRequest)
+	mClient, err := newMinioClient(session, clientIP)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+	s3Client, err := newS3BucketClient(session, opts.TargetBucket, "", clientIP)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a mc S3Client interface implementation
+	// defining the client to be used
+	mcClient := mcClient{client: s3Client}
+
+	if err := checkDiffLocations(ctx, minioClient, &opts.objectDiffOpts); err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	job := startMirrorJob(session, opts, minioClient, mcClient)
+	return job.snapshot(time.Now()), nil
+}
+
+// startMirrorJob runs the job in the background, it isn't tied to the request that started it. The job
+// belongs to the user of the session, every session of the user can follow it, and runs with the
+// credentials of the session until they expire.
+func startMirrorJob(session *models.Principal, opts *mirrorOpts, client MinioClient, mcClient MCClient) *mirrorJob {
+	now := time.Now()
+	job := newMirrorJob(sessionOwner(session), opts, now)
+	job.deadline = sessionCredentialsDeadline(session)
+	ctx, cancel := context.WithCancel(context.Background())
+	job.cancel = cancel
+	globalMirrorJobs.add(job, now)
+	go func() {
+		defer cancel()
+		job.run(ctx, client, mcClient)
+	}()
+	return job
+}
+
+func getListMirrorJobsResponse(session *models.Principal) *models.MirrorJobList {
+	now := time.Now()
+	list := &models.MirrorJobList{Jobs: []*models.MirrorJob{}}
+	for _, job := range globalMirrorJobs.list(sessionOwner(session)) {
+		list.Jobs = append(list.Jobs, job.snapshot(now))
+	}
+	return list
+}
+
+func getMirrorJobResponse(session *models.Principal, params bucketApi.GetMirrorJobParams) (*models.MirrorJob, *CodedAPIError) {
+	job, err := globalMirrorJobs.get(sessionOwner(session), params.JobID)
+	if err != nil {
+		return nil, ErrorWithContext(params.HTTPRequest.Context(), err)
+	}
+	return job.snapshot(time.Now()), nil
+}
+
+func getDeleteMirrorJobResponse(session *models.Principal, params bucketApi.DeleteMirrorJobParams) *CodedAPIError {
+	job, err := globalMirrorJobs.get(sessionOwner(session), params.JobID)
+	if err != nil {
+		return ErrorWithContext(params.HTTPRequest.Context(), err)
+	}
+	// running jobs are canceled and keep their report, finished ones are discarded. The cancellation
+	// is not awaited, the objectManager websocket reports when the job stopped
+	if job.finished() {
+		globalMirrorJobs.remove(params.JobID)
+		return nil
+	}
+	job.stop()
+	return nil
+}
+
+func getPauseMirrorJobResponse(session *models.Principal, params bucketApi.PauseMirrorJobParams) (*models.MirrorJob, *CodedAPIError) {
+	ctx := params.HTTPRequest.Context()
+	job, err := globalMirrorJobs.get(sessionOwner(session), params.JobID)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	now := time.Now()
+	if err := job.pause(now); err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return job.snapshot(now), nil
+}
+
+func getResumeMirrorJobResponse(session *models.Principal, params bucketApi.ResumeMirrorJobParams) (*models.MirrorJob, *CodedAPIError) {
+	ctx := params.HTTPRequest.Context()
+	job, err := globalMirrorJobs.get(sessionOwner(session), params.JobID)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	now := time.Now()
+	if err := job.unpause(now); err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return job.snapshot(now), nil
+}
+
+// streamMirrorJob handles `mirror` requests of the objectManager websocket, the job progress is
+// sent periodically until the job finishes and the final report is sent
+func streamMirrorJob(ctx context.Context, session *models.Principal, request ObjectsRequest, send func(WSResponse)) {
+	job, err := globalMirrorJobs.get(sessionOwner(session), request.JobID)
+	if err != nil {
+		send(WSResponse{RequestID: request.RequestID, Error: ErrorWithContext(ctx, err)})
+		return
+	}
+	ticker := time.NewTicker(mirrorProgressInterval)
+	defer ticker.Stop()
+	for {
+		send(WSResponse{RequestID: request.RequestID, MirrorJob: job.snapshot(time.Now())})
+		select {
+		case <-job.done:
+			send(WSResponse{RequestID: request.RequestID, MirrorJob: job.snapshot(time.Now())})
+			send(WSResponse{RequestID: request.RequestID, RequestEnd: true})
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,261 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	jwtgo "github.com/golang-jwt/jwt/v4"
+	bucketApi "github.com/minio/console/api/operations/bucket"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/auth/session"
+	mc "github.com/minio/mc/cmd"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_mirrorDiffAction(t *testing.T) {
+	assert := assert.New(t)
+	different := ObjectDiff{Diff: objectDiffDifferent, Reasons: []string{objectDiffReasonSize}}
+	newer := ObjectDiff{Diff: objectDiffDifferent, Reasons: []string{objectDiffReasonETag, objectDiffReasonModified}}
+	onlyInTarget := ObjectDiff{Diff: objectDiffOnlyInTarget}
+
+	opts := &mirrorOpts{}
+	action, skipped := mirrorDiffAction(opts, ObjectDiff{Diff: objectDiffOnlyInSource})
+	assert.Equal(mirrorActionCopy, action)
+	assert.False(skipped)
+	_, skipped = mirrorDiffAction(opts, different)
+	assert.True(skipped)
+	action, skipped = mirrorDiffAction(opts, onlyInTarget)
+	assert.Equal("", action)
+	assert.False(skipped)
+
+	opts = &mirrorOpts{Overwrite: true, RemoveExtraneous: true}
+	action, _ = mirrorDiffAction(opts, different)
+	assert.Equal(mirrorActionCopy, action)
+	action, _ = mirrorDiffAction(opts, onlyInTarget)
+	assert.Equal(mirrorActionRemove, action)
+
+	// only objects the source has a newer copy of are overwritten
+	opts = &mirrorOpts{Overwrite: true, NewerOnly: true}
+	_, skipped = mirrorDiffAction(opts, different)
+	assert.True(skipped)
+	action, _ = mirrorDiffAction(opts, newer)
+	assert.Equal(mirrorActionCopy, action)
+}
+
+func Test_mirrorJobRun(t *testing.T) {
+	assert := assert.New(t)
+	t1 := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	minioListObjectsMock = listBucketsObjectsFrom(map[string][]minio.ObjectInfo{
+		"source": {
+			{Key: "a.txt", Size: 1, ETag: "a", LastModified: t1},
+			{Key: "big.bin", Size: 6 << 30, ETag: "big", LastModified: t1},
+			{Key: "broken.txt", Size: 3, ETag: "c", LastModified: t1},
+			{Key: "same.txt", Size: 4, ETag: "s", LastModified: t1},
+			{Key: "stale.txt", Size: 5, ETag: "x", LastModified: t1},
+		},
+		"target": {
+			{Key: "backup/extra.txt", Size: 2, ETag: "e", LastModified: t1},
+			{Key: "backup/same.txt", Size: 4, ETag: "s", LastModified: t1},
+			{Key: "backup/stale.txt", Size: 5, ETag: "y", LastModified: t1},
+		},
+	})
+	var copied, composed []string
+	client := minioClientMock{
+		copyObjectMock: func(_ context.Context, dst minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error) {
+			if src.Object == "broken.txt" {
+				return minio.UploadInfo{}, errors.New("access denied")
+			}
+			copied = append(copied, dst.Object)
+			return minio.UploadInfo{}, nil
+		},
+		composeObjectMock: func(_ context.Context, dst minio.CopyDestOptions, _ ...minio.CopySrcOptions) (minio.UploadInfo, error) {
+			composed = append(composed, dst.Object)
+			return minio.UploadInfo{}, nil
+		},
+	}
+	var removed []string
+	mcRemoveMock = func(_ context.Context, _, _, _, _ bool, contentCh <-chan *mc.ClientContent) <-chan mc.RemoveResult {
+		for content := range contentCh {
+			removed = append(removed, content.URL.Path)
+		}
+		resultCh := make(chan mc.RemoveResult)
+		close(resultCh)
+		return resultCh
+	}
+
+	opts, err := newMirrorOpts("source", &models.MirrorJobRequest{TargetBucket: swag.String("target"), TargetPrefix: "backup/", RemoveExtraneous: true})
+	assert.Nil(err)
+	job := newMirrorJob("user", opts, t1)
+	job.run(context.Background(), client, s3ClientMock{})
+	report := job.snapshot(time.Now())
+	assert.Equal(mirrorJobCompleted, report.Status)
+	assert.Equal([]string{"backup/a.txt"}, copied)
+	assert.Equal([]string{"backup/big.bin"}, composed)
+	assert.Equal([]string{"target/backup/extra.txt"}, removed)
+	assert.Equal(int64(4), report.TotalObjects)
+	assert.Equal(int64(1+6<<30+3), report.TotalBytes)
+	assert.Equal(int64(2), report.CopiedObjects)
+	assert.Equal(int64(1+6<<30), report.CopiedBytes)
+	assert.Equal(int64(1), report.SkippedObjects)
+	assert.Equal(int64(1), report.RemovedObjects)
+	assert.Equal(int64(1), report.FailedObjects)
+	assert.Equal([]*models.MirrorJobError{{Key: "broken.txt", Action: mirrorActionCopy, Error: "access denied"}}, report.Errors)
+	assert.Equal(int64(0), report.EtaSeconds)
+
+	// listing errors fail the job
+	minioListObjectsMock = listBucketsObjectsFrom(map[string][]minio.ObjectInfo{
+		"source": {{Err: errors.New("access denied")}},
+	})
+	job = newMirrorJob("user", opts, t1)
+	job.run(context.Background(), client, s3ClientMock{})
+	report = job.snapshot(time.Now())
+	assert.Equal(mirrorJobFailed, report.Status)
+	assert.Equal(mirrorActionList, report.Errors[0].Action)
+
+	// jobs fail once the credentials of their session expire
+	minioListObjectsMock = listBucketsObjectsFrom(map[string][]minio.ObjectInfo{
+		"source": {{Key: "a.txt", Size: 1, ETag: "a", LastModified: t1}},
+	})
+	copied = nil
+	job = newMirrorJob("user", opts, t1)
+	job.deadline = time.Now()
+	job.run(context.Background(), client, s3ClientMock{})
+	report = job.snapshot(time.Now())
+	assert.Equal(mirrorJobFailed, report.Status)
+	assert.Empty(copied)
+	assert.Equal(int64(0), report.FailedObjects)
+	assert.Equal(ErrMirrorJobCredentialsExpired.Error(), report.Errors[0].Error)
+}
+
+func Test_sessionCredentialsDeadline(t *testing.T) {
+	assert := assert.New(t)
+	exp := time.Now().Add(time.Hour).Truncate(time.Second)
+	token, _ := jwtgo.NewWithClaims(jwtgo.SigningMethodHS256, jwtgo.MapClaims{"exp": exp.Unix()}).SignedString([]byte("secret"))
+
+	// Test-1 : credentials that aren't renewed expire with the STS session token
+	assert.Equal(exp, sessionCredentialsDeadline(&models.Principal{STSSessionToken: token}))
+	assert.True(sessionCredentialsDeadline(&models.Principal{STSSessionToken: "opaque"}).IsZero())
+
+	// Test-2 : renewed credentials last as long as the session
+	renewable := &models.Principal{STSSessionToken: token, StsExpiration: exp.Unix(), SessionExpiration: exp.Add(time.Hour).Unix()}
+	assert.Equal(exp, sessionCredentialsDeadline(renewable))
+	GlobalSessions = session.NewRegistry(session.NewMemoryStore(), 0)
+	defer func() { GlobalSessions = nil }()
+	assert.Equal(exp.Add(time.Hour), sessionCredentialsDeadline(renewable))
+}
+
+func Test_mirrorJobPause(t *testing.T) {
+	assert := assert.New(t)
+	minioListObjectsMock = listBucketsObjectsFrom(map[string][]minio.ObjectInfo{
+		"source": {{Key: "a.txt", Size: 1}, {Key: "b.txt", Size: 1}},
+	})
+	started := make(chan struct{})
+	release := make(chan struct{})
+	client := minioClientMock{
+		copyObjectMock: func(_ context.Context, _ minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error) {
+			if src.Object == "a.txt" {
+				close(started)
+				<-release
+			}
+			return minio.UploadInfo{}, nil
+		},
+	}
+	opts, err := newMirrorOpts("source", &models.MirrorJobRequest{TargetBucket: swag.String("target")})
+	assert.Nil(err)
+	token, _ := jwtgo.NewWithClaims(jwtgo.SigningMethodHS256, jwtgo.MapClaims{"parent": "mirror-test"}).SignedString([]byte("secret"))
+	session := &models.Principal{STSAccessKeyID: "sts-access", STSSessionToken: token}
+	job := startMirrorJob(session, opts, client, s3ClientMock{})
+
+	<-started
+	now := time.Now()
+	assert.Nil(job.pause(now))
+	assert.Equal(ErrInvalidMirrorJobState, job.pause(now))
+	close(release)
+	// the copy in progress completes, the next one waits for the job to be resumed
+	assert.Eventually(func() bool {
+		return job.snapshot(time.Now()).CopiedObjects == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(mirrorJobPaused, job.snapshot(time.Now()).Status)
+	assert.Nil(job.unpause(time.Now()))
+	<-job.done
+	assert.Equal(int64(2), job.snapshot(time.Now()).CopiedObjects)
+	assert.Equal(ErrInvalidMirrorJobState, job.unpause(time.Now()))
+
+	// jobs are only visible to the user that started them
+	_, err = globalMirrorJobs.get("someone-else", job.report.ID)
+	assert.Equal(ErrMirrorJobNotFound, err)
+	jobs := getListMirrorJobsResponse(session).Jobs
+	assert.Len(jobs, 1)
+	assert.Equal(mirrorJobCompleted, jobs[0].Status)
+	// the STS access key of the session changes when its credentials are renewed
+	renewed := &models.Principal{STSAccessKeyID: "renewed-sts-access", STSSessionToken: token}
+	assert.Len(getListMirrorJobsResponse(renewed).Jobs, 1)
+
+	var frames []WSResponse
+	streamMirrorJob(context.Background(), session, ObjectsRequest{Mode: "mirror", JobID: job.report.ID, RequestID: 1}, func(r WSResponse) {
+		frames = append(frames, r)
+	})
+	assert.Equal(mirrorJobCompleted, frames[len(frames)-2].MirrorJob.Status)
+	assert.True(frames[len(frames)-1].RequestEnd)
+	globalMirrorJobs.remove(job.report.ID)
+}
+
+func Test_mirrorJobCancel(t *testing.T) {
+	assert := assert.New(t)
+	minioListObjectsMock = listBucketsObjectsFrom(map[string][]minio.ObjectInfo{
+		"source": {{Key: "a.txt", Size: 1}, {Key: "b.txt", Size: 1}},
+	})
+	started, release := make(chan struct{}), make(chan struct{})
+	client := minioClientMock{
+		copyObjectMock: func(ctx context.Context, _ minio.CopyDestOptions, _ minio.CopySrcOptions) (minio.UploadInfo, error) {
+			close(started)
+			// a copy in progress on the server isn't interrupted right away
+			<-release
+			return minio.UploadInfo{}, ctx.Err()
+		},
+	}
+	opts, err := newMirrorOpts("source", &models.MirrorJobRequest{TargetBucket: swag.String("target")})
+	assert.Nil(err)
+	session := &models.Principal{AccountAccessKey: "mirror-test"}
+	job := startMirrorJob(session, opts, client, s3ClientMock{})
+	<-started
+	// canceling the job doesn't wait for the copy in progress
+	apiErr := getDeleteMirrorJobResponse(session, bucketApi.DeleteMirrorJobParams{
+		HTTPRequest: httptest.NewRequest(http.MethodDelete, "/api/v1/buckets/mirror-jobs/"+job.report.ID, nil),
+		JobID:       job.report.ID,
+	})
+	assert.Nil(apiErr)
+	assert.False(job.finished())
+	assert.Equal(mirrorJobCanceling, job.snapshot(time.Now()).Status)
+	close(release)
+	<-job.done
+	report := job.snapshot(time.Now())
+	assert.Equal(mirrorJobCanceled, report.Status)
+	assert.Equal(int64(0), report.FailedObjects)
+	globalMirrorJobs.remove(job.report.ID)
+
+	_, err = newMirrorOpts("source", &models.MirrorJobRequest{})
+	assert.Equal(ErrBucketBodyNotInRequest, err)
+}
@@ -0,0 +1,444 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/api/operations"
+	bucketApi "github.com/minio/console/api/operations/bucket"
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/replication"
+)
+
+func registerBucketsReplicationHandlers(api *operations.ConsoleAPI) {
+	// list replication rules
+	api.BucketGetBucketReplicationHandler = bucketApi.GetBucketReplicationHandlerFunc(func(params bucketApi.GetBucketReplicationParams, session *models.Principal) middleware.Responder {
+		getBucketReplication, err := getBucketReplicationResponse(session, params)
+		if err != nil {
+			return bucketApi.NewGetBucketReplicationDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucketApi.NewGetBucketReplicationOK().WithPayload(getBucketReplication)
+	})
+	// add replication rule
+	api.BucketAddBucketReplicationRuleHandler = bucketApi.AddBucketReplicationRuleHandlerFunc(func(params bucketApi.AddBucketReplicationRuleParams, session *models.Principal) middleware.Responder {
+		err := addBucketReplicationRuleResponse(session, params)
+		if err != nil {
+			return bucketApi.NewAddBucketReplicationRuleDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucketApi.NewAddBucketReplicationRuleCreated()
+	})
+	// delete replication rule
+	api.BucketDeleteBucketReplicationRuleHandler = bucketApi.DeleteBucketReplicationRuleHandlerFunc(func(params bucketApi.DeleteBucketReplicationRuleParams, session *models.Principal) middleware.Responder {
+		err := deleteBucketReplicationRuleResponse(session, params)
+		if err != nil {
+			return bucketApi.NewDeleteBucketReplicationRuleDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucketApi.NewDeleteBucketReplicationRuleNoContent()
+	})
+	// replication status and metrics
+	api.BucketGetBucketReplicationMetricsHandler = bucketApi.GetBucketReplicationMetricsHandlerFunc(func(params bucketApi.GetBucketReplicationMetricsParams, session *models.Principal) middleware.Responder {
+		metrics, err := getBucketReplicationMetricsResponse(session, params)
+		if err != nil {
+			return bucketApi.NewGetBucketReplicationMetricsDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucketApi.NewGetBucketReplicationMetricsOK().WithPayload(metrics)
+	})
+}
+
+// getReplicationConfig fetches the replication configuration of a bucket, an empty configuration
+// is returned if the bucket doesn't have one
+func getReplicationConfig(ctx context.Context, client MinioClient, bucketName string) (replication.Config, error) {
+	cfg, err := client.getBucketReplication(ctx, bucketName)
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "ReplicationConfigurationNotFoundError" {
+			return replication.Config{}, nil
+		}
+		return replication.Config{}, err
+	}
+	return cfg, nil
+}
+
+// getReplicationTargets returns the replication remote targets of a bucket indexed by ARN
+func getReplicationTargets(ctx context.Context, adminClient MinioAdmin, bucketName string) (map[string]madmin.BucketTarget, error) {
+	targets, err := adminClient.listRemoteTargets(ctx, bucketName, string(madmin.ReplicationService))
+	if err != nil {
+		return nil, err
+	}
+	targetsByArn := map[string]madmin.BucketTarget{}
+	for _, target := range targets {
+		targetsByArn[target.Arn] = target
+	}
+	return targetsByArn, nil
+}
+
+// replicationRuleToModel converts a replication.Rule to its console representation
+func replicationRuleToModel(rule replication.Rule, targets map[string]madmin.BucketTarget) *models.BucketReplicationRule {
+	destination := &models.BucketReplicationDestination{
+		Bucket: rule.Destination.Bucket,
+	}
+	if target, ok := targets[rule.Destination.Bucket]; ok {
+		destination.Endpoint = target.Endpoint
+		destination.TargetBucket = target.TargetBucket
+		destination.Region = target.Region
+		destination.Online = target.Online
+		destination.SyncMode = "async"
+		if target.ReplicationSync {
+			destination.SyncMode = "sync"
+		}
+	}
+	return &models.BucketReplicationRule{
+		ID:                      rule.ID,
+		Status:                  string(rule.Status),
+		Priority:                int32(rule.Priority),
+		Prefix:                  rule.Prefix(),
+		Tags:                    rule.Tags(),
+		DeleteMarkerReplication: rule.DeleteMarkerReplication.Status == replication.Enabled,
+		DeletesReplication:      rule.DeleteReplication.Status == replication.Enabled,
+		ExistingObjects:         rule.ExistingObjectReplication.Status == replication.Enabled,
+		MetadataReplication:     rule.SourceSelectionCriteria.ReplicaModifications.Status == replication.Enabled,
+		StorageClass:            rule.Destination.StorageClass,
+		Destination:             destination,
+	}
+}
+
+// listBucketReplicationRules lists the replication rules of a bucket sorted by priority
+func listBucketReplicationRules(ctx context.Context, client MinioClient, adminClient MinioAdmin, bucketName string) (*models.BucketReplicationResponse, error) {
+	cfg, err := getReplicationConfig(ctx, client, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	rules := []*models.BucketReplicationRule{}
+	if cfg.Empty() {
+		return &models.BucketReplicationResponse{Rules: rules}, nil
+	}
+	targets, err := getReplicationTargets(ctx, adminClient, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	for _, rule := range cfg.Rules {
+		rules = append(rules, replicationRuleToModel(rule, targets))
+	}
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].Priority > rules[j].Priority
+	})
+	return &models.BucketReplicationResponse{Rules: rules}, nil
+}
+
+func getBucketReplicationResponse(session *models.Principal, params bucketApi.GetBucketReplicationParams) (*models.BucketReplicationResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	adminClient := AdminClient{Client: mAdmin}
+
+	rules, err := listBucketReplicationRules(ctx, minioClient, adminClient, params.BucketName)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return rules, nil
+}
+
+// replicationStatus converts a boolean flag to the status expected by replication.Options
+func replicationStatus(enabled bool) string {
+	if enabled {
+		return "enable"
+	}
+	return "disable"
+}
+
+// newReplicationTarget builds the remote target described in the rule request
+func newReplicationTarget(bucketName string, req *models.AddBucketReplicationRule) (*madmin.BucketTarget, error) {
+	if req.Endpoint == "" || req.TargetBucket == "" || req.AccessKey == "" || req.SecretKey == "" {
+		return nil, errors.New("endpoint, target bucket and credentials are required when no remote target ARN is provided")
+	}
+	endpoint, err := url.Parse(req.Endpoint)
+	if err != nil || endpoint.Host == "" || (endpoint.Scheme != "http" && endpoint.Scheme != "https") {
+		return nil, fmt.Errorf("invalid remote target endpoint %s, expected format is http(s)://host:port", req.Endpoint)
+	}
+	if req.Bandwidth < 0 || req.HealthCheckPeriod < 0 {
+		return nil, errors.New("bandwidth and health check period cannot be negative")
+	}
+	return &madmin.BucketTarget{
+		SourceBucket: bucketName,
+		Endpoint:     endpoint.Host,
+		Credentials: &madmin.Credentials{
+			AccessKey: req.AccessKey,
+			SecretKey: req.SecretKey,
+		},
+		TargetBucket:        req.TargetBucket,
+		Secure:              endpoint.Scheme == "https",
+		API:                 "s3v4",
+		Type:                madmin.ReplicationService,
+		Region:              req.Region,
+		BandwidthLimit:      req.Bandwidth,
+		ReplicationSync:     req.SyncMode == "sync",
+		HealthCheckDuration: time.Duration(req.HealthCheckPeriod) * time.Second,
+	}, nil
+}
+
+// addBucketReplicationRule adds a replication rule to the bucket, creating the remote target first when
+// the request doesn't reference an existing one. The remote target is removed if the rule can't be added.
+func addBucketReplicationRule(ctx context.Context, client MinioClient, adminClient MinioAdmin, bucketName string, req *models.AddBucketReplicationRule) error {
+	cfg, err := getReplicationConfig(ctx, client, bucketName)
+	if err != nil {
+		return err
+	}
+
+	arn := req.Arn
+	createdTarget := false
+	if arn != "" {
+		targets, err := getReplicationTargets(ctx, adminClient, bucketName)
+		if err != nil {
+			return err
+		}
+		if _, ok := targets[arn]; !ok {
+			return fmt.Errorf("%w: remote target %s not found", ErrInvalidReplicationRule, arn)
+		}
+	} else {
+		target, err := newReplicationTarget(bucketName, req)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidReplicationRule, err)
+		}
+		arn, err = adminClient.setRemoteTarget(ctx, bucketName, target)
+		if err != nil {
+			return err
+		}
+		createdTarget = true
+	}
+
+	opts := replication.Options{
+		Op:                      replication.AddOption,
+		ID:                      req.ID,
+		Prefix:                  req.Prefix,
+		RuleStatus:              replicationStatus(!req.Disable),
+		Priority:                strconv.Itoa(int(*req.Priority)),
+		TagString:               req.Tags,
+		IsTagSet:                req.Tags != "",
+		StorageClass:            req.StorageClass,
+		IsSCSet:                 req.StorageClass != "",
+		DestBucket:              arn,
+		ReplicateDeletes:        replicationStatus(req.DeletesReplication),
+		ReplicateDeleteMarkers:  replicationStatus(req.DeleteMarkerReplication),
+		ReplicaSync:             replicationStatus(req.MetadataReplication),
+		ExistingObjectReplicate: replicationStatus(req.ExistingObjects),
+	}
+	err = cfg.AddRule(opts)
+	if err != nil {
+		err = fmt.Errorf("%w: %v", ErrInvalidReplicationRule, err)
+	} else {
+		err = client.setBucketReplication(ctx, bucketName, cfg)
+	}
+	if err != nil && createdTarget {
+		if rErr := adminClient.removeRemoteTarget(ctx, bucketName, arn); rErr != nil {
+			LogError("unable to remove remote target %s after failing to add replication rule: %v", arn, rErr)
+		}
+	}
+	return err
+}
+
+func addBucketReplicationRuleResponse(session *models.Principal, params bucketApi.AddBucketReplicationRuleParams) *CodedAPIError {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	adminClient := AdminClient{Client: mAdmin}
+
+	if err := addBucketReplicationRule(ctx, minioClient, adminClient, params.BucketName, params.Body); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
+
+// deleteBucketReplicationRule removes a replication rule, the replication configuration is removed
+// altogether when deleting its last rule. The remote target of the rule is only removed when asked to
+// and no other rule replicates to it, targets may have been set up before the rule and be used elsewhere.
+func deleteBucketReplicationRule(ctx context.Context, client MinioClient, adminClient MinioAdmin, bucketName, ruleID string, removeTarget bool) error {
+	cfg, err := getReplicationConfig(ctx, client, bucketName)
+	if err != nil {
+		return err
+	}
+	arn, found := "", false
+	for _, rule := range cfg.Rules {
+		if rule.ID == ruleID {
+			arn, found = rule.Destination.Bucket, true
+			break
+		}
+	}
+	if !found {
+		return ErrNotFound
+	}
+	shared := false
+	for _, rule := range cfg.Rules {
+		if rule.ID != ruleID && rule.Destination.Bucket == arn {
+			shared = true
+			break
+		}
+	}
+	if len(cfg.Rules) == 1 {
+		err = client.removeBucketReplication(ctx, bucketName)
+	} else if err = cfg.RemoveRule(replication.Options{ID: ruleID}); err == nil {
+		err = client.setBucketReplication(ctx, bucketName, cfg)
+	}
+	if err != nil {
+		return err
+	}
+	if removeTarget && arn != "" && !shared {
+		if err := adminClient.removeRemoteTarget(ctx, bucketName, arn); err != nil {
+			return fmt.Errorf("the replication rule was deleted but not its remote target %s: %w", arn, err)
+		}
+	}
+	return nil
+}
+
+func deleteBucketReplicationRuleResponse(session *models.Principal, params bucketApi.DeleteBucketReplicationRuleParams) *CodedAPIError {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	adminClient := AdminClient{Client: mAdmin}
+
+	removeTarget := params.RemoveTarget != nil && *params.RemoveTarget
+	if err := deleteBucketReplicationRule(ctx, minioClient, adminClient, params.BucketName, params.RuleID, removeTarget); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
+
+// failedReplicationStats returns the failed operations count and size, falling back to the deprecated
+// fields reported by older MinIO releases
+func failedReplicationStats(errs replication.TimedErrStats, failedCount, failedSize uint64) (int64, int64) {
+	if errs.Totals.Count > 0 || errs.Totals.Bytes > 0 {
+		return int64(errs.Totals.Count), errs.Totals.Bytes
+	}
+	return int64(failedCount), int64(failedSize)
+}
+
+// getBucketReplicationMetrics summarizes the replication metrics of a bucket and its remote targets
+func getBucketReplicationMetrics(ctx context.Context, client MinioClient, adminClient MinioAdmin, bucketName string) (*models.BucketReplicationMetrics, error) {
+	metrics, err := client.getBucketReplicationMetrics(ctx, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	targets, err := getReplicationTargets(ctx, adminClient, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	stats := metrics.CurrentStats
+	failedCount, failedBytes := failedReplicationStats(stats.Errors, stats.FailedCount, stats.FailedSize)
+	result := &models.BucketReplicationMetrics{
+		Uptime:          metrics.Uptime,
+		ReplicatedCount: stats.ReplicatedCount,
+		ReplicatedBytes: int64(stats.ReplicatedSize),
+		ReplicaCount:    stats.ReplicaCount,
+		ReplicaBytes:    int64(stats.ReplicaSize),
+		PendingCount:    int64(stats.PendingCount),
+		PendingBytes:    int64(stats.PendingSize),
+		FailedCount:     failedCount,
+		FailedBytes:     failedBytes,
+		QueuedCount:     int64(stats.QStats.Curr.Count),
+		QueuedBytes:     int64(stats.QStats.Curr.Bytes),
+		Targets:         []*models.BucketReplicationTargetMetrics{},
+	}
+	for arn, targetStats := range stats.Stats {
+		failedCount, failedBytes := failedReplicationStats(targetStats.Failed, targetStats.FailedCount, targetStats.FailedSize)
+		targetMetrics := &models.BucketReplicationTargetMetrics{
+			Arn:              arn,
+			ReplicatedCount:  int64(targetStats.ReplicatedCount),
+			ReplicatedBytes:  int64(targetStats.ReplicatedSize),
+			PendingCount:     int64(targetStats.PendingCount),
+			PendingBytes:     int64(targetStats.PendingSize),
+			FailedCount:      failedCount,
+			FailedBytes:      failedBytes,
+			BandwidthLimit:   targetStats.BandWidthLimitInBytesPerSecond,
+			CurrentBandwidth: targetStats.CurrentBandwidthInBytesPerSecond,
+		}
+		if target, ok := targets[arn]; ok {
+			targetMetrics.Endpoint = target.Endpoint
+			targetMetrics.TargetBucket = target.TargetBucket
+			targetMetrics.Online = target.Online
+		}
+		result.Targets = append(result.Targets, targetMetrics)
+	}
+	sort.Slice(result.Targets, func(i, j int) bool {
+		return result.Targets[i].Arn < result.Targets[j].Arn
+	})
+	return result, nil
+}
+
+func getBucketReplicationMetricsResponse(session *models.Principal, params bucketApi.GetBucketReplicationMetricsParams) (*models.BucketReplicationMetrics, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	mAdmin, err := NewMinioAdminClient(params.HTTPRequest.Context(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	adminClient := AdminClient{Client: mAdmin}
+
+	metrics, err := getBucketReplicationMetrics(ctx, minioClient, adminClient, params.BucketName)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return metrics, nil
+}
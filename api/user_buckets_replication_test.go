@@ -0,0 +1,308 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/replication"
+	"github.com/stretchr/testify/assert"
+)
+
+const testReplicationArn = "arn:minio:replication::c5be6b16-769d-432a-9ef1-4567081f3566:target"
+
+func replicationTargetsMock(_ context.Context, _, _ string) ([]madmin.BucketTarget, error) {
+	return []madmin.BucketTarget{
+		{
+			Arn:             testReplicationArn,
+			Endpoint:        "minio.example.net:9000",
+			TargetBucket:    "target",
+			Online:          true,
+			ReplicationSync: true,
+		},
+	}, nil
+}
+
+func Test_listBucketReplicationRules(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	adminClient := AdminClientMock{minioListRemoteTargetsMock: replicationTargetsMock}
+
+	// bucket without replication
+	minClient.getBucketReplicationMock = func(_ context.Context, _ string) (replication.Config, error) {
+		return replication.Config{}, minio.ErrorResponse{Code: "ReplicationConfigurationNotFoundError"}
+	}
+	resp, err := listBucketReplicationRules(ctx, minClient, adminClient, "bucket")
+	assert.Nil(err)
+	assert.Empty(resp.Rules)
+
+	cfg := replication.Config{}
+	assert.Nil(cfg.AddRule(replication.Options{
+		ID:                     "low",
+		Priority:               "1",
+		RuleStatus:             "enable",
+		DestBucket:             testReplicationArn,
+		ReplicateDeleteMarkers: "enable",
+		Prefix:                 "logs/",
+	}))
+	assert.Nil(cfg.AddRule(replication.Options{
+		ID:         "high",
+		Priority:   "2",
+		RuleStatus: "disable",
+		DestBucket: testReplicationArn,
+		TagString:  "a=b",
+		IsTagSet:   true,
+	}))
+	minClient.getBucketReplicationMock = func(_ context.Context, _ string) (replication.Config, error) {
+		return cfg, nil
+	}
+	resp, err = listBucketReplicationRules(ctx, minClient, adminClient, "bucket")
+	assert.Nil(err)
+	assert.Len(resp.Rules, 2)
+	// rules are sorted by priority
+	assert.Equal("high", resp.Rules[0].ID)
+	assert.Equal("Disabled", resp.Rules[0].Status)
+	assert.Equal("a=b", resp.Rules[0].Tags)
+	assert.Equal("low", resp.Rules[1].ID)
+	assert.Equal("logs/", resp.Rules[1].Prefix)
+	assert.True(resp.Rules[1].DeleteMarkerReplication)
+	assert.False(resp.Rules[1].DeletesReplication)
+	assert.Equal("minio.example.net:9000", resp.Rules[1].Destination.Endpoint)
+	assert.Equal("target", resp.Rules[1].Destination.TargetBucket)
+	assert.Equal("sync", resp.Rules[1].Destination.SyncMode)
+	assert.True(resp.Rules[1].Destination.Online)
+}
+
+func Test_addBucketReplicationRule(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	adminClient := AdminClientMock{minioListRemoteTargetsMock: replicationTargetsMock}
+
+	var setCfg replication.Config
+	minClient.getBucketReplicationMock = func(_ context.Context, _ string) (replication.Config, error) {
+		return replication.Config{}, minio.ErrorResponse{Code: "ReplicationConfigurationNotFoundError"}
+	}
+	minClient.setBucketReplicationMock = func(_ context.Context, _ string, cfg replication.Config) error {
+		setCfg = cfg
+		return nil
+	}
+
+	// use an existing remote target
+	err := addBucketReplicationRule(ctx, minClient, adminClient, "bucket", &models.AddBucketReplicationRule{
+		Arn:                testReplicationArn,
+		Priority:           swag.Int32(1),
+		Prefix:             "logs/",
+		DeletesReplication: true,
+	})
+	assert.Nil(err)
+	assert.Len(setCfg.Rules, 1)
+	assert.Equal(testReplicationArn, setCfg.Rules[0].Destination.Bucket)
+	assert.Equal(replication.Enabled, setCfg.Rules[0].DeleteReplication.Status)
+	assert.Equal(replication.Disabled, setCfg.Rules[0].DeleteMarkerReplication.Status)
+
+	// unknown remote targets are rejected
+	err = addBucketReplicationRule(ctx, minClient, adminClient, "bucket", &models.AddBucketReplicationRule{
+		Arn:      "arn:minio:replication::unknown:target",
+		Priority: swag.Int32(1),
+	})
+	assert.True(errors.Is(err, ErrInvalidReplicationRule))
+
+	// create a new remote target
+	var createdTarget *madmin.BucketTarget
+	adminClient.minioSetRemoteTargetMock = func(_ context.Context, _ string, target *madmin.BucketTarget) (string, error) {
+		createdTarget = target
+		return testReplicationArn, nil
+	}
+	err = addBucketReplicationRule(ctx, minClient, adminClient, "bucket", &models.AddBucketReplicationRule{
+		Priority:          swag.Int32(1),
+		Endpoint:          "https://minio.example.net:9000",
+		AccessKey:         "access",
+		SecretKey:         "secret",
+		TargetBucket:      "target",
+		SyncMode:          "sync",
+		HealthCheckPeriod: 30,
+	})
+	assert.Nil(err)
+	assert.Equal("minio.example.net:9000", createdTarget.Endpoint)
+	assert.True(createdTarget.Secure)
+	assert.True(createdTarget.ReplicationSync)
+	assert.Equal(30*time.Second, createdTarget.HealthCheckDuration)
+	assert.Equal(madmin.ReplicationService, createdTarget.Type)
+
+	// invalid endpoint
+	err = addBucketReplicationRule(ctx, minClient, adminClient, "bucket", &models.AddBucketReplicationRule{
+		Priority:     swag.Int32(1),
+		Endpoint:     "minio.example.net",
+		AccessKey:    "access",
+		SecretKey:    "secret",
+		TargetBucket: "target",
+	})
+	assert.True(errors.Is(err, ErrInvalidReplicationRule))
+
+	// the created remote target is removed when the rule can't be set
+	removedArn := ""
+	adminClient.minioRemoveRemoteTargetMock = func(_ context.Context, _, arn string) error {
+		removedArn = arn
+		return nil
+	}
+	minClient.setBucketReplicationMock = func(_ context.Context, _ string, _ replication.Config) error {
+		return errors.New("versioning must be enabled")
+	}
+	err = addBucketReplicationRule(ctx, minClient, adminClient, "bucket", &models.AddBucketReplicationRule{
+		Priority:     swag.Int32(1),
+		Endpoint:     "http://minio.example.net:9000",
+		AccessKey:    "access",
+		SecretKey:    "secret",
+		TargetBucket: "target",
+	})
+	assert.Equal("versioning must be enabled", err.Error())
+	assert.Equal(testReplicationArn, removedArn)
+}
+
+func Test_deleteBucketReplicationRule(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	var removedArns []string
+	adminClient := AdminClientMock{minioRemoveRemoteTargetMock: func(_ context.Context, _, arn string) error {
+		removedArns = append(removedArns, arn)
+		return nil
+	}}
+	const otherArn = "arn:minio:replication::other:target"
+
+	cfg := replication.Config{}
+	assert.Nil(cfg.AddRule(replication.Options{ID: "a", Priority: "1", RuleStatus: "enable", DestBucket: testReplicationArn}))
+	assert.Nil(cfg.AddRule(replication.Options{ID: "b", Priority: "2", RuleStatus: "enable", DestBucket: testReplicationArn}))
+	assert.Nil(cfg.AddRule(replication.Options{ID: "c", Priority: "3", RuleStatus: "enable", DestBucket: otherArn}))
+	minClient.getBucketReplicationMock = func(_ context.Context, _ string) (replication.Config, error) {
+		return cfg, nil
+	}
+	var setCfg replication.Config
+	minClient.setBucketReplicationMock = func(_ context.Context, _ string, cfg replication.Config) error {
+		setCfg = cfg
+		return nil
+	}
+	// remote targets still used by another rule are kept
+	err := deleteBucketReplicationRule(ctx, minClient, adminClient, "bucket", "a", true)
+	assert.Nil(err)
+	assert.Equal([]string{"b", "c"}, []string{setCfg.Rules[0].ID, setCfg.Rules[1].ID})
+	assert.Empty(removedArns)
+
+	err = deleteBucketReplicationRule(ctx, minClient, adminClient, "bucket", "d", true)
+	assert.Equal(ErrNotFound, err)
+
+	// remote targets are kept unless their removal is asked for
+	minClient.getBucketReplicationMock = func(_ context.Context, _ string) (replication.Config, error) {
+		return cfg, nil
+	}
+	err = deleteBucketReplicationRule(ctx, minClient, adminClient, "bucket", "c", false)
+	assert.Nil(err)
+	assert.Equal([]string{"a", "b"}, []string{setCfg.Rules[0].ID, setCfg.Rules[1].ID})
+	assert.Empty(removedArns)
+
+	// the remote target goes away with the last rule replicating to it when asked to
+	err = deleteBucketReplicationRule(ctx, minClient, adminClient, "bucket", "c", true)
+	assert.Nil(err)
+	assert.Equal([]string{otherArn}, removedArns)
+
+	// failing to remove it is reported, the rule is deleted anyway
+	adminClient.minioRemoveRemoteTargetMock = func(_ context.Context, _, _ string) error {
+		return errors.New("target busy")
+	}
+	setCfg = replication.Config{}
+	err = deleteBucketReplicationRule(ctx, minClient, adminClient, "bucket", "c", true)
+	assert.ErrorContains(err, "target busy")
+	assert.Len(setCfg.Rules, 2)
+	adminClient.minioRemoveRemoteTargetMock = func(_ context.Context, _, arn string) error {
+		removedArns = append(removedArns, arn)
+		return nil
+	}
+
+	// removing the last rule removes the configuration and its remote target
+	last := replication.Config{}
+	assert.Nil(last.AddRule(replication.Options{ID: "b", Priority: "2", RuleStatus: "enable", DestBucket: testReplicationArn}))
+	minClient.getBucketReplicationMock = func(_ context.Context, _ string) (replication.Config, error) {
+		return last, nil
+	}
+	removed := false
+	minClient.removeBucketReplicationMock = func(_ context.Context, _ string) error {
+		removed = true
+		return nil
+	}
+	err = deleteBucketReplicationRule(ctx, minClient, adminClient, "bucket", "b", true)
+	assert.Nil(err)
+	assert.True(removed)
+	assert.Equal([]string{otherArn, testReplicationArn}, removedArns)
+}
+
+func Test_getBucketReplicationMetrics(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	adminClient := AdminClientMock{minioListRemoteTargetsMock: replicationTargetsMock}
+
+	minClient.getReplicationMetricsMock = func(_ context.Context, _ string) (replication.MetricsV2, error) {
+		return replication.MetricsV2{
+			Uptime: 60,
+			CurrentStats: replication.Metrics{
+				ReplicatedCount: 10,
+				ReplicatedSize:  1024,
+				PendingCount:    2,
+				PendingSize:     200,
+				Errors:          replication.TimedErrStats{Totals: replication.RStat{Count: 3, Bytes: 300}},
+				QStats:          replication.InQueueMetric{Curr: replication.QStat{Count: 1, Bytes: 100}},
+				Stats: map[string]replication.TargetMetrics{
+					testReplicationArn: {
+						ReplicatedCount: 10,
+						ReplicatedSize:  1024,
+						FailedCount:     4,
+						FailedSize:      400,
+					},
+				},
+			},
+		}, nil
+	}
+	metrics, err := getBucketReplicationMetrics(ctx, minClient, adminClient, "bucket")
+	assert.Nil(err)
+	assert.Equal(int64(60), metrics.Uptime)
+	assert.Equal(int64(10), metrics.ReplicatedCount)
+	assert.Equal(int64(2), metrics.PendingCount)
+	assert.Equal(int64(200), metrics.PendingBytes)
+	assert.Equal(int64(3), metrics.FailedCount)
+	assert.Equal(int64(300), metrics.FailedBytes)
+	assert.Equal(int64(1), metrics.QueuedCount)
+	assert.Len(metrics.Targets, 1)
+	assert.Equal("minio.example.net:9000", metrics.Targets[0].Endpoint)
+	// older MinIO releases only report the deprecated failed fields
+	assert.Equal(int64(4), metrics.Targets[0].FailedCount)
+	assert.Equal(int64(400), metrics.Targets[0].FailedBytes)
+
+	minClient.getReplicationMetricsMock = func(_ context.Context, _ string) (replication.MetricsV2, error) {
+		return replication.MetricsV2{}, errors.New("error")
+	}
+	_, err = getBucketReplicationMetrics(ctx, minClient, adminClient, "bucket")
+	assert.Equal("error", err.Error())
+}
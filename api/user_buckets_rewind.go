@@ -0,0 +1,227 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/minio/console/models"
+	mc "github.com/minio/mc/cmd"
+)
+
+const (
+	objectChangeCreated     = "created"
+	objectChangeOverwritten = "overwritten"
+	objectChangeDeleted     = "deleted"
+)
+
+type rewindDiffOpts struct {
+	BucketName string
+	Prefix     string
+	Start      time.Time
+	End        time.Time
+}
+
+// ObjectChange describes how an object changed between the start and the end of a rewind window,
+// the old and new versions are the ones that were current at each end of the window
+type ObjectChange struct {
+	Name            string `json:"name"`
+	Change          string `json:"change"`
+	OldVersionID    string `json:"old_version_id,omitempty"`
+	NewVersionID    string `json:"new_version_id,omitempty"`
+	OldSize         int64  `json:"old_size,omitempty"`
+	NewSize         int64  `json:"new_size,omitempty"`
+	OldLastModified string `json:"old_last_modified,omitempty"`
+	NewLastModified string `json:"new_last_modified,omitempty"`
+	// versions and delete markers written during the window
+	Versions int64 `json:"versions"`
+}
+
+// ObjectChangesSummary is sent once the whole prefix was compared
+type ObjectChangesSummary struct {
+	Objects     int64 `json:"objects"`
+	Created     int64 `json:"created"`
+	Overwritten int64 `json:"overwritten"`
+	Deleted     int64 `json:"deleted"`
+	Unchanged   int64 `json:"unchanged"`
+}
+
+func newRewindDiffOpts(request ObjectsRequest, now time.Time) (*rewindDiffOpts, error) {
+	if request.BucketName == "" {
+		return nil, ErrBucketNameNotInRequest
+	}
+	start, err := time.Parse(time.RFC3339, request.Date)
+	if err != nil {
+		return nil, err
+	}
+	end := now
+	if request.DateEnd != "" {
+		if end, err = time.Parse(time.RFC3339, request.DateEnd); err != nil {
+			return nil, err
+		}
+	}
+	if !end.After(start) {
+		return nil, ErrInvalidRewindWindow
+	}
+	return &rewindDiffOpts{
+		BucketName: request.BucketName,
+		Prefix:     request.Prefix,
+		Start:      start,
+		End:        end,
+	}, nil
+}
+
+// objectChange compares the current version of an object at the start and the end of the window,
+// it returns nil when the object didn't change
+func objectChange(name string, atStart, atEnd *mc.ClientContent, versions int64) *ObjectChange {
+	existedAtStart := atStart != nil && !atStart.IsDeleteMarker
+	existsAtEnd := atEnd != nil && !atEnd.IsDeleteMarker
+	change := &ObjectChange{Name: name, Versions: versions}
+	switch {
+	case !existedAtStart && existsAtEnd:
+		change.Change = objectChangeCreated
+	case existedAtStart && !existsAtEnd:
+		change.Change = objectChangeDeleted
+	case existedAtStart && atStart != atEnd:
+		change.Change = objectChangeOverwritten
+	default:
+		return nil
+	}
+	if existedAtStart {
+		change.OldVersionID = atStart.VersionID
+		change.OldSize = atStart.Size
+		change.OldLastModified = formatDiffTime(atStart.Time)
+	}
+	if atEnd != nil && atEnd != atStart {
+		// for deleted objects this is the delete marker
+		change.NewVersionID = atEnd.VersionID
+		change.NewSize = atEnd.Size
+		change.NewLastModified = formatDiffTime(atEnd.Time)
+	}
+	return change
+}
+
+// diffRewindWindow walks every version written before the end of the window, the listing returns the
+// versions of each object together and newest first, so the state of the object at both ends of the
+// window is known once the next object starts
+func diffRewindWindow(ctx context.Context, client MCClient, opts *rewindDiffOpts, fn func(ObjectChange) error) (*ObjectChangesSummary, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	summary := &ObjectChangesSummary{}
+	var (
+		name            string
+		atStart, atEnd  *mc.ClientContent
+		versionsWritten int64
+	)
+	flush := func() error {
+		if atEnd == nil {
+			return nil
+		}
+		summary.Objects++
+		change := objectChange(name, atStart, atEnd, versionsWritten)
+		if change == nil {
+			summary.Unchanged++
+			return nil
+		}
+		switch change.Change {
+		case objectChangeCreated:
+			summary.Created++
+		case objectChangeOverwritten:
+			summary.Overwritten++
+		case objectChangeDeleted:
+			summary.Deleted++
+		}
+		return fn(*change)
+	}
+
+	bucketPath := fmt.Sprintf("/%s/", opts.BucketName)
+	for content := range client.list(ctx, mc.ListOptions{TimeRef: opts.End, Recursive: true, WithOlderVersions: true, WithDeleteMarkers: true}) {
+		if content.Err != nil {
+			return nil, content.Err.ToGoError()
+		}
+		key := strings.Replace(content.URL.Path, bucketPath, "", 1)
+		if atEnd == nil || key != name {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			name, atStart, atEnd, versionsWritten = key, nil, content, 0
+		}
+		if content.Time.Before(opts.Start) {
+			if atStart == nil {
+				atStart = content
+			}
+			continue
+		}
+		versionsWritten++
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+// streamRewindDiff handles `rewind-diff` requests of the objectManager websocket
+func streamRewindDiff(ctx context.Context, session *models.Principal, clientIP string, request ObjectsRequest, send func(WSResponse)) {
+	const changesPerBatch = 1000
+	sendError := func(err error) {
+		send(WSResponse{
+			RequestID:  request.RequestID,
+			Error:      ErrorWithContext(ctx, err),
+			Prefix:     request.Prefix,
+			BucketName: request.BucketName,
+		})
+	}
+	opts, err := newRewindDiffOpts(request, time.Now())
+	if err != nil {
+		sendError(err)
+		return
+	}
+	s3Client, err := newS3BucketClient(session, opts.BucketName, opts.Prefix, clientIP)
+	if err != nil {
+		sendError(err)
+		return
+	}
+	// create a mc S3Client interface implementation
+	// defining the client to be used
+	mcS3C := mcClient{client: s3Client}
+
+	var buffer []ObjectChange
+	summary, err := diffRewindWindow(ctx, mcS3C, opts, func(change ObjectChange) error {
+		buffer = append(buffer, change)
+		if len(buffer) >= changesPerBatch {
+			send(WSResponse{RequestID: request.RequestID, Changes: buffer})
+			buffer = nil
+		}
+		return nil
+	})
+	if len(buffer) > 0 {
+		send(WSResponse{RequestID: request.RequestID, Changes: buffer})
+	}
+	if err != nil {
+		sendError(err)
+		return
+	}
+	send(WSResponse{RequestID: request.RequestID, ChangesSummary: summary})
+	send(WSResponse{RequestID: request.RequestID, RequestEnd: true})
+}
@@ -0,0 +1,105 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mc "github.com/minio/mc/cmd"
+	"github.com/minio/mc/pkg/probe"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_newRewindDiffOpts(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	opts, err := newRewindDiffOpts(ObjectsRequest{BucketName: "bucket", Prefix: "logs/", Date: "2024-02-01T00:00:00Z"}, now)
+	assert.Nil(err)
+	assert.Equal(now, opts.End)
+	assert.Equal("logs/", opts.Prefix)
+
+	_, err = newRewindDiffOpts(ObjectsRequest{BucketName: "bucket", Date: "2024-02-01T00:00:00Z", DateEnd: "2024-01-01T00:00:00Z"}, now)
+	assert.ErrorIs(err, ErrInvalidRewindWindow)
+	_, err = newRewindDiffOpts(ObjectsRequest{Date: "2024-02-01T00:00:00Z"}, now)
+	assert.ErrorIs(err, ErrBucketNameNotInRequest)
+	_, err = newRewindDiffOpts(ObjectsRequest{BucketName: "bucket", Date: "yesterday"}, now)
+	assert.NotNil(err)
+}
+
+func Test_diffRewindWindow(t *testing.T) {
+	assert := assert.New(t)
+	start := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC)
+	before, during := start.Add(-time.Hour), start.Add(time.Hour)
+	version := func(key, versionID string, modified time.Time, deleteMarker bool) *mc.ClientContent {
+		return &mc.ClientContent{
+			URL:            mc.ClientURL{Path: "/bucket/" + key},
+			VersionID:      versionID,
+			Time:           modified,
+			Size:           int64(len(versionID)),
+			IsDeleteMarker: deleteMarker,
+		}
+	}
+	// versions of each object newest first, as listed by mc
+	versions := []*mc.ClientContent{
+		version("logs/created", "c1", during, false),
+		version("logs/deleted", "d2", during, true),
+		version("logs/deleted", "d1", before, false),
+		version("logs/overwritten", "o3", during.Add(time.Minute), false),
+		version("logs/overwritten", "o2", during, false),
+		version("logs/overwritten", "o1", before, false),
+		version("logs/overwritten", "o0", before.Add(-time.Hour), false),
+		version("logs/transient", "t2", during.Add(time.Minute), true),
+		version("logs/transient", "t1", during, false),
+		version("logs/unchanged", "u1", before, false),
+	}
+	var listOpts mc.ListOptions
+	mcListMock = func(_ context.Context, opts mc.ListOptions) <-chan *mc.ClientContent {
+		listOpts = opts
+		ch := make(chan *mc.ClientContent)
+		go func() {
+			defer close(ch)
+			for _, v := range versions {
+				ch <- v
+			}
+		}()
+		return ch
+	}
+
+	opts := &rewindDiffOpts{BucketName: "bucket", Prefix: "logs/", Start: start, End: end}
+	var changes []ObjectChange
+	summary, err := diffRewindWindow(context.Background(), s3ClientMock{}, opts, func(change ObjectChange) error {
+		changes = append(changes, change)
+		return nil
+	})
+	assert.Nil(err)
+	assert.Equal(mc.ListOptions{TimeRef: end, Recursive: true, WithOlderVersions: true, WithDeleteMarkers: true}, listOpts)
+	assert.Equal(&ObjectChangesSummary{Objects: 5, Created: 1, Overwritten: 1, Deleted: 1, Unchanged: 2}, summary)
+	assert.Equal([]ObjectChange{
+		{Name: "logs/created", Change: objectChangeCreated, NewVersionID: "c1", NewSize: 2, NewLastModified: formatDiffTime(during), Versions: 1},
+		{Name: "logs/deleted", Change: objectChangeDeleted, OldVersionID: "d1", OldSize: 2, OldLastModified: formatDiffTime(before), NewVersionID: "d2", NewSize: 2, NewLastModified: formatDiffTime(during), Versions: 1},
+		{Name: "logs/overwritten", Change: objectChangeOverwritten, OldVersionID: "o1", OldSize: 2, OldLastModified: formatDiffTime(before), NewVersionID: "o3", NewSize: 2, NewLastModified: formatDiffTime(during.Add(time.Minute)), Versions: 2},
+	}, changes)
+
+	// listing errors stop the comparison
+	versions = []*mc.ClientContent{version("logs/created", "c1", during, false), {Err: probe.NewError(errors.New("access denied"))}}
+	_, err = diffRewindWindow(context.Background(), s3ClientMock{}, opts, func(_ ObjectChange) error { return nil })
+	assert.Equal("access denied", err.Error())
+}
@@ -0,0 +1,297 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/minio/console/api/operations"
+	bucketApi "github.com/minio/console/api/operations/bucket"
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+	"github.com/minio/minio-go/v7/pkg/sse"
+	"github.com/minio/minio-go/v7/pkg/tags"
+)
+
+const (
+	bucketEncryptionSSES3  = "sse-s3"
+	bucketEncryptionSSEKMS = "sse-kms"
+)
+
+// bucketSetup is the validated configuration applied to a new bucket
+type bucketSetup struct {
+	locking           bool
+	versioning        *models.SetBucketVersioning
+	retention         *models.PutBucketRetentionRequest
+	quota             int64
+	access            *models.BucketAccess
+	definition        string
+	encryption        *sse.Configuration
+	tags              *tags.Tags
+	lifecycle         *lifecycle.Configuration
+	confirmationToken string
+}
+
+func registerBucketTemplatesHandlers(api *operations.ConsoleAPI) {
+	// list bucket templates
+	api.BucketListBucketTemplatesHandler = bucketApi.ListBucketTemplatesHandlerFunc(func(params bucketApi.ListBucketTemplatesParams, _ *models.Principal) middleware.Responder {
+		templates, err := getListBucketTemplatesResponse(params)
+		if err != nil {
+			return bucketApi.NewListBucketTemplatesDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucketApi.NewListBucketTemplatesOK().WithPayload(templates)
+	})
+}
+
+// loadBucketTemplates reads the named templates from a JSON file, the file is read on every request
+// so templates can be changed without restarting the console
+func loadBucketTemplates(path string) ([]*models.BucketTemplate, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var templates []*models.BucketTemplate
+	if err := json.Unmarshal(data, &templates); err != nil {
+		return nil, fmt.Errorf("unable to parse bucket templates: %v", err)
+	}
+	for _, template := range templates {
+		if err := template.Validate(strfmt.Default); err != nil {
+			return nil, fmt.Errorf("invalid bucket template: %v", err)
+		}
+	}
+	return templates, nil
+}
+
+func findBucketTemplate(templates []*models.BucketTemplate, name string) (*models.BucketTemplate, error) {
+	for _, template := range templates {
+		if *template.Name == name {
+			return template, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrBucketTemplateNotFound, name)
+}
+
+// mergeBucketSettings applies the settings of the request on top of the template ones, tags are
+// merged and lifecycle rules are added to the template rules
+func mergeBucketSettings(base, override *models.BucketSettings) *models.BucketSettings {
+	merged := &models.BucketSettings{}
+	if base != nil {
+		*merged = *base
+	}
+	if override == nil {
+		return merged
+	}
+	merged.Locking = merged.Locking || override.Locking
+	if override.Versioning != nil {
+		merged.Versioning = override.Versioning
+	}
+	if override.Retention != nil {
+		merged.Retention = override.Retention
+	}
+	if override.Quota != 0 {
+		merged.Quota = override.Quota
+	}
+	if override.Access != nil {
+		merged.Access = override.Access
+		merged.Definition = override.Definition
+	}
+	if override.Encryption != nil {
+		merged.Encryption = override.Encryption
+	}
+	if len(override.Tags) > 0 {
+		bucketTags := map[string]string{}
+		for k, v := range merged.Tags {
+			bucketTags[k] = v
+		}
+		for k, v := range override.Tags {
+			bucketTags[k] = v
+		}
+		merged.Tags = bucketTags
+	}
+	merged.Lifecycle = append(append([]*models.LifecycleRuleRequest{}, merged.Lifecycle...), override.Lifecycle...)
+	return merged
+}
+
+// bucketEncryptionConfig converts the console encryption settings to a default encryption configuration
+func bucketEncryptionConfig(e *models.BucketEncryptionSettings) (*sse.Configuration, error) {
+	switch {
+	case e.Type == nil:
+		return nil, errors.New("encryption type is required")
+	case *e.Type == bucketEncryptionSSES3:
+		return sse.NewConfigurationSSES3(), nil
+	case *e.Type == bucketEncryptionSSEKMS && e.KmsKeyID != "":
+		return sse.NewConfigurationSSEKMS(e.KmsKeyID), nil
+	case *e.Type == bucketEncryptionSSEKMS:
+		return nil, errors.New("sse-kms encryption requires a KMS key ID")
+	}
+	return nil, fmt.Errorf("encryption type %s not supported", *e.Type)
+}
+
+// newBucketSetup validates the settings before the bucket is created so most mistakes don't
+// require a rollback
+func newBucketSetup(settings *models.BucketSettings) (*bucketSetup, error) {
+	invalid := func(format string, a ...interface{}) error {
+		return fmt.Errorf("%w: %s", ErrInvalidBucketSettings, fmt.Sprintf(format, a...))
+	}
+	setup := &bucketSetup{
+		// object locking is required to set a default retention
+		locking:    settings.Locking || settings.Retention != nil,
+		versioning: settings.Versioning,
+		retention:  settings.Retention,
+		quota:      settings.Quota,
+		access:     settings.Access,
+		definition: settings.Definition,
+	}
+	if setup.locking && setup.versioning != nil && !setup.versioning.Enabled {
+		return nil, invalid("versioning cannot be disabled on buckets with object locking")
+	}
+	if r := setup.retention; r != nil {
+		if r.Mode == nil || r.Unit == nil || r.Validity == nil {
+			return nil, invalid("retention requires mode, unit and validity")
+		}
+		if err := validateBucketRetentionConfig(*r.Unit, *r.Validity); err != nil {
+			return nil, invalid("%v", err)
+		}
+		setup.confirmationToken = r.ConfirmationToken
+	}
+	if setup.quota < 0 {
+		return nil, invalid("%v", ErrInvalidBucketQuota)
+	}
+	if setup.access != nil {
+		switch *setup.access {
+		case models.BucketAccessPRIVATE, models.BucketAccessPUBLIC:
+		case models.BucketAccessCUSTOM:
+			if setup.definition == "" {
+				return nil, invalid("CUSTOM access requires a policy definition")
+			}
+		default:
+			return nil, invalid("access %s not supported", *setup.access)
+		}
+	}
+	if settings.Encryption != nil {
+		encryption, err := bucketEncryptionConfig(settings.Encryption)
+		if err != nil {
+			return nil, invalid("%v", err)
+		}
+		setup.encryption = encryption
+	}
+	if len(settings.Tags) > 0 {
+		bucketTags, err := tags.NewTags(settings.Tags, false)
+		if err != nil {
+			return nil, invalid("%v", err)
+		}
+		setup.tags = bucketTags
+	}
+	if len(settings.Lifecycle) > 0 {
+		setup.lifecycle = lifecycle.NewConfiguration()
+		for _, req := range settings.Lifecycle {
+			if req == nil {
+				continue
+			}
+			id := req.ID
+			if id == "" {
+				id = uuid.NewString()
+			}
+			rule, err := lifecycleRuleFromRequest(id, req)
+			if err != nil {
+				return nil, invalid("%v", err)
+			}
+			if err := validateLifecycleRule(rule); err != nil {
+				return nil, invalid("%v", err)
+			}
+			setup.lifecycle.Rules = append(setup.lifecycle.Rules, rule)
+		}
+	}
+	return setup, nil
+}
+
+// configureBucket applies every setting to a bucket that was just created, the name of the step
+// that failed is returned along with the error
+func configureBucket(ctx context.Context, client MinioClient, mcClient MCClient, adminClient MinioAdmin, bucketName string, setup *bucketSetup) (string, error) {
+	// buckets with object locking are always versioned, folders and prefixes can still be excluded
+	if v := setup.versioning; v != nil && v.Enabled && (!setup.locking || len(v.ExcludePrefixes) > 0 || v.ExcludeFolders) {
+		if err := doSetVersioning(ctx, mcClient, VersionEnable, v.ExcludePrefixes, v.ExcludeFolders); err != nil {
+			return "versioning", err
+		}
+	}
+	if r := setup.retention; r != nil {
+		if err := setBucketRetentionConfig(ctx, client, bucketName, *r.Mode, *r.Unit, *r.Validity); err != nil {
+			return "retention", err
+		}
+	}
+	if setup.quota > 0 {
+		if err := setBucketQuota(ctx, adminClient, bucketName, setup.quota); err != nil {
+			return "quota", err
+		}
+	}
+	if setup.access != nil {
+		if err := setBucketAccessPolicy(ctx, client, bucketName, *setup.access, setup.definition); err != nil {
+			return "access policy", err
+		}
+	}
+	if setup.encryption != nil {
+		if err := client.setBucketEncryption(ctx, bucketName, setup.encryption); err != nil {
+			return "encryption", err
+		}
+	}
+	if setup.tags != nil {
+		if err := client.SetBucketTagging(ctx, bucketName, setup.tags); err != nil {
+			return "tags", err
+		}
+	}
+	if setup.lifecycle != nil {
+		if err := setValidatedBucketLifecycle(ctx, client, adminClient, bucketName, setup.lifecycle); err != nil {
+			return "lifecycle", err
+		}
+	}
+	return "", nil
+}
+
+// createConfiguredBucket creates the bucket and applies its settings, the bucket is removed when
+// any of the settings can't be applied so it is never left half configured
+func createConfiguredBucket(ctx context.Context, client MinioClient, mcClient MCClient, adminClient MinioAdmin, bucketName string, setup *bucketSetup) error {
+	if err := makeBucket(ctx, client, bucketName, setup.locking); err != nil {
+		return err
+	}
+	step, err := configureBucket(ctx, client, mcClient, adminClient, bucketName, setup)
+	if err == nil {
+		return nil
+	}
+	if rerr := removeBucket(client, bucketName); rerr != nil {
+		ErrorWithContext(ctx, fmt.Errorf("error removing bucket %s after a failed creation: %v", bucketName, rerr))
+		return fmt.Errorf("unable to set bucket %s, the bucket could not be removed: %w", step, err)
+	}
+	return fmt.Errorf("unable to set bucket %s, bucket creation was rolled back: %w", step, err)
+}
+
+func getListBucketTemplatesResponse(params bucketApi.ListBucketTemplatesParams) (*models.BucketTemplateList, *CodedAPIError) {
+	templates, err := loadBucketTemplates(getBucketTemplatesFile())
+	if err != nil {
+		return nil, ErrorWithContext(params.HTTPRequest.Context(), err)
+	}
+	return &models.BucketTemplateList{Templates: templates}, nil
+}
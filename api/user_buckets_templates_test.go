@@ -0,0 +1,178 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/sse"
+	"github.com/minio/minio-go/v7/pkg/tags"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_loadBucketTemplates(t *testing.T) {
+	assert := assert.New(t)
+	templates, err := loadBucketTemplates("")
+	assert.Nil(err)
+	assert.Empty(templates)
+
+	path := filepath.Join(t.TempDir(), "templates.json")
+	assert.Nil(os.WriteFile(path, []byte(`[{"name":"audit","settings":{"locking":true,"quota":1024,"tags":{"team":"audit"}}}]`), 0o600))
+	templates, err = loadBucketTemplates(path)
+	assert.Nil(err)
+	template, err := findBucketTemplate(templates, "audit")
+	assert.Nil(err)
+	assert.True(template.Settings.Locking)
+	assert.Equal(int64(1024), template.Settings.Quota)
+	_, err = findBucketTemplate(templates, "missing")
+	assert.ErrorIs(err, ErrBucketTemplateNotFound)
+
+	assert.Nil(os.WriteFile(path, []byte(`[{"description":"no name"}]`), 0o600))
+	_, err = loadBucketTemplates(path)
+	assert.NotNil(err)
+}
+
+func Test_mergeBucketSettings(t *testing.T) {
+	assert := assert.New(t)
+	private := models.BucketAccessPRIVATE
+	public := models.BucketAccessPUBLIC
+	template := &models.BucketSettings{
+		Locking:   true,
+		Quota:     1024,
+		Access:    &private,
+		Tags:      map[string]string{"team": "audit", "env": "prod"},
+		Lifecycle: []*models.LifecycleRuleRequest{{ID: "expire"}},
+	}
+	merged := mergeBucketSettings(template, &models.BucketSettings{
+		Access:    &public,
+		Tags:      map[string]string{"env": "dev"},
+		Lifecycle: []*models.LifecycleRuleRequest{{ID: "transition"}},
+	})
+	assert.True(merged.Locking)
+	assert.Equal(int64(1024), merged.Quota)
+	assert.Equal(&public, merged.Access)
+	assert.Equal(map[string]string{"team": "audit", "env": "dev"}, merged.Tags)
+	assert.Len(merged.Lifecycle, 2)
+	// the template is left untouched
+	assert.Equal("prod", template.Tags["env"])
+	assert.Len(template.Lifecycle, 1)
+
+	assert.Equal(&models.BucketSettings{}, mergeBucketSettings(nil, nil))
+}
+
+func Test_newBucketSetup(t *testing.T) {
+	assert := assert.New(t)
+	governance := models.ObjectRetentionModeGovernance
+	days := models.ObjectRetentionUnitDays
+	setup, err := newBucketSetup(&models.BucketSettings{
+		Retention:  &models.PutBucketRetentionRequest{Mode: &governance, Unit: &days, Validity: swag.Int32(30)},
+		Encryption: &models.BucketEncryptionSettings{Type: swag.String(bucketEncryptionSSEKMS), KmsKeyID: "key"},
+		Tags:       map[string]string{"team": "audit"},
+	})
+	assert.Nil(err)
+	// retention requires object locking
+	assert.True(setup.locking)
+	assert.Equal(sse.NewConfigurationSSEKMS("key"), setup.encryption)
+	assert.Equal("audit", setup.tags.ToMap()["team"])
+
+	custom := models.BucketAccessCUSTOM
+	for _, settings := range []*models.BucketSettings{
+		{Locking: true, Versioning: &models.SetBucketVersioning{Enabled: false}},
+		{Retention: &models.PutBucketRetentionRequest{Mode: &governance, Unit: &days, Validity: swag.Int32(0)}},
+		{Quota: -1},
+		{Access: &custom},
+		{Encryption: &models.BucketEncryptionSettings{Type: swag.String(bucketEncryptionSSEKMS)}},
+		{Encryption: &models.BucketEncryptionSettings{Type: swag.String("sse-c")}},
+		{Lifecycle: []*models.LifecycleRuleRequest{{ID: "empty"}}},
+	} {
+		_, err := newBucketSetup(settings)
+		assert.ErrorIs(err, ErrInvalidBucketSettings)
+	}
+}
+
+func Test_createConfiguredBucket(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	var steps []string
+	client := minioClientMock{
+		makeBucketWithContextMock: func(_ context.Context, _, _ string, objectLock bool) error {
+			steps = append(steps, "make")
+			assert.True(objectLock)
+			return nil
+		},
+		setBucketPolicyWithContextMock: func(_ context.Context, _, _ string) error {
+			steps = append(steps, "policy")
+			return nil
+		},
+		setBucketEncryptionMock: func(_ context.Context, _ string, _ *sse.Configuration) error {
+			steps = append(steps, "encryption")
+			return nil
+		},
+		setBucketTaggingMock: func(_ context.Context, _ string, _ *tags.Tags) error {
+			steps = append(steps, "tags")
+			return errors.New("access denied")
+		},
+		setObjectLockConfigMock: func(_ context.Context, _ string, _ *minio.RetentionMode, _ *uint, _ *minio.ValidityUnit) error {
+			steps = append(steps, "retention")
+			return nil
+		},
+		removeBucketMock: func(_ string) error {
+			steps = append(steps, "remove")
+			return nil
+		},
+	}
+	adminClient := AdminClientMock{
+		minioSetBucketQuotaMock: func(_ context.Context, _ string, _ *madmin.BucketQuota) error {
+			steps = append(steps, "quota")
+			return nil
+		},
+	}
+	public := models.BucketAccessPUBLIC
+	governance := models.ObjectRetentionModeGovernance
+	days := models.ObjectRetentionUnitDays
+	setup, err := newBucketSetup(&models.BucketSettings{
+		// versioning is implied by object locking
+		Versioning: &models.SetBucketVersioning{Enabled: true},
+		Retention:  &models.PutBucketRetentionRequest{Mode: &governance, Unit: &days, Validity: swag.Int32(30)},
+		Quota:      1024,
+		Access:     &public,
+		Encryption: &models.BucketEncryptionSettings{Type: swag.String(bucketEncryptionSSES3)},
+		Tags:       map[string]string{"team": "audit"},
+	})
+	assert.Nil(err)
+
+	// the bucket is removed when a setting can't be applied
+	err = createConfiguredBucket(ctx, client, s3ClientMock{}, adminClient, "bucket", setup)
+	assert.Equal("unable to set bucket tags, bucket creation was rolled back: access denied", err.Error())
+	assert.Equal([]string{"make", "retention", "quota", "policy", "encryption", "tags", "remove"}, steps)
+
+	steps = nil
+	client.setBucketTaggingMock = func(_ context.Context, _ string, _ *tags.Tags) error {
+		steps = append(steps, "tags")
+		return nil
+	}
+	assert.Nil(createConfiguredBucket(ctx, client, s3ClientMock{}, adminClient, "bucket", setup))
+	assert.Equal([]string{"make", "retention", "quota", "policy", "encryption", "tags"}, steps)
+}
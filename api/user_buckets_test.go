@@ -35,6 +35,9 @@ import (
 	"github.com/minio/madmin-go/v3"
 	"github.com/minio/mc/pkg/probe"
 	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/cors"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+	"github.com/minio/minio-go/v7/pkg/replication"
 	"github.com/minio/minio-go/v7/pkg/sse"
 	"github.com/minio/minio-go/v7/pkg/tags"
 	"github.com/stretchr/testify/assert"
@@ -64,6 +67,8 @@ func (c s3ClientMock) setVersioning(ctx context.Context, state string, excludePr
 // Define a mock struct of minio Client interface implementation
 type minioClientMock struct {
 	getBucketNotificationMock      func(ctx context.Context, bucketName string) (bucketNotification notification.Configuration, err error)
+	setBucketNotificationMock      func(ctx context.Context, bucketName string, config notification.Configuration) error
+	getBucketVersioningMock        func(ctx context.Context, bucketName string) (minio.BucketVersioningConfiguration, error)
 	listBucketsWithContextMock     func(ctx context.Context) ([]minio.BucketInfo, error)
 	makeBucketWithContextMock      func(ctx context.Context, bucketName, location string, objectLock bool) error
 	setBucketPolicyWithContextMock func(ctx context.Context, bucketName, policy string) error
@@ -76,8 +81,21 @@ type minioClientMock struct {
 	getBucketObjectLockConfigMock  func(ctx context.Context, bucketName string) (mode *minio.RetentionMode, validity *uint, unit *minio.ValidityUnit, err error)
 	getObjectLockConfigMock        func(ctx context.Context, bucketName string) (lock string, mode *minio.RetentionMode, validity *uint, unit *minio.ValidityUnit, err error)
 	copyObjectMock                 func(ctx context.Context, dst minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error)
+	composeObjectMock              func(ctx context.Context, dst minio.CopyDestOptions, srcs ...minio.CopySrcOptions) (minio.UploadInfo, error)
+	getBucketTaggingMock           func(ctx context.Context, bucketName string) (*tags.Tags, error)
 	setBucketTaggingMock           func(ctx context.Context, bucketName string, tags *tags.Tags) error
 	removeBucketTaggingMock        func(ctx context.Context, bucketName string) error
+	getLifecycleRulesMock          func(ctx context.Context, bucketName string) (lifecycle *lifecycle.Configuration, err error)
+	setBucketLifecycleMock         func(ctx context.Context, bucketName string, config *lifecycle.Configuration) error
+	getBucketReplicationMock       func(ctx context.Context, bucketName string) (replication.Config, error)
+	setBucketReplicationMock       func(ctx context.Context, bucketName string, cfg replication.Config) error
+	removeBucketReplicationMock    func(ctx context.Context, bucketName string) error
+	getReplicationMetricsMock      func(ctx context.Context, bucketName string) (replication.MetricsV2, error)
+	getBucketCorsMock              func(ctx context.Context, bucketName string) (*cors.Config, error)
+	setBucketCorsMock              func(ctx context.Context, bucketName string, corsConfig *cors.Config) error
+	listIncompleteUploadsMock      func(ctx context.Context, bucketName, prefix string, recursive bool) <-chan minio.ObjectMultipartInfo
+	listObjectPartsMock            func(ctx context.Context, bucketName, objectName, uploadID string, partNumberMarker, maxParts int) (minio.ListObjectPartsResult, error)
+	abortMultipartUploadMock       func(ctx context.Context, bucketName, objectName, uploadID string) error
 }
 
 // mock function of getBucketNotification()
@@ -85,6 +103,16 @@ func (mc minioClientMock) getBucketNotification(ctx context.Context, bucketName
 	return mc.getBucketNotificationMock(ctx, bucketName)
 }
 
+// mock function of setBucketNotification()
+func (mc minioClientMock) setBucketNotification(ctx context.Context, bucketName string, config notification.Configuration) error {
+	return mc.setBucketNotificationMock(ctx, bucketName, config)
+}
+
+// mock function of getBucketVersioning()
+func (mc minioClientMock) getBucketVersioning(ctx context.Context, bucketName string) (minio.BucketVersioningConfiguration, error) {
+	return mc.getBucketVersioningMock(ctx, bucketName)
+}
+
 // mock function of listBucketsWithContext()
 func (mc minioClientMock) listBucketsWithContext(ctx context.Context) ([]minio.BucketInfo, error) {
 	return mc.listBucketsWithContextMock(ctx)
@@ -138,7 +166,14 @@ func (mc minioClientMock) copyObject(ctx context.Context, dst minio.CopyDestOpti
 	return mc.copyObjectMock(ctx, dst, src)
 }
 
+func (mc minioClientMock) composeObject(ctx context.Context, dst minio.CopyDestOptions, srcs ...minio.CopySrcOptions) (minio.UploadInfo, error) {
+	return mc.composeObjectMock(ctx, dst, srcs...)
+}
+
 func (mc minioClientMock) GetBucketTagging(ctx context.Context, bucketName string) (*tags.Tags, error) {
+	if mc.getBucketTaggingMock != nil {
+		return mc.getBucketTaggingMock(ctx, bucketName)
+	}
 	return minioGetBucketTaggingMock(ctx, bucketName)
 }
 
@@ -150,6 +185,50 @@ func (mc minioClientMock) RemoveBucketTagging(ctx context.Context, bucketName st
 	return mc.removeBucketTaggingMock(ctx, bucketName)
 }
 
+func (mc minioClientMock) getLifecycleRules(ctx context.Context, bucketName string) (lifecycle *lifecycle.Configuration, err error) {
+	return mc.getLifecycleRulesMock(ctx, bucketName)
+}
+
+func (mc minioClientMock) setBucketLifecycle(ctx context.Context, bucketName string, config *lifecycle.Configuration) error {
+	return mc.setBucketLifecycleMock(ctx, bucketName, config)
+}
+
+func (mc minioClientMock) getBucketReplication(ctx context.Context, bucketName string) (replication.Config, error) {
+	return mc.getBucketReplicationMock(ctx, bucketName)
+}
+
+func (mc minioClientMock) setBucketReplication(ctx context.Context, bucketName string, cfg replication.Config) error {
+	return mc.setBucketReplicationMock(ctx, bucketName, cfg)
+}
+
+func (mc minioClientMock) removeBucketReplication(ctx context.Context, bucketName string) error {
+	return mc.removeBucketReplicationMock(ctx, bucketName)
+}
+
+func (mc minioClientMock) getBucketReplicationMetrics(ctx context.Context, bucketName string) (replication.MetricsV2, error) {
+	return mc.getReplicationMetricsMock(ctx, bucketName)
+}
+
+func (mc minioClientMock) getBucketCors(ctx context.Context, bucketName string) (*cors.Config, error) {
+	return mc.getBucketCorsMock(ctx, bucketName)
+}
+
+func (mc minioClientMock) setBucketCors(ctx context.Context, bucketName string, corsConfig *cors.Config) error {
+	return mc.setBucketCorsMock(ctx, bucketName, corsConfig)
+}
+
+func (mc minioClientMock) listIncompleteUploads(ctx context.Context, bucketName, prefix string, recursive bool) <-chan minio.ObjectMultipartInfo {
+	return mc.listIncompleteUploadsMock(ctx, bucketName, prefix, recursive)
+}
+
+func (mc minioClientMock) listObjectParts(ctx context.Context, bucketName, objectName, uploadID string, partNumberMarker, maxParts int) (minio.ListObjectPartsResult, error) {
+	return mc.listObjectPartsMock(ctx, bucketName, objectName, uploadID, partNumberMarker, maxParts)
+}
+
+func (mc minioClientMock) abortMultipartUpload(ctx context.Context, bucketName, objectName, uploadID string) error {
+	return mc.abortMultipartUploadMock(ctx, bucketName, objectName, uploadID)
+}
+
 func minioGetBucketTaggingMock(ctx context.Context, bucketName string) (*tags.Tags, error) {
 	fmt.Println(ctx)
 	fmt.Println(bucketName)
@@ -537,6 +616,64 @@ func Test_GetBucketRetentionConfig(t *testing.T) {
 	}
 }
 
+func Test_SetBucketRetentionConfig(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	var gotMode *minio.RetentionMode
+	var gotValidity *uint
+	var gotUnit *minio.ValidityUnit
+	minClient.setObjectLockConfigMock = func(_ context.Context, _ string, mode *minio.RetentionMode, validity *uint, unit *minio.ValidityUnit) error {
+		gotMode, gotValidity, gotUnit = mode, validity, unit
+		return nil
+	}
+	err := setBucketRetentionConfig(ctx, minClient, "test", models.ObjectRetentionModeCompliance, models.ObjectRetentionUnitYears, 3)
+	assert.Nil(err)
+	assert.Equal(minio.Compliance, *gotMode)
+	assert.Equal(uint(3), *gotValidity)
+	assert.Equal(minio.Years, *gotUnit)
+
+	minClient.setObjectLockConfigMock = func(_ context.Context, _ string, _ *minio.RetentionMode, _ *uint, _ *minio.ValidityUnit) error {
+		return errors.New("error func")
+	}
+	err = setBucketRetentionConfig(ctx, minClient, "test", models.ObjectRetentionModeGovernance, models.ObjectRetentionUnitDays, 3)
+	assert.Equal("error func", err.Error())
+
+	err = setBucketRetentionConfig(ctx, minClient, "test", models.ObjectRetentionMode("other"), models.ObjectRetentionUnitDays, 3)
+	assert.Equal("invalid retention mode", err.Error())
+}
+
+func Test_validateBucketRetentionConfig(t *testing.T) {
+	assert := assert.New(t)
+	assert.Nil(validateBucketRetentionConfig(models.ObjectRetentionUnitDays, 30))
+	assert.Nil(validateBucketRetentionConfig(models.ObjectRetentionUnitYears, 100))
+	assert.NotNil(validateBucketRetentionConfig(models.ObjectRetentionUnitDays, 0))
+	assert.NotNil(validateBucketRetentionConfig(models.ObjectRetentionUnitDays, 36501))
+	assert.NotNil(validateBucketRetentionConfig(models.ObjectRetentionUnitYears, 101))
+	assert.NotNil(validateBucketRetentionConfig(models.ObjectRetentionUnit("weeks"), 1))
+}
+
+func Test_complianceConfirmationToken(t *testing.T) {
+	assert := assert.New(t)
+	session := &models.Principal{STSAccessKeyID: "access", STSSecretAccessKey: "secret"}
+	now := time.Now()
+	confirmationToken := complianceConfirmationToken(session, "test", models.ObjectRetentionUnitDays, 30, now.Add(complianceConfirmationTTL).Unix())
+
+	assert.True(validComplianceConfirmationToken(session, "test", models.ObjectRetentionUnitDays, 30, confirmationToken, now))
+	// token is bound to the bucket and the requested settings
+	assert.False(validComplianceConfirmationToken(session, "other", models.ObjectRetentionUnitDays, 30, confirmationToken, now))
+	assert.False(validComplianceConfirmationToken(session, "test", models.ObjectRetentionUnitYears, 30, confirmationToken, now))
+	assert.False(validComplianceConfirmationToken(session, "test", models.ObjectRetentionUnitDays, 31, confirmationToken, now))
+	// token is bound to the session
+	otherSession := &models.Principal{STSAccessKeyID: "access", STSSecretAccessKey: "other"}
+	assert.False(validComplianceConfirmationToken(otherSession, "test", models.ObjectRetentionUnitDays, 30, confirmationToken, now))
+	// token expires
+	assert.False(validComplianceConfirmationToken(session, "test", models.ObjectRetentionUnitDays, 30, confirmationToken, now.Add(2*complianceConfirmationTTL)))
+	// malformed tokens
+	assert.False(validComplianceConfirmationToken(session, "test", models.ObjectRetentionUnitDays, 30, "", now))
+	assert.False(validComplianceConfirmationToken(session, "test", models.ObjectRetentionUnitDays, 30, "abc.def", now))
+}
+
 func Test_SetBucketVersioning(t *testing.T) {
 	assert := assert.New(t)
 	ctx := context.WithValue(context.Background(), utils.ContextClientIP, "127.0.0.1")
@@ -0,0 +1,203 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/api/operations"
+	bucketApi "github.com/minio/console/api/operations/bucket"
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+)
+
+func registerBucketsUploadsHandlers(api *operations.ConsoleAPI) {
+	// list incomplete uploads
+	api.BucketListIncompleteUploadsHandler = bucketApi.ListIncompleteUploadsHandlerFunc(func(params bucketApi.ListIncompleteUploadsParams, session *models.Principal) middleware.Responder {
+		resp, err := getListIncompleteUploadsResponse(session, params)
+		if err != nil {
+			return bucketApi.NewListIncompleteUploadsDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucketApi.NewListIncompleteUploadsOK().WithPayload(resp)
+	})
+	// abort a single incomplete upload
+	api.BucketAbortIncompleteUploadHandler = bucketApi.AbortIncompleteUploadHandlerFunc(func(params bucketApi.AbortIncompleteUploadParams, session *models.Principal) middleware.Responder {
+		if err := getAbortIncompleteUploadResponse(session, params); err != nil {
+			return bucketApi.NewAbortIncompleteUploadDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucketApi.NewAbortIncompleteUploadNoContent()
+	})
+	// abort incomplete uploads by age
+	api.BucketAbortIncompleteUploadsHandler = bucketApi.AbortIncompleteUploadsHandlerFunc(func(params bucketApi.AbortIncompleteUploadsParams, session *models.Principal) middleware.Responder {
+		resp, err := getAbortIncompleteUploadsResponse(session, params)
+		if err != nil {
+			return bucketApi.NewAbortIncompleteUploadsDefault(err.Code).WithPayload(err.APIError)
+		}
+		return bucketApi.NewAbortIncompleteUploadsOK().WithPayload(resp)
+	})
+}
+
+// isNoSuchUpload tells whether the upload was completed or aborted in the meantime
+func isNoSuchUpload(err error) bool {
+	return minio.ToErrorResponse(err).Code == "NoSuchUpload"
+}
+
+// uploadPartsSize returns the number of parts and the total size uploaded so far for an upload
+func uploadPartsSize(ctx context.Context, client MinioClient, bucketName, objectName, uploadID string) (int64, int64, error) {
+	var parts, size int64
+	partNumberMarker := 0
+	for {
+		result, err := client.listObjectParts(ctx, bucketName, objectName, uploadID, partNumberMarker, 0)
+		if err != nil {
+			return 0, 0, err
+		}
+		for _, part := range result.ObjectParts {
+			parts++
+			size += part.Size
+		}
+		if !result.IsTruncated {
+			return parts, size, nil
+		}
+		partNumberMarker = result.NextPartNumberMarker
+	}
+}
+
+// listIncompleteUploads lists every incomplete upload under the prefix with the size of its parts
+func listIncompleteUploads(ctx context.Context, client MinioClient, bucketName, prefix string) (*models.ListIncompleteUploadsResponse, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	resp := &models.ListIncompleteUploadsResponse{Uploads: []*models.IncompleteUpload{}}
+	for upload := range client.listIncompleteUploads(ctx, bucketName, prefix, true) {
+		if upload.Err != nil {
+			return nil, upload.Err
+		}
+		parts, size, err := uploadPartsSize(ctx, client, bucketName, upload.Key, upload.UploadID)
+		if err != nil {
+			if isNoSuchUpload(err) {
+				continue
+			}
+			return nil, err
+		}
+		resp.Uploads = append(resp.Uploads, &models.IncompleteUpload{
+			Key:       upload.Key,
+			UploadID:  upload.UploadID,
+			Initiated: upload.Initiated.Format(time.RFC3339),
+			Parts:     parts,
+			Size:      size,
+		})
+		resp.Total++
+		resp.TotalSize += size
+	}
+	return resp, nil
+}
+
+// abortIncompleteUploads aborts the uploads under the prefix initiated before the given age, uploads
+// of the same object that are more recent are left running
+func abortIncompleteUploads(ctx context.Context, client MinioClient, bucketName string, req *models.AbortIncompleteUploadsRequest, now time.Time) (*models.AbortIncompleteUploadsResponse, error) {
+	uploads, err := listIncompleteUploads(ctx, client, bucketName, req.Prefix)
+	if err != nil {
+		return nil, err
+	}
+	cutoff := now.Add(-time.Duration(*req.OlderThanHours) * time.Hour)
+	resp := &models.AbortIncompleteUploadsResponse{Aborted: []*models.IncompleteUpload{}, DryRun: req.DryRun}
+	for _, upload := range uploads.Uploads {
+		initiated, err := time.Parse(time.RFC3339, upload.Initiated)
+		if err != nil || !initiated.Before(cutoff) {
+			continue
+		}
+		if !req.DryRun {
+			if err := client.abortMultipartUpload(ctx, bucketName, upload.Key, upload.UploadID); err != nil {
+				if isNoSuchUpload(err) {
+					continue
+				}
+				return nil, fmt.Errorf("unable to abort upload %s of %s after aborting %d uploads: %w", upload.UploadID, upload.Key, len(resp.Aborted), err)
+			}
+		}
+		resp.Aborted = append(resp.Aborted, upload)
+		resp.FreedBytes += upload.Size
+	}
+	return resp, nil
+}
+
+func getListIncompleteUploadsResponse(session *models.Principal, params bucketApi.ListIncompleteUploadsParams) (*models.ListIncompleteUploadsResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	var prefix string
+	if params.Prefix != nil {
+		prefix = *params.Prefix
+	}
+	resp, err := listIncompleteUploads(ctx, minioClient, params.BucketName, prefix)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return resp, nil
+}
+
+func getAbortIncompleteUploadResponse(session *models.Principal, params bucketApi.AbortIncompleteUploadParams) *CodedAPIError {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	if err := minioClient.abortMultipartUpload(ctx, params.BucketName, params.Key, params.UploadID); err != nil {
+		if isNoSuchUpload(err) {
+			return ErrorWithContext(ctx, ErrNotFound)
+		}
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
+
+func getAbortIncompleteUploadsResponse(session *models.Principal, params bucketApi.AbortIncompleteUploadsParams) (*models.AbortIncompleteUploadsResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	// uploads that are still running must never be aborted
+	if *params.Body.OlderThanHours < 1 {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, errors.New("older_than_hours must be at least 1"))
+	}
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	resp, err := abortIncompleteUploads(ctx, minioClient, params.BucketName, params.Body, time.Now())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,93 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_abortIncompleteUploads(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	uploads := []minio.ObjectMultipartInfo{
+		{Key: "backups/db.tar", UploadID: "old", Initiated: now.Add(-72 * time.Hour)},
+		{Key: "backups/db.tar", UploadID: "running", Initiated: now.Add(-time.Hour)},
+		{Key: "backups/logs.tar", UploadID: "completed", Initiated: now.Add(-48 * time.Hour)},
+	}
+	var aborted []string
+	client := minioClientMock{
+		listIncompleteUploadsMock: func(_ context.Context, _, prefix string, recursive bool) <-chan minio.ObjectMultipartInfo {
+			assert.Equal("backups/", prefix)
+			assert.True(recursive)
+			ch := make(chan minio.ObjectMultipartInfo, len(uploads))
+			for _, upload := range uploads {
+				ch <- upload
+			}
+			close(ch)
+			return ch
+		},
+		listObjectPartsMock: func(_ context.Context, _, _, uploadID string, partNumberMarker, _ int) (minio.ListObjectPartsResult, error) {
+			switch {
+			case uploadID == "completed":
+				return minio.ListObjectPartsResult{}, minio.ErrorResponse{Code: "NoSuchUpload"}
+			case partNumberMarker == 0:
+				return minio.ListObjectPartsResult{ObjectParts: []minio.ObjectPart{{Size: 100}, {Size: 100}}, IsTruncated: true, NextPartNumberMarker: 2}, nil
+			default:
+				return minio.ListObjectPartsResult{ObjectParts: []minio.ObjectPart{{Size: 50}}}, nil
+			}
+		},
+		abortMultipartUploadMock: func(_ context.Context, _, objectName, uploadID string) error {
+			aborted = append(aborted, objectName+"@"+uploadID)
+			return nil
+		},
+	}
+
+	// uploads finished while listing are left out
+	list, err := listIncompleteUploads(ctx, client, "bucket", "backups/")
+	assert.Nil(err)
+	assert.Equal(int64(2), list.Total)
+	assert.Equal(int64(500), list.TotalSize)
+	assert.Equal(&models.IncompleteUpload{Key: "backups/db.tar", UploadID: "old", Initiated: "2024-02-27T12:00:00Z", Parts: 3, Size: 250}, list.Uploads[0])
+
+	req := &models.AbortIncompleteUploadsRequest{Prefix: "backups/", OlderThanHours: swag.Int32(24), DryRun: true}
+	resp, err := abortIncompleteUploads(ctx, client, "bucket", req, now)
+	assert.Nil(err)
+	assert.Len(resp.Aborted, 1)
+	assert.Equal(int64(250), resp.FreedBytes)
+	assert.Empty(aborted)
+
+	// the running upload of the same object isn't aborted
+	req.DryRun = false
+	_, err = abortIncompleteUploads(ctx, client, "bucket", req, now)
+	assert.Nil(err)
+	assert.Equal([]string{"backups/db.tar@old"}, aborted)
+
+	client.abortMultipartUploadMock = func(_ context.Context, _, _, _ string) error {
+		return errors.New("access denied")
+	}
+	_, err = abortIncompleteUploads(ctx, client, "bucket", req, now)
+	assert.Equal("unable to abort upload old of backups/db.tar after aborting 0 uploads: access denied", err.Error())
+}
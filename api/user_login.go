@@ -18,8 +18,15 @@ package api
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
@@ -27,27 +34,68 @@ import (
 	authApi "github.com/minio/console/api/operations/auth"
 	"github.com/minio/console/models"
 	"github.com/minio/console/pkg/auth"
+	"github.com/minio/console/pkg/auth/idp/oauth2"
+	"github.com/minio/console/pkg/auth/throttle"
 	"github.com/minio/madmin-go/v3"
 	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/minio/pkg/v3/env"
 	xnet "github.com/minio/pkg/v3/net"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// idpLoginTimeout is how long a user has to come back from the IDP once the login page was loaded
+	idpLoginTimeout = 30 * time.Minute
+	// idpLoginCookie holds the PKCE verifiers and nonces of the logins started on the IDPs
+	idpLoginCookie = "idp-login"
+	// idpLoginPurpose keeps the secrets of the logins started on the IDPs from being used as session tokens
+	idpLoginPurpose = "console idp login"
+	// ldapDetectionTTL is how long whether MinIO accepts LDAP logins is trusted, changes of the MinIO
+	// configuration are picked up after it
+	ldapDetectionTTL = 5 * time.Minute
+	// ldapDetectionBackoff is how long a failed detection waits before MinIO is asked again, so logins
+	// don't each wait on an unreachable MinIO
+	ldapDetectionBackoff = 10 * time.Second
 )
 
 func registerLoginHandlers(api *operations.ConsoleAPI) {
 	// GET login strategy
-	api.AuthLoginDetailHandler = authApi.LoginDetailHandlerFunc(func(_ authApi.LoginDetailParams) middleware.Responder {
-		loginDetails, err := getLoginDetailsResponse()
+	api.AuthLoginDetailHandler = authApi.LoginDetailHandlerFunc(func(params authApi.LoginDetailParams) middleware.Responder {
+		loginDetails, loginCookie, err := getLoginDetailsResponse(params, GlobalMinIOConfig.OpenIDProviders)
 		if err != nil {
 			return authApi.NewLoginDetailDefault(err.Code).WithPayload(err.APIError)
 		}
-		return authApi.NewLoginDetailOK().WithPayload(loginDetails)
+		if loginCookie == nil {
+			return authApi.NewLoginDetailOK().WithPayload(loginDetails)
+		}
+		// the browser keeps the secrets of the logins it may start on the IDPs until it comes back
+		return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+			http.SetCookie(w, loginCookie)
+			authApi.NewLoginDetailOK().WithPayload(loginDetails).WriteResponse(w, p)
+		})
 	})
 	// POST login using user credentials
 	api.AuthLoginHandler = authApi.LoginHandlerFunc(func(params authApi.LoginParams) middleware.Responder {
-		loginResponse, err := getLoginResponse(params)
+		// clients that failed too many times have to wait before trying again
+		attempt, retryAfter := reserveLoginAttempt(getLoginClientIP(params.HTTPRequest), strings.TrimSpace(params.Body.AccessKey))
+		if retryAfter > 0 {
+			err := ErrorWithContext(params.HTTPRequest.Context(), ErrTooManyLoginAttempts)
+			return authApi.NewLoginTooManyRequests().WithRetryAfter(retryAfter).WithPayload(err.APIError)
+		}
+		loginResponse, err := getLoginResponse(params, attempt)
 		if err != nil {
 			return authApi.NewLoginDefault(err.Code).WithPayload(err.APIError)
 		}
+		// the credentials are valid, the session is issued once the code of the second factor is entered
+		if loginResponse.SecondFactorToken != "" {
+			return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+				cookie := newSecondFactorCookie(loginResponse.SecondFactorToken)
+				http.SetCookie(w, &cookie)
+				authApi.NewLoginAccepted().WithPayload(&models.LoginSecondFactorResponse{
+					EnrollmentRequired: loginResponse.SecondFactorEnrollment,
+				}).WriteResponse(w, p)
+			})
+		}
 		// Custom response writer to set the session cookies
 		return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
 			cookie := NewSessionCookieForConsole(loginResponse.SessionID)
@@ -55,19 +103,91 @@ func registerLoginHandlers(api *operations.ConsoleAPI) {
 			authApi.NewLoginNoContent().WriteResponse(w, p)
 		})
 	})
+	// POST login using the authorization code of an external IDP
+	api.AuthLoginOauth2AuthHandler = authApi.LoginOauth2AuthHandlerFunc(func(params authApi.LoginOauth2AuthParams) middleware.Responder {
+		loginResponse, err := getLoginOauth2AuthResponse(params, GlobalMinIOConfig.OpenIDProviders)
+		if err != nil {
+			return authApi.NewLoginOauth2AuthDefault(err.Code).WithPayload(err.APIError)
+		}
+		// Custom response writer to set the session cookies
+		return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+			cookie := NewSessionCookieForConsole(loginResponse.SessionID)
+			http.SetCookie(w, &cookie)
+			// the refresh token lets the logout end the session on the IDP as well, and renew the session
+			refreshCookie := newIDPRefreshTokenCookie(loginResponse.IDPRefreshToken)
+			http.SetCookie(w, &refreshCookie)
+			loginCookie := expireIDPLoginCookie()
+			http.SetCookie(w, &loginCookie)
+			authApi.NewLoginOauth2AuthNoContent().WriteResponse(w, p)
+		})
+	})
 }
 
 // login performs a check of ConsoleCredentials against MinIO, generates some claims and returns the jwt
-// for subsequent authentication
-func login(credentials ConsoleCredentialsI, sessionFeatures *auth.SessionFeatures) (*string, error) {
+// for subsequent authentication, the session is registered with the client of the request
+func login(credentials ConsoleCredentialsI, sessionFeatures *auth.SessionFeatures, r *http.Request) (*string, error) {
+	session, err := authenticate(credentials, sessionFeatures)
+	if err != nil {
+		return nil, err
+	}
+	return issueSession(r, session)
+}
+
+// pendingSession is a login whose credentials were checked, the session isn't issued yet
+type pendingSession struct {
+	Credentials      credentials.Value     `json:"credentials"`
+	AccountAccessKey string                `json:"accountAccessKey"`
+	Features         *auth.SessionFeatures `json:"features,omitempty"`
+	Renewal          *auth.SessionRenewal  `json:"renewal,omitempty"`
+	Expires          time.Time             `json:"expires"`
+}
+
+// authenticate obtains the STS credentials of the session from MinIO, which checks the credentials of the login
+func authenticate(credentials ConsoleCredentialsI, sessionFeatures *auth.SessionFeatures) (*pendingSession, error) {
 	// try to obtain consoleCredentials,
 	tokens, err := credentials.Get()
 	if err != nil {
 		return nil, err
 	}
 
+	session := &pendingSession{
+		Credentials:      tokens,
+		AccountAccessKey: credentials.GetAccountAccessKey(),
+		Features:         sessionFeatures,
+		Expires:          tokens.Expiration,
+	}
+	// the credentials of the session are renewed until its maximum lifetime when it's set
+	if sr := credentials.GetSessionRenewal(); sr != nil && getSessionMaxLifetime() > 0 {
+		session.Renewal = &auth.SessionRenewal{
+			SecretKey:  sr.SecretKey,
+			IDPName:    sr.IDPName,
+			Expiration: time.Now().Add(getSessionMaxLifetime()),
+		}
+		session.Expires = session.Renewal.Expiration
+	}
+	return session, nil
+}
+
+// issueSession registers the session with the client of the request and returns its session token
+func issueSession(r *http.Request, session *pendingSession) (*string, error) {
+	renewal, expires := session.Renewal, session.Expires
+	var renewalSecretKey string
+	if renewal != nil && renewal.IDPName == "" {
+		// access and secret key logins are renewed with the secret key kept in the registry, the ones
+		// that passed through a second factor didn't carry it along and are not renewed
+		if renewal.SecretKey == "" || GlobalSessions == nil {
+			renewal, expires = nil, session.Credentials.Expiration
+		} else {
+			renewalSecretKey = renewal.SecretKey
+		}
+	}
+	sessionID, err := registerSession(r, session.Credentials, session.AccountAccessKey, renewalSecretKey, expires)
+	if err != nil {
+		LogError("error registering the session: %v", err)
+		return nil, ErrInvalidLogin
+	}
 	// if we made it here, the consoleCredentials work, generate a jwt with claims
-	token, err := auth.NewEncryptedTokenForClient(&tokens, credentials.GetAccountAccessKey(), sessionFeatures)
+	token, err := auth.NewEncryptedTokenForClient(&session.Credentials, session.AccountAccessKey, sessionID, session.Features, renewal)
 	if err != nil {
 		LogError("error authenticating user: %v", err)
 		return nil, ErrInvalidLogin
@@ -96,11 +216,110 @@ func getConsoleCredentials(accessKey, secretKey string, client *http.Client) (*C
 		CredContext: &credentials.CredContext{
 			Client: client,
 		},
+		Renewal: &auth.SessionRenewal{SecretKey: secretKey},
 	}, nil
 }
 
+// getLDAPConsoleCredentials returns the ConsoleCredentials of an LDAP user obtained through
+// AssumeRoleWithLDAPIdentity
+func getLDAPConsoleCredentials(username, password string, client *http.Client) (*ConsoleCredentials, error) {
+	creds, err := auth.GetCredentialsFromLDAP(client, getMinIOServer(), username, password)
+	if err != nil {
+		return nil, err
+	}
+	return &ConsoleCredentials{
+		ConsoleCredentials: creds,
+		AccountAccessKey:   username,
+		CredContext: &credentials.CredContext{
+			Client: client,
+		},
+	}, nil
+}
+
+// ldapDetection caches whether MinIO accepts LDAP logins, a single request asks MinIO at a time
+// and the others wait for its answer
+type ldapDetection struct {
+	group singleflight.Group
+
+	mu sync.Mutex
+	// enabled is the last answer of MinIO, kept when a later detection fails
+	enabled bool
+	expires time.Time
+}
+
+var globalLDAPDetection = &ldapDetection{}
+
+// get returns whether LDAP is enabled, detect is called once the cached answer expired
+func (d *ldapDetection) get(now time.Time, detect func() (bool, error)) bool {
+	d.mu.Lock()
+	enabled, expires := d.enabled, d.expires
+	d.mu.Unlock()
+	if now.Before(expires) {
+		return enabled
+	}
+	result, _, _ := d.group.Do("ldap", func() (interface{}, error) {
+		enabled, err := detect()
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		if err != nil {
+			LogError("unable to detect whether MinIO has LDAP enabled: %v", err)
+			d.expires = now.Add(ldapDetectionBackoff)
+			return d.enabled, nil
+		}
+		d.enabled, d.expires = enabled, now.Add(ldapDetectionTTL)
+		return enabled, nil
+	})
+	return result.(bool)
+}
+
+// isLDAPEnabled tells whether users sign in with their LDAP credentials
+func isLDAPEnabled(client *http.Client) bool {
+	return globalLDAPDetection.get(time.Now(), func() (bool, error) {
+		return auth.DetectLDAPIdentity(client, getMinIOServer())
+	})
+}
+
+// verifySTSCredentials asks MinIO whether STS credentials handed to the console are valid. Console didn't
+// obtain them itself, neither the keys nor the claims of the session token are trusted before MinIO
+// accepted them.
+func verifySTSCredentials(ctx context.Context, tokens credentials.Value, client *http.Client) error {
+	adminClient, err := madmin.NewWithOptions(getMinIOEndpoint(), &madmin.Options{
+		Creds:  credentials.NewStaticV4(tokens.AccessKeyID, tokens.SecretAccessKey, tokens.SessionToken),
+		Secure: getMinIOEndpointIsSecure(),
+	})
+	if err != nil {
+		return err
+	}
+	adminClient.SetCustomTransport(client.Transport)
+	// every user is allowed to read its own account info
+	_, err = AdminClient{Client: adminClient}.AccountInfo(ctx)
+	return err
+}
+
+// authenticateSTS checks STS credentials handed to the console with MinIO. Logins with STS credentials
+// can't have a second factor, they are refused when the user they were issued to requires one or has one.
+func authenticateSTS(ctx context.Context, accessKey, secretKey, sts string, features *auth.SessionFeatures, client *http.Client) (*pendingSession, error) {
+	session, err := authenticate(&ConsoleCredentials{
+		ConsoleCredentials: credentials.NewStaticV4(accessKey, secretKey, sts),
+		AccountAccessKey:   accessKey,
+		CredContext: &credentials.CredContext{
+			Client: client,
+		},
+	}, features)
+	if err != nil {
+		return nil, err
+	}
+	if err = verifySTSCredentials(ctx, session.Credentials, client); err != nil {
+		return nil, err
+	}
+	if _, _, err = secondFactorForLogin(ctx, session.AccountAccessKey, &session.Credentials); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
 // getLoginResponse performs login() and serializes it to the handler's output
-func getLoginResponse(params authApi.LoginParams) (*models.LoginResponse, *CodedAPIError) {
+func getLoginResponse(params authApi.LoginParams, attempt *throttle.Attempt) (*models.LoginResponse, *CodedAPIError) {
 	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
 	defer cancel()
 	lr := params.Body
@@ -113,15 +332,47 @@ func getLoginResponse(params authApi.LoginParams) (*models.LoginResponse, *Coded
 	client := GetConsoleHTTPClient(clientIP)
 
 	var err error
-	var consoleCreds *ConsoleCredentials
+	// the outcome of logins waiting for their second factor is recorded once the code is checked
+	var secondFactorPending bool
+	// failed logins slow down and eventually lock out the client and the access key
+	defer func() {
+		if secondFactorPending {
+			attempt.Cancel()
+			return
+		}
+		recordLoginAttempt(ctx, params.HTTPRequest, getLoginClientIP(params.HTTPRequest), attempt, err)
+	}()
+	sf := &auth.SessionFeatures{}
+	if lr.Features != nil {
+		sf.HideMenu = lr.Features.HideMenu
+	}
 	// if we receive an STS we use that instead of the credentials
 	if lr.Sts != "" {
-		consoleCreds = &ConsoleCredentials{
-			ConsoleCredentials: credentials.NewStaticV4(lr.AccessKey, lr.SecretKey, lr.Sts),
-			AccountAccessKey:   lr.AccessKey,
-			CredContext: &credentials.CredContext{
-				Client: client,
-			},
+		var session *pendingSession
+		if session, err = authenticateSTS(ctx, lr.AccessKey, lr.SecretKey, lr.Sts, sf, client); err != nil {
+			if errors.Is(err, ErrSecondFactorRequired) {
+				// the credentials were right, the login isn't a failure of the client
+				attempt.Cancel()
+				return nil, ErrorWithContext(ctx, err)
+			}
+			if xnet.IsNetworkOrHostDown(err, true) {
+				return nil, ErrorWithContext(ctx, ErrNetworkError)
+			}
+			return nil, ErrorWithContext(ctx, err, ErrInvalidLogin)
+		}
+		var sessionID *string
+		if sessionID, err = issueSession(params.HTTPRequest, session); err != nil {
+			return nil, ErrorWithContext(ctx, err, ErrInvalidLogin)
+		}
+		return &models.LoginResponse{SessionID: *sessionID}, nil
+	}
+
+	var consoleCreds *ConsoleCredentials
+	var ldapLogin bool
+	if ldapLogin = isLDAPEnabled(client); ldapLogin {
+		consoleCreds, err = getLDAPConsoleCredentials(lr.AccessKey, lr.SecretKey, client)
+		if err != nil {
+			return nil, ErrorWithContext(ctx, err, ErrInvalidLogin)
 		}
 	} else {
 		// prepare console credentials
@@ -131,17 +382,43 @@ func getLoginResponse(params authApi.LoginParams) (*models.LoginResponse, *Coded
 		}
 	}
 
-	sf := &auth.SessionFeatures{}
-	if lr.Features != nil {
-		sf.HideMenu = lr.Features.HideMenu
+	session, err := authenticate(consoleCreds, sf)
+	var stsErr credentials.ErrorResponse
+	if err != nil && ldapLogin && errors.As(err, &stsErr) {
+		// MinIO users and service accounts keep signing in with their keys when LDAP is enabled
+		if staticCreds, cErr := getConsoleCredentials(lr.AccessKey, lr.SecretKey, client); cErr == nil {
+			if staticSession, sErr := authenticate(staticCreds, sf); sErr == nil {
+				session, err = staticSession, nil
+			}
+		}
 	}
-	sessionID, err := login(consoleCreds, sf)
 	if err != nil {
 		if xnet.IsNetworkOrHostDown(err, true) {
 			return nil, ErrorWithContext(ctx, ErrNetworkError)
 		}
 		return nil, ErrorWithContext(ctx, err, ErrInvalidLogin)
 	}
+	// the session is only issued once the second factor of the user is checked
+	secondFactorPending, enroll, err := secondFactorForLogin(ctx, session.AccountAccessKey, nil)
+	if err != nil {
+		// the credentials were right, the login isn't a failure of the client
+		attempt.Cancel()
+		return nil, ErrorWithContext(ctx, err)
+	}
+	if secondFactorPending {
+		token, err := newSecondFactorToken(session, enroll)
+		if err != nil {
+			return nil, ErrorWithContext(ctx, err)
+		}
+		return &models.LoginResponse{
+			SecondFactorToken:      token,
+			SecondFactorEnrollment: enroll,
+		}, nil
+	}
+	sessionID, err := issueSession(params.HTTPRequest, session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err, ErrInvalidLogin)
+	}
 	// serialize output
 	loginResponse := &models.LoginResponse{
 		SessionID: *sessionID,
@@ -149,6 +426,62 @@ func getLoginResponse(params authApi.LoginParams) (*models.LoginResponse, *Coded
 	return loginResponse, nil
 }
 
+// getLoginOauth2AuthResponse exchanges the authorization code of the IDP the login started on for
+// MinIO credentials through AssumeRoleWithWebIdentity and returns the console session
+func getLoginOauth2AuthResponse(params authApi.LoginOauth2AuthParams, openIDProviders oauth2.OpenIDPCfg) (*models.LoginResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	r := params.HTTPRequest
+	lr := params.Body
+
+	// the state holds the name of the provider next to the signed state it issued
+	decodedState, err := base64.StdEncoding.DecodeString(*lr.State)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, err)
+	}
+	var loginParams oauth2.LoginURLParams
+	if err = json.Unmarshal(decodedState, &loginParams); err != nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, err)
+	}
+	provider, ok := openIDProviders[loginParams.IDPName]
+	if !ok {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, fmt.Errorf("unknown openid provider %s", loginParams.IDPName))
+	}
+
+	client := GetConsoleHTTPClient(getClientIP(r))
+	oauth2Client, err := provider.GetOauth2Provider(loginParams.IDPName, nil, r, client)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	identityProvider := auth.IdentityProvider{
+		KeyFunc: provider.GetStateKeyFunc(),
+		Client:  oauth2Client,
+		RoleARN: provider.RoleArn,
+	}
+	userCredentials, err := identityProvider.VerifyIdentity(ctx, *lr.Code, loginParams.State, getIDPLoginSecrets(r, loginParams.IDPName))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err, ErrInvalidLogin)
+	}
+	consoleCreds := &ConsoleCredentials{
+		ConsoleCredentials: userCredentials,
+		CredContext: &credentials.CredContext{
+			Client: client,
+		},
+		Renewal: &auth.SessionRenewal{IDPName: loginParams.IDPName},
+	}
+	sessionID, err := login(consoleCreds, &auth.SessionFeatures{}, r)
+	if err != nil {
+		if xnet.IsNetworkOrHostDown(err, true) {
+			return nil, ErrorWithContext(ctx, ErrNetworkError)
+		}
+		return nil, ErrorWithContext(ctx, err, ErrInvalidLogin)
+	}
+	return &models.LoginResponse{
+		SessionID:       *sessionID,
+		IDPRefreshToken: oauth2Client.RefreshToken,
+	}, nil
+}
+
 // isKubernetes returns true if minio is running in kubernetes.
 func isKubernetes() bool {
 	// Kubernetes env used to validate if we are
@@ -158,17 +491,128 @@ func isKubernetes() bool {
 	return env.Get("KUBERNETES_SERVICE_HOST", "") != ""
 }
 
-// getLoginDetailsResponse returns information regarding the Console authentication mechanism.
-func getLoginDetailsResponse() (ld *models.LoginDetails, apiErr *CodedAPIError) {
+// idpLogin is the content of the cookie holding the secrets of the last login started on each IDP
+type idpLogin struct {
+	Secrets map[string]*oauth2.LoginSecrets `json:"secrets"`
+	Expires int64                           `json:"expires"`
+}
+
+// newIDPLoginCookie returns the cookie holding the encrypted secrets of the logins started on the IDPs
+func newIDPLoginCookie(secrets map[string]*oauth2.LoginSecrets) (*http.Cookie, error) {
+	data, err := json.Marshal(idpLogin{Secrets: secrets, Expires: time.Now().Add(idpLoginTimeout).Unix()})
+	if err != nil {
+		return nil, err
+	}
+	value, err := auth.EncryptPayload(data, idpLoginPurpose)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Cookie{
+		Path:     "/",
+		Name:     idpLoginCookie,
+		Value:    value,
+		MaxAge:   int(idpLoginTimeout.Seconds()),
+		Expires:  time.Now().Add(idpLoginTimeout),
+		HttpOnly: true,
+		Secure:   len(GlobalPublicCerts) > 0,
+		SameSite: http.SameSiteLaxMode,
+	}, nil
+}
+
+func expireIDPLoginCookie() http.Cookie {
+	return http.Cookie{
+		Path:     "/",
+		Name:     idpLoginCookie,
+		Value:    "",
+		MaxAge:   -1,
+		Expires:  time.Now().Add(-100 * time.Hour),
+		HttpOnly: true,
+		Secure:   len(GlobalPublicCerts) > 0,
+		SameSite: http.SameSiteLaxMode,
+	}
+}
+
+// getIDPLoginSecrets returns the secrets of the login started on the IDP by the browser of the request, nil
+// when it has none
+func getIDPLoginSecrets(r *http.Request, idpName string) *oauth2.LoginSecrets {
+	cookie, err := r.Cookie(idpLoginCookie)
+	if err != nil {
+		return nil
+	}
+	data, err := auth.DecryptPayload(strings.TrimSpace(cookie.Value), idpLoginPurpose)
+	if err != nil {
+		return nil
+	}
+	var login idpLogin
+	if err = json.Unmarshal(data, &login); err != nil || !time.Now().Before(time.Unix(login.Expires, 0)) {
+		return nil
+	}
+	return login.Secrets[idpName]
+}
+
+// getLoginDetailsResponse returns information regarding the Console authentication mechanism, along with the
+// cookie keeping the secrets of the logins the browser may start on the IDPs, if any
+func getLoginDetailsResponse(params authApi.LoginDetailParams, openIDProviders oauth2.OpenIDPCfg) (ld *models.LoginDetails, loginCookie *http.Cookie, apiErr *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
 	loginStrategy := models.LoginDetailsLoginStrategyForm
 	var redirectRules []*models.RedirectRule
 
+	r := params.HTTPRequest
+	client := GetConsoleHTTPClient(getClientIP(r))
+	if isLDAPEnabled(client) {
+		loginStrategy = models.LoginDetailsLoginStrategyLdap
+	}
+	names := make([]string, 0, len(openIDProviders))
+	for name := range openIDProviders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	loginSecrets := map[string]*oauth2.LoginSecrets{}
+	for _, name := range names {
+		provider := openIDProviders[name]
+		oauth2Client, err := provider.GetOauth2Provider(name, nil, r.WithContext(ctx), client)
+		if err != nil {
+			// an unreachable IDP must not prevent the other logins
+			LogError("unable to reach the openid provider %s: %v", name, err)
+			continue
+		}
+		identityProvider := &auth.IdentityProvider{
+			KeyFunc: provider.GetStateKeyFunc(),
+			Client:  oauth2Client,
+			RoleARN: provider.RoleArn,
+		}
+		displayName := provider.DisplayName
+		if displayName == "" {
+			displayName = "Login with SSO"
+			if name != oauth2.DefaultProviderName {
+				displayName = fmt.Sprintf("Login with SSO (%s)", name)
+			}
+		}
+		loginURL, secrets := identityProvider.GenerateLoginURL()
+		if secrets != nil {
+			loginSecrets[name] = secrets
+		}
+		redirectRules = append(redirectRules, &models.RedirectRule{
+			Redirect:    loginURL,
+			DisplayName: displayName,
+			ServiceType: "oauth2",
+		})
+	}
+
 	loginDetails := &models.LoginDetails{
 		LoginStrategy: loginStrategy,
 		RedirectRules: redirectRules,
 		IsK8S:         isKubernetes(),
 		AnimatedLogin: getConsoleAnimatedLogin(),
 	}
+	if len(loginSecrets) > 0 {
+		cookie, err := newIDPLoginCookie(loginSecrets)
+		if err != nil {
+			return nil, nil, ErrorWithContext(ctx, err)
+		}
+		loginCookie = cookie
+	}
 
-	return loginDetails, nil
+	return loginDetails, loginCookie, nil
 }
@@ -18,10 +18,27 @@ package api
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/go-openapi/swag"
+	jwtgo "github.com/golang-jwt/jwt/v4"
+	authApi "github.com/minio/console/api/operations/auth"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/auth"
+	"github.com/minio/console/pkg/auth/idp/oauth2"
+	"github.com/minio/console/pkg/auth/mfa"
+	"github.com/minio/console/pkg/auth/session"
 	"github.com/minio/madmin-go/v3"
 
 	iampolicy "github.com/minio/pkg/v3/policy"
@@ -41,6 +58,10 @@ func (ac consoleCredentialsMock) GetAccountAccessKey() string {
 	return ""
 }
 
+func (ac consoleCredentialsMock) GetSessionRenewal() *auth.SessionRenewal {
+	return nil
+}
+
 // Common mocks
 var consoleCredentialsGetMock func() (credentials.Value, error)
 
@@ -61,7 +82,7 @@ func TestLogin(t *testing.T) {
 			SignerType:      0,
 		}, nil
 	}
-	token, err := login(consoleCredentials, nil)
+	token, err := login(consoleCredentials, nil, nil)
 	funcAssert.NotEmpty(token, "Token was returned empty")
 	funcAssert.Nil(err, "error creating a session")
 
@@ -69,7 +90,7 @@ func TestLogin(t *testing.T) {
 	consoleCredentialsGetMock = func() (credentials.Value, error) {
 		return credentials.Value{}, errors.New("")
 	}
-	_, err = login(consoleCredentials, nil)
+	_, err = login(consoleCredentials, nil, nil)
 	funcAssert.NotNil(err, "not error returned creating a session")
 }
 
@@ -117,3 +138,327 @@ func Test_getAccountInfo(t *testing.T) {
 		})
 	}
 }
+
+// mockOpenIDLogin holds the PKCE challenge and nonce the browser sent to the IDP on login
+type mockOpenIDLogin struct {
+	challenge string
+	nonce     string
+}
+
+// newMockOpenIDServer serves the discovery document and token endpoint of an IDP as well as
+// the AssumeRoleWithWebIdentity STS API of MinIO
+func newMockOpenIDServer(t *testing.T) (*httptest.Server, *mockOpenIDLogin) {
+	var server *httptest.Server
+	login := &mockOpenIDLogin{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		json.NewEncoder(w).Encode(oauth2.DiscoveryDoc{
+			Issuer:                 server.URL,
+			AuthEndpoint:           server.URL + "/authorize",
+			TokenEndpoint:          server.URL + "/token",
+			ResponseTypesSupported: []string{"code"},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		verifier := sha256.Sum256([]byte(r.Form.Get("code_verifier")))
+		if r.Form.Get("code") != "mock-code" || (login.challenge != "" && base64.RawURLEncoding.EncodeToString(verifier[:]) != login.challenge) {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"error":"invalid_grant"}`)
+			return
+		}
+		idToken := "e30." + base64.RawURLEncoding.EncodeToString([]byte(`{"nonce":"`+login.nonce+`"}`)) + ".sig"
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"access","token_type":"Bearer","expires_in":3600,"refresh_token":"refresh","id_token":"%s"}`, idToken)
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.Form.Get("Action") != "AssumeRoleWithWebIdentity" || r.Form.Get("WebIdentityToken") == "" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		fmt.Fprint(w, `<AssumeRoleWithWebIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleWithWebIdentityResult>
+    <Credentials>
+      <AccessKeyId>sts-access</AccessKeyId>
+      <SecretAccessKey>sts-secret</SecretAccessKey>
+      <SessionToken>sts-token</SessionToken>
+      <Expiration>2099-01-01T00:00:00Z</Expiration>
+    </Credentials>
+  </AssumeRoleWithWebIdentityResult>
+</AssumeRoleWithWebIdentityResponse>`)
+	})
+	server = httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server, login
+}
+
+func Test_getLoginOauth2AuthResponse(t *testing.T) {
+	assert := assert.New(t)
+	server, _ := newMockOpenIDServer(t)
+	t.Setenv(oauth2.ConsoleMinIOServer, server.URL)
+	t.Setenv(auth.ConsoleLDAPEnabled, "off")
+	providers := oauth2.OpenIDPCfg{
+		"corp": {
+			URL:              server.URL + "/.well-known/openid-configuration",
+			ClientID:         "console",
+			ClientSecret:     "secret",
+			RedirectCallback: "http://localhost:9090/oauth_callback",
+			HMACSalt:         "salt",
+			HMACPassphrase:   "passphrase",
+		},
+		"offline": {
+			URL:              "http://127.0.0.1:1/.well-known/openid-configuration",
+			ClientID:         "console",
+			RedirectCallback: "http://localhost:9090/oauth_callback",
+		},
+	}
+
+	// unreachable providers are left out of the login page
+	details, loginCookie, apiErr := getLoginDetailsResponse(authApi.LoginDetailParams{HTTPRequest: httptest.NewRequest(http.MethodGet, "/api/v1/login", nil)}, providers)
+	assert.Nil(apiErr)
+	assert.Nil(loginCookie)
+	assert.Len(details.RedirectRules, 1)
+	assert.Equal("Login with SSO (corp)", details.RedirectRules[0].DisplayName)
+	redirect, err := url.Parse(details.RedirectRules[0].Redirect)
+	assert.Nil(err)
+	assert.Equal(server.URL+"/authorize", fmt.Sprintf("%s://%s%s", redirect.Scheme, redirect.Host, redirect.Path))
+	state := redirect.Query().Get("state")
+
+	callback := func(code, state string) (*models.LoginResponse, *CodedAPIError) {
+		return getLoginOauth2AuthResponse(authApi.LoginOauth2AuthParams{
+			HTTPRequest: httptest.NewRequest(http.MethodPost, "/api/v1/login/oauth2/auth", nil),
+			Body:        &models.LoginOauth2AuthRequest{Code: swag.String(code), State: swag.String(state)},
+		}, providers)
+	}
+	resp, apiErr := callback("mock-code", state)
+	assert.Nil(apiErr)
+	assert.NotEmpty(resp.SessionID)
+	assert.Equal("refresh", resp.IDPRefreshToken)
+	claims, err := auth.SessionTokenAuthenticate(resp.SessionID)
+	assert.Nil(err)
+	assert.Equal("sts-access", claims.STSAccessKeyID)
+
+	_, apiErr = callback("wrong-code", state)
+	assert.Equal(401, apiErr.Code)
+
+	// a state that wasn't signed by the provider is rejected
+	forged, _ := json.Marshal(oauth2.LoginURLParams{State: base64.StdEncoding.EncodeToString([]byte("state:hmac")), IDPName: "corp"})
+	_, apiErr = callback("mock-code", base64.StdEncoding.EncodeToString(forged))
+	assert.Equal(401, apiErr.Code)
+
+	unknown, _ := json.Marshal(oauth2.LoginURLParams{State: "state", IDPName: "other"})
+	_, apiErr = callback("mock-code", base64.StdEncoding.EncodeToString(unknown))
+	assert.Equal(400, apiErr.Code)
+}
+
+func Test_getLoginOauth2AuthResponsePKCE(t *testing.T) {
+	assert := assert.New(t)
+	server, login := newMockOpenIDServer(t)
+	t.Setenv(oauth2.ConsoleMinIOServer, server.URL)
+	t.Setenv(auth.ConsoleLDAPEnabled, "off")
+	providers := oauth2.OpenIDPCfg{
+		"corp": {
+			URL:              server.URL + "/.well-known/openid-configuration",
+			ClientID:         "console",
+			RedirectCallback: "http://localhost:9090/oauth_callback",
+			HMACSalt:         "salt",
+			HMACPassphrase:   "passphrase",
+			PKCE:             true,
+			Nonce:            true,
+		},
+	}
+	startLogin := func() (string, *http.Cookie) {
+		details, loginCookie, apiErr := getLoginDetailsResponse(authApi.LoginDetailParams{HTTPRequest: httptest.NewRequest(http.MethodGet, "/api/v1/login", nil)}, providers)
+		assert.Nil(apiErr)
+		assert.True(loginCookie.HttpOnly)
+		redirect, err := url.Parse(details.RedirectRules[0].Redirect)
+		assert.Nil(err)
+		assert.Equal("S256", redirect.Query().Get("code_challenge_method"))
+		login.challenge = redirect.Query().Get("code_challenge")
+		login.nonce = redirect.Query().Get("nonce")
+		assert.NotEmpty(login.challenge)
+		assert.NotEmpty(login.nonce)
+		return redirect.Query().Get("state"), loginCookie
+	}
+	callback := func(state string, loginCookie *http.Cookie) *CodedAPIError {
+		r := httptest.NewRequest(http.MethodPost, "/api/v1/login/oauth2/auth", nil)
+		if loginCookie != nil {
+			r.AddCookie(loginCookie)
+		}
+		_, apiErr := getLoginOauth2AuthResponse(authApi.LoginOauth2AuthParams{
+			HTTPRequest: r,
+			Body:        &models.LoginOauth2AuthRequest{Code: swag.String("mock-code"), State: swag.String(state)},
+		}, providers)
+		return apiErr
+	}
+
+	// Test-1 : the browser that started the login completes it
+	state, loginCookie := startLogin()
+	assert.Nil(callback(state, loginCookie))
+
+	// Test-2 : the verifier and nonce aren't derived from the state, the login needs the cookie it started with
+	state, loginCookie = startLogin()
+	assert.Equal(401, callback(state, nil).Code)
+	_, otherCookie := startLogin()
+	assert.Equal(401, callback(state, otherCookie).Code)
+	forgedCookie := *loginCookie
+	forgedCookie.Value = "forged"
+	assert.Equal(401, callback(state, &forgedCookie).Code)
+
+	// Test-3 : the code of another login can't be redeemed with this login's verifier
+	state, loginCookie = startLogin()
+	login.challenge = "another-challenge"
+	assert.Equal(401, callback(state, loginCookie).Code)
+
+	// Test-4 : an id_token issued for another login is rejected
+	state, loginCookie = startLogin()
+	login.nonce = "replayed"
+	assert.Equal(401, callback(state, loginCookie).Code)
+}
+
+func Test_getLoginResponseSTS(t *testing.T) {
+	assert := assert.New(t)
+	token, _ := jwtgo.NewWithClaims(jwtgo.SigningMethodHS256, jwtgo.MapClaims{"parent": "victim"}).SignedString([]byte("secret"))
+	// MinIO only accepts the STS credentials it issued
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/minio/admin/v3/accountinfo" || r.Header.Get("X-Amz-Security-Token") != token || !strings.Contains(r.Header.Get("Authorization"), "Credential=sts-access/") {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"Code":"InvalidAccessKeyId","Message":"The access key ID you provided does not exist in our records."}`)
+			return
+		}
+		json.NewEncoder(w).Encode(madmin.AccountInfo{AccountName: "victim"})
+	}))
+	defer server.Close()
+	t.Setenv(ConsoleMinIOServer, server.URL)
+	GlobalSessions = session.NewRegistry(session.NewMemoryStore(), 0)
+	defer func() { GlobalSessions = nil }()
+
+	loginWithSTS := func(accessKey, sts string) (*models.LoginResponse, *CodedAPIError) {
+		return getLoginResponse(authApi.LoginParams{
+			HTTPRequest: httptest.NewRequest(http.MethodPost, "/api/v1/login", nil),
+			Body:        &models.LoginRequest{AccessKey: accessKey, SecretKey: "sts-secret", Sts: sts},
+		}, nil)
+	}
+
+	// Test-1 : made up STS credentials don't get a session of the user they claim
+	forged, _ := jwtgo.NewWithClaims(jwtgo.SigningMethodHS256, jwtgo.MapClaims{"parent": "victim"}).SignedString([]byte("guess"))
+	_, apiErr := loginWithSTS("sts-access", forged)
+	assert.Equal(401, apiErr.Code)
+	_, apiErr = loginWithSTS("other-access", token)
+	assert.Equal(401, apiErr.Code)
+	sessions, err := GlobalSessions.List(context.Background(), "victim")
+	assert.Nil(err)
+	assert.Empty(sessions)
+
+	// Test-2 : STS credentials MinIO accepts get a session of the user they were issued to
+	resp, apiErr := loginWithSTS("sts-access", token)
+	assert.Nil(apiErr)
+	assert.NotEmpty(resp.SessionID)
+	sessions, err = GlobalSessions.List(context.Background(), "victim")
+	assert.Nil(err)
+	assert.Len(sessions, 1)
+
+	// Test-3 : the SPA issues sessions of STS credentials the same way
+	spaLogin := func(accessKey, sts string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		handleSPA(w, httptest.NewRequest(http.MethodGet, "/?"+url.Values{"sts": {sts}, "sts_a": {accessKey}, "sts_s": {"sts-secret"}}.Encode(), nil))
+		return w
+	}
+	w := spaLogin("sts-access", forged)
+	assert.Equal(http.StatusUnauthorized, w.Code)
+	assert.Empty(w.Result().Cookies())
+	w = spaLogin("sts-access", token)
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Len(w.Result().Cookies(), 1)
+
+	// Test-4 : both refuse the STS credentials of users requiring a second factor
+	t.Setenv(ConsoleMFARequiredUsers, "victim")
+	GlobalSecondFactors = mfa.NewMemoryStore()
+	defer func() { GlobalSecondFactors = nil }()
+	_, apiErr = loginWithSTS("sts-access", token)
+	assert.Equal(403, apiErr.Code)
+	w = spaLogin("sts-access", token)
+	assert.Equal(http.StatusForbidden, w.Code)
+	assert.Empty(w.Result().Cookies())
+}
+
+func Test_ldapDetection(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Now()
+	detection := &ldapDetection{}
+	probes := 0
+	var answer error
+	detect := func() (bool, error) {
+		probes++
+		return answer == nil, answer
+	}
+
+	// Test-1 : the answer of MinIO is cached until it expires
+	assert.True(detection.get(now, detect))
+	assert.True(detection.get(now.Add(ldapDetectionTTL-time.Second), detect))
+	assert.Equal(1, probes)
+
+	// Test-2 : failures keep the last answer and back off before asking again
+	answer = errors.New("connection refused")
+	now = now.Add(ldapDetectionTTL)
+	assert.True(detection.get(now, detect))
+	assert.True(detection.get(now.Add(ldapDetectionBackoff-time.Second), detect))
+	assert.Equal(2, probes)
+	answer = nil
+	assert.True(detection.get(now.Add(ldapDetectionBackoff), detect))
+	assert.Equal(3, probes)
+}
+
+func Test_getLoginResponseLDAP(t *testing.T) {
+	assert := assert.New(t)
+	// MinIO with LDAP configured, alice is an LDAP user and minioadmin the root user
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		var action, accessKey string
+		switch {
+		case r.Form.Get("Action") == "AssumeRoleWithLDAPIdentity" && r.Form.Get("LDAPUsername") == "alice" && r.Form.Get("LDAPPassword") == "secret":
+			action, accessKey = "AssumeRoleWithLDAPIdentity", "ldap-access"
+		case r.Form.Get("Action") == "AssumeRole" && strings.Contains(r.Header.Get("Authorization"), "Credential=minioadmin/"):
+			action, accessKey = "AssumeRole", "root-access"
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `<ErrorResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/"><Error><Type></Type><Code>InvalidParameterValue</Code><Message>LDAP server error</Message></Error></ErrorResponse>`)
+			return
+		}
+		fmt.Fprintf(w, `<%[1]sResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/"><%[1]sResult><Credentials><AccessKeyId>%[2]s</AccessKeyId><SecretAccessKey>secret</SecretAccessKey><SessionToken>token</SessionToken><Expiration>2099-01-01T00:00:00Z</Expiration></Credentials></%[1]sResult></%[1]sResponse>`, action, accessKey)
+	}))
+	defer server.Close()
+	t.Setenv(ConsoleMinIOServer, server.URL)
+	globalLDAPDetection = &ldapDetection{}
+	defer func() { globalLDAPDetection = &ldapDetection{} }()
+
+	details, _, apiErr := getLoginDetailsResponse(authApi.LoginDetailParams{HTTPRequest: httptest.NewRequest(http.MethodGet, "/api/v1/login", nil)}, nil)
+	assert.Nil(apiErr)
+	assert.Equal(models.LoginDetailsLoginStrategyLdap, details.LoginStrategy)
+
+	loginAs := func(username, password string) (*auth.TokenClaims, *CodedAPIError) {
+		resp, apiErr := getLoginResponse(authApi.LoginParams{
+			HTTPRequest: httptest.NewRequest(http.MethodPost, "/api/v1/login", nil),
+			Body:        &models.LoginRequest{AccessKey: username, SecretKey: password},
+		}, nil)
+		if apiErr != nil {
+			return nil, apiErr
+		}
+		claims, err := auth.SessionTokenAuthenticate(resp.SessionID)
+		assert.Nil(err)
+		return claims, nil
+	}
+	claims, apiErr := loginAs("alice", "secret")
+	assert.Nil(apiErr)
+	assert.Equal("ldap-access", claims.STSAccessKeyID)
+	assert.Equal("alice", claims.AccountAccessKey)
+
+	// users that aren't in LDAP sign in with their MinIO keys
+	claims, apiErr = loginAs("minioadmin", "minioadmin")
+	assert.Nil(apiErr)
+	assert.Equal("root-access", claims.STSAccessKeyID)
+
+	_, apiErr = loginAs("alice", "wrong")
+	assert.Equal(401, apiErr.Code)
+}
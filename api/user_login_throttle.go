@@ -0,0 +1,101 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/minio/console/pkg/auth/throttle"
+	"github.com/minio/console/pkg/logger"
+	"github.com/minio/console/pkg/logger/message/audit"
+	xnet "github.com/minio/pkg/v3/net"
+)
+
+// GlobalLoginThrottle slows down and locks out the clients failing to log in, logins aren't throttled
+// when it's nil
+var GlobalLoginThrottle *throttle.Throttle
+
+// NewLoginThrottle returns the login throttle set up by CONSOLE_LOGIN_MAX_FAILURES,
+// CONSOLE_LOGIN_MAX_IP_FAILURES and CONSOLE_LOGIN_LOCKOUT_DURATION
+func NewLoginThrottle() *throttle.Throttle {
+	maxFailures := getLoginMaxFailures()
+	if maxFailures == 0 {
+		return nil
+	}
+	return throttle.New(throttle.Config{
+		MaxFailures:   maxFailures,
+		MaxIPFailures: getLoginMaxIPFailures(),
+		Lockout:       getLoginLockoutDuration(),
+	})
+}
+
+// reserveLoginAttempt lets the client try to log in with the access key, or returns how many seconds
+// it has to wait before trying again. The attempt is nil when logins aren't throttled.
+func reserveLoginAttempt(clientIP, accessKey string) (*throttle.Attempt, int64) {
+	if GlobalLoginThrottle == nil {
+		return nil, 0
+	}
+	attempt, wait := GlobalLoginThrottle.Reserve(clientIP, accessKey)
+	if attempt != nil {
+		return attempt, 0
+	}
+	return nil, int64(math.Ceil(wait.Seconds()))
+}
+
+// recordLoginAttempt records the outcome of a login attempt, the access keys and client IPs locked
+// out by a failure are written to the audit log
+func recordLoginAttempt(ctx context.Context, r *http.Request, clientIP string, attempt *throttle.Attempt, err error) {
+	if attempt == nil {
+		return
+	}
+	if err == nil {
+		attempt.Success()
+		return
+	}
+	// MinIO being unreachable says nothing about the credentials
+	if xnet.IsNetworkOrHostDown(err, true) {
+		attempt.Cancel()
+		return
+	}
+	for _, lockout := range attempt.Failure() {
+		LogInfo("login locked out for %s %s after %d failures until %s", lockout.Kind, lockout.Value, lockout.Failures, lockout.Until.Format(time.RFC3339))
+		auditLoginLockout(ctx, r, clientIP, lockout)
+	}
+}
+
+// auditLoginLockout sends the lockout of an access key or a client IP to the audit targets
+func auditLoginLockout(ctx context.Context, r *http.Request, clientIP string, lockout throttle.Lockout) {
+	entry := audit.NewEntry(logger.GetGlobalDeploymentID())
+	entry.Trigger = "internal"
+	entry.API.Path = r.URL.Path
+	entry.API.Method = r.Method
+	entry.API.StatusCode = http.StatusTooManyRequests
+	entry.API.Status = http.StatusText(http.StatusTooManyRequests)
+	entry.RemoteHost = clientIP
+	entry.UserAgent = r.UserAgent()
+	entry.Tags = map[string]interface{}{
+		"event":    "login-lockout",
+		"kind":     lockout.Kind,
+		"value":    lockout.Value,
+		"failures": lockout.Failures,
+		"until":    lockout.Until.UTC().Format(time.RFC3339),
+	}
+	logger.AuditLog(logger.SetAuditEntry(ctx, &entry), nil, nil, nil)
+}
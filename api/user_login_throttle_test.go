@@ -0,0 +1,104 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"net"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_loginThrottle(t *testing.T) {
+	assert := assert.New(t)
+	t.Setenv(ConsoleLoginMaxFailures, "3")
+	t.Setenv(ConsoleLoginLockoutDuration, "10m")
+	GlobalLoginThrottle = NewLoginThrottle()
+	defer func() { GlobalLoginThrottle = nil }()
+	r := httptest.NewRequest("POST", "/api/v1/login", nil)
+	ctx := context.Background()
+	fail := func(clientIP string, err error) int64 {
+		attempt, retryAfter := reserveLoginAttempt(clientIP, "user")
+		if attempt != nil {
+			recordLoginAttempt(ctx, r, clientIP, attempt, err)
+		}
+		return retryAfter
+	}
+	retryAfter := func(clientIP string) int64 {
+		attempt, retryAfter := reserveLoginAttempt(clientIP, "user")
+		attempt.Cancel()
+		return retryAfter
+	}
+
+	// Test-1 : MinIO being unreachable doesn't count as a failure
+	fail("10.0.0.1", &url.Error{Op: "Post", URL: "http://minio:9000", Err: &net.OpError{Op: "dial", Net: "tcp"}})
+	assert.Equal(int64(0), retryAfter("10.0.0.1"))
+
+	// Test-2 : failed logins slow the client down until the access key is locked out
+	fail("10.0.0.1", ErrInvalidLogin)
+	assert.Equal(int64(0), retryAfter("10.0.0.1"))
+	fail("10.0.0.1", ErrInvalidLogin)
+	assert.Equal(int64(1), retryAfter("10.0.0.1"))
+	// the client has to wait, the third failure is recorded without it
+	GlobalLoginThrottle.Failure("10.0.0.1", "user")
+	assert.InDelta(int64(600), retryAfter("10.0.0.2"), 1)
+
+	// Test-3 : concurrent attempts of an access key wait for the one in progress
+	GlobalLoginThrottle = NewLoginThrottle()
+	attempt, wait := reserveLoginAttempt("10.0.0.1", "user")
+	assert.NotNil(attempt)
+	assert.Equal(int64(0), wait)
+	_, wait = reserveLoginAttempt("10.0.0.2", "user")
+	assert.Equal(int64(1), wait)
+	recordLoginAttempt(ctx, r, "10.0.0.1", attempt, nil)
+	assert.Equal(int64(0), retryAfter("10.0.0.2"))
+
+	// Test-4 : logging in is never throttled without a login throttle
+	t.Setenv(ConsoleLoginMaxFailures, "0")
+	GlobalLoginThrottle = NewLoginThrottle()
+	assert.Nil(GlobalLoginThrottle)
+	fail("10.0.0.1", ErrInvalidLogin)
+	assert.Equal(int64(0), retryAfter("10.0.0.1"))
+}
+
+func Test_getClientIPTrustedProxies(t *testing.T) {
+	assert := assert.New(t)
+	r := httptest.NewRequest("POST", "/api/v1/login", nil)
+	r.RemoteAddr = "192.168.1.10:43210"
+	r.Header.Set("X-Forwarded-For", "1.2.3.4, 8.8.8.8, 192.168.1.20")
+
+	// Test-1 : forwarding headers only tell MinIO where requests come from when no proxy is set, they
+	// are not trusted to throttle logins
+	assert.Equal("1.2.3.4", getClientIP(r))
+	assert.Equal("192.168.1.10", getLoginClientIP(r))
+
+	// Test-2 : forwarding headers of the proxies are walked from the right, skipping the proxies
+	t.Setenv(ConsoleTrustedProxies, "10.0.0.1, 192.168.1.0/24")
+	assert.Equal("8.8.8.8", getClientIP(r))
+	assert.Equal("8.8.8.8", getLoginClientIP(r))
+	r.Header.Del("X-Forwarded-For")
+	r.Header.Set("Forwarded", `for=1.2.3.4, for="[2001:db8::1]:4711";proto=https, for=10.0.0.1`)
+	assert.Equal("2001:db8::1", getLoginClientIP(r))
+
+	// Test-3 : forwarding headers sent by anyone else are ignored
+	r.RemoteAddr = "172.16.0.5:43210"
+	assert.Equal("172.16.0.5", getClientIP(r))
+	assert.Equal("172.16.0.5", getLoginClientIP(r))
+}
@@ -75,6 +75,10 @@ func logout(credentials ConsoleCredentialsI) {
 func getLogoutResponse(session *models.Principal, params authApi.LogoutParams) *CodedAPIError {
 	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
 	defer cancel()
+	// the session is revoked even when the IDP logout fails
+	if err := revokeCurrentSession(ctx, session); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
 	state := params.Body.State
 	if state != "" {
 		if err := logoutFromIDPProvider(params.HTTPRequest, state); err != nil {
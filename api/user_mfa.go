@@ -0,0 +1,495 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/api/operations"
+	authApi "github.com/minio/console/api/operations/auth"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/auth"
+	"github.com/minio/console/pkg/auth/mfa"
+	"github.com/minio/console/pkg/auth/throttle"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+const (
+	// secondFactorLoginTimeout is how long a user has to enter the code of the second factor once the
+	// credentials of the login were checked
+	secondFactorLoginTimeout = 5 * time.Minute
+	// secondFactorCookie holds the login waiting for its second factor
+	secondFactorCookie = "mfa-token"
+	// secondFactorUpdateRetries bounds how many times a code is checked again against a second factor
+	// updated concurrently
+	secondFactorUpdateRetries = 3
+	// secondFactorTokenPurpose keeps the tokens of the logins waiting for their second factor from being
+	// used as session tokens
+	secondFactorTokenPurpose = "console second factor login"
+)
+
+// GlobalSecondFactors keeps the second factors of the users, second factors are disabled when it's nil
+var GlobalSecondFactors mfa.Store
+
+// NewSecondFactorStore returns the second factor store set up by CONSOLE_MFA_STORE, the memory store suits
+// a single console while the bucket store is shared by every console of a deployment
+func NewSecondFactorStore(ctx context.Context) (mfa.Store, error) {
+	switch store := getSecondFactorStore(); store {
+	case "":
+		return nil, nil
+	case "memory":
+		return mfa.NewMemoryStore(), nil
+	case "bucket":
+		client, err := newSystemBucketClient(ConsoleMFAStoreAccessKey, ConsoleMFAStoreSecretKey)
+		if err != nil {
+			return nil, err
+		}
+		key, err := getSecondFactorStoreKey()
+		if err != nil {
+			return nil, err
+		}
+		return mfa.NewBucketStore(ctx, client, getSecondFactorStoreBucket(), key)
+	default:
+		return nil, fmt.Errorf("unknown second factor store %s, expected memory or bucket", store)
+	}
+}
+
+func registerSecondFactorHandlers(api *operations.ConsoleAPI) {
+	// finish a login with the code of the second factor
+	api.AuthLoginSecondFactorHandler = authApi.LoginSecondFactorHandlerFunc(func(params authApi.LoginSecondFactorParams) middleware.Responder {
+		// clients that failed too many times have to wait before trying again
+		var attempt *throttle.Attempt
+		if login, err := getSecondFactorLogin(params.HTTPRequest); err == nil {
+			var retryAfter int64
+			if attempt, retryAfter = reserveLoginAttempt(getLoginClientIP(params.HTTPRequest), login.Session.AccountAccessKey); retryAfter > 0 {
+				apiErr := ErrorWithContext(params.HTTPRequest.Context(), ErrTooManyLoginAttempts)
+				return authApi.NewLoginSecondFactorTooManyRequests().WithRetryAfter(retryAfter).WithPayload(apiErr.APIError)
+			}
+		}
+		loginResponse, err := getLoginSecondFactorResponse(params, attempt)
+		if err != nil {
+			return authApi.NewLoginSecondFactorDefault(err.Code).WithPayload(err.APIError)
+		}
+		// Custom response writer to set the session cookies
+		return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+			cookie := NewSessionCookieForConsole(loginResponse.SessionID)
+			http.SetCookie(w, &cookie)
+			expired := expireSecondFactorCookie()
+			http.SetCookie(w, &expired)
+			authApi.NewLoginSecondFactorNoContent().WriteResponse(w, p)
+		})
+	})
+	// enroll the second factor a login requires
+	api.AuthLoginSecondFactorEnrollHandler = authApi.LoginSecondFactorEnrollHandlerFunc(func(params authApi.LoginSecondFactorEnrollParams) middleware.Responder {
+		resp, err := getLoginSecondFactorEnrollResponse(params)
+		if err != nil {
+			return authApi.NewLoginSecondFactorEnrollDefault(err.Code).WithPayload(err.APIError)
+		}
+		return authApi.NewLoginSecondFactorEnrollOK().WithPayload(resp)
+	})
+	// whether the user has a second factor
+	api.AuthSecondFactorStatusHandler = authApi.SecondFactorStatusHandlerFunc(func(params authApi.SecondFactorStatusParams, session *models.Principal) middleware.Responder {
+		resp, err := getSecondFactorStatusResponse(session, params)
+		if err != nil {
+			return authApi.NewSecondFactorStatusDefault(err.Code).WithPayload(err.APIError)
+		}
+		return authApi.NewSecondFactorStatusOK().WithPayload(resp)
+	})
+	// start the enrollment of a second factor
+	api.AuthSecondFactorEnrollHandler = authApi.SecondFactorEnrollHandlerFunc(func(params authApi.SecondFactorEnrollParams, session *models.Principal) middleware.Responder {
+		resp, err := getSecondFactorEnrollResponse(session, params)
+		if err != nil {
+			return authApi.NewSecondFactorEnrollDefault(err.Code).WithPayload(err.APIError)
+		}
+		return authApi.NewSecondFactorEnrollOK().WithPayload(resp)
+	})
+	// confirm the enrollment of the second factor
+	api.AuthSecondFactorConfirmHandler = authApi.SecondFactorConfirmHandlerFunc(func(params authApi.SecondFactorConfirmParams, session *models.Principal) middleware.Responder {
+		if err := getSecondFactorConfirmResponse(session, params); err != nil {
+			return authApi.NewSecondFactorConfirmDefault(err.Code).WithPayload(err.APIError)
+		}
+		return authApi.NewSecondFactorConfirmNoContent()
+	})
+	// remove the second factor
+	api.AuthSecondFactorDisableHandler = authApi.SecondFactorDisableHandlerFunc(func(params authApi.SecondFactorDisableParams, session *models.Principal) middleware.Responder {
+		if err := getSecondFactorDisableResponse(session, params); err != nil {
+			return authApi.NewSecondFactorDisableDefault(err.Code).WithPayload(err.APIError)
+		}
+		return authApi.NewSecondFactorDisableNoContent()
+	})
+}
+
+// secondFactorRequiredFor tells whether the user has to log in with a second factor
+func secondFactorRequiredFor(user string) bool {
+	return getSecondFactorRequired() || slices.Contains(getSecondFactorRequiredUsers(), user)
+}
+
+// getEnrollment returns the second factor of the user, nil when the user has none. A second factor that
+// can't be decrypted is an error of the deployment, not of the user.
+func getEnrollment(ctx context.Context, user string) (*mfa.Enrollment, error) {
+	enrollment, err := GlobalSecondFactors.Get(ctx, user)
+	if errors.Is(err, mfa.ErrNotEnrolled) {
+		return nil, nil
+	}
+	if errors.Is(err, mfa.ErrUndecryptable) {
+		LogError("unable to read the second factor of %s, check %s: %v", user, ConsoleMFAStoreKey, err)
+		return nil, ErrSecondFactorUnreadable
+	}
+	return enrollment, err
+}
+
+// useSecondFactorCode checks a code against the second factor of the user with check, which records the code
+// was used on the enrollment. The code is only accepted once the enrollment is saved, an enrollment updated
+// concurrently is read and checked again so a code can't be accepted twice.
+func useSecondFactorCode(ctx context.Context, user string, check func(enrollment *mfa.Enrollment) (bool, error)) (bool, error) {
+	for i := 0; i < secondFactorUpdateRetries; i++ {
+		enrollment, err := getEnrollment(ctx, user)
+		if err != nil {
+			return false, err
+		}
+		verified, err := check(enrollment)
+		if err != nil || !verified {
+			return false, err
+		}
+		err = GlobalSecondFactors.Update(ctx, user, enrollment)
+		if errors.Is(err, mfa.ErrConflict) {
+			continue
+		}
+		return err == nil, err
+	}
+	return false, nil
+}
+
+// secondFactorForLogin tells whether the login of the user waits for a second factor, and whether the user has
+// to enroll it first. Logins with STS credentials can't have a second factor, they are refused when the user
+// they were issued to requires one or has one.
+func secondFactorForLogin(ctx context.Context, user string, sts *credentials.Value) (pending, enroll bool, err error) {
+	if GlobalSecondFactors == nil {
+		return false, false, nil
+	}
+	if sts != nil {
+		if getSecondFactorRequired() {
+			return false, false, ErrSecondFactorRequired
+		}
+		// the parent user MinIO records in the token, the STS access key otherwise
+		for _, u := range []string{sessionOwner(&models.Principal{STSSessionToken: sts.SessionToken}), user} {
+			if u == "" {
+				continue
+			}
+			if secondFactorRequiredFor(u) {
+				return false, false, ErrSecondFactorRequired
+			}
+			enrollment, err := getEnrollment(ctx, u)
+			if err != nil {
+				return false, false, err
+			}
+			if enrollment != nil && enrollment.Confirmed {
+				return false, false, ErrSecondFactorRequired
+			}
+		}
+		return false, false, nil
+	}
+	enrollment, err := getEnrollment(ctx, user)
+	if err != nil {
+		return false, false, err
+	}
+	if enrollment != nil && enrollment.Confirmed {
+		return true, false, nil
+	}
+	if secondFactorRequiredFor(user) {
+		return true, true, nil
+	}
+	return false, false, nil
+}
+
+// secondFactorLogin is a login waiting for its second factor
+type secondFactorLogin struct {
+	Session *pendingSession `json:"session"`
+	// Enroll is set when the user has to enroll the second factor
+	Enroll  bool  `json:"enroll,omitempty"`
+	Expires int64 `json:"expires"`
+}
+
+// newSecondFactorToken returns the encrypted token of a login waiting for its second factor
+func newSecondFactorToken(session *pendingSession, enroll bool) (string, error) {
+	data, err := json.Marshal(secondFactorLogin{
+		Session: session,
+		Enroll:  enroll,
+		Expires: time.Now().Add(secondFactorLoginTimeout).Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+	return auth.EncryptPayload(data, secondFactorTokenPurpose)
+}
+
+// getSecondFactorLogin returns the login waiting for its second factor the request belongs to
+func getSecondFactorLogin(r *http.Request) (*secondFactorLogin, error) {
+	cookie, err := r.Cookie(secondFactorCookie)
+	if err != nil {
+		return nil, ErrSecondFactorLoginExpired
+	}
+	data, err := auth.DecryptPayload(strings.TrimSpace(cookie.Value), secondFactorTokenPurpose)
+	if err != nil {
+		return nil, ErrSecondFactorLoginExpired
+	}
+	var login secondFactorLogin
+	if err = json.Unmarshal(data, &login); err != nil || login.Session == nil {
+		return nil, ErrSecondFactorLoginExpired
+	}
+	if !time.Now().Before(time.Unix(login.Expires, 0)) {
+		return nil, ErrSecondFactorLoginExpired
+	}
+	return &login, nil
+}
+
+// newSecondFactorCookie returns the cookie holding a login waiting for its second factor
+func newSecondFactorCookie(token string) http.Cookie {
+	return http.Cookie{
+		Path:     "/",
+		Name:     secondFactorCookie,
+		Value:    token,
+		MaxAge:   int(secondFactorLoginTimeout.Seconds()),
+		Expires:  time.Now().Add(secondFactorLoginTimeout),
+		HttpOnly: true,
+		Secure:   len(GlobalPublicCerts) > 0,
+		SameSite: http.SameSiteLaxMode,
+	}
+}
+
+func expireSecondFactorCookie() http.Cookie {
+	return http.Cookie{
+		Path:     "/",
+		Name:     secondFactorCookie,
+		Value:    "",
+		MaxAge:   -1,
+		Expires:  time.Now().Add(-100 * time.Hour),
+		HttpOnly: true,
+		Secure:   len(GlobalPublicCerts) > 0,
+		SameSite: http.SameSiteLaxMode,
+	}
+}
+
+// newEnrollmentResponse starts the enrollment of a second factor for the user, it has to be confirmed
+// with a code of the authenticator
+func newEnrollmentResponse(ctx context.Context, user string) (*models.SecondFactorEnrollment, error) {
+	enrollment, recoveryCodes, err := mfa.NewEnrollment(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err = GlobalSecondFactors.Save(ctx, user, enrollment); err != nil {
+		return nil, err
+	}
+	return &models.SecondFactorEnrollment{
+		Secret:          enrollment.Secret,
+		ProvisioningURI: mfa.ProvisioningURI(getSecondFactorIssuer(), user, enrollment.Secret),
+		RecoveryCodes:   recoveryCodes,
+	}, nil
+}
+
+// getLoginSecondFactorResponse checks the code of the second factor of a login and issues its session, the
+// first code of a second factor enrolled during the login confirms it
+func getLoginSecondFactorResponse(params authApi.LoginSecondFactorParams, attempt *throttle.Attempt) (*models.LoginResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	// the attempt counts once the code is checked, nothing is recorded when it can't be
+	defer attempt.Cancel()
+	if GlobalSecondFactors == nil {
+		return nil, ErrorWithContext(ctx, ErrSecondFactorDisabled)
+	}
+	login, err := getSecondFactorLogin(params.HTTPRequest)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	user := login.Session.AccountAccessKey
+	clientIP := getLoginClientIP(params.HTTPRequest)
+
+	// the code can't be used again, nor the recovery code
+	verified, err := useSecondFactorCode(ctx, user, func(enrollment *mfa.Enrollment) (bool, error) {
+		switch {
+		case enrollment == nil:
+			return false, nil
+		case enrollment.Confirmed:
+			return enrollment.Verify(*params.Body.Code, time.Now()) || enrollment.UseRecoveryCode(*params.Body.Code), nil
+		case login.Enroll:
+			enrollment.Confirmed = enrollment.Verify(*params.Body.Code, time.Now())
+			return enrollment.Confirmed, nil
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	if !verified {
+		recordLoginAttempt(ctx, params.HTTPRequest, clientIP, attempt, ErrInvalidSecondFactor)
+		return nil, ErrorWithContext(ctx, ErrInvalidSecondFactor)
+	}
+	recordLoginAttempt(ctx, params.HTTPRequest, clientIP, attempt, nil)
+	sessionID, err := issueSession(params.HTTPRequest, login.Session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err, ErrInvalidLogin)
+	}
+	return &models.LoginResponse{SessionID: *sessionID}, nil
+}
+
+// getLoginSecondFactorEnrollResponse enrolls the second factor of a user that has to log in with one
+func getLoginSecondFactorEnrollResponse(params authApi.LoginSecondFactorEnrollParams) (*models.SecondFactorEnrollment, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if GlobalSecondFactors == nil {
+		return nil, ErrorWithContext(ctx, ErrSecondFactorDisabled)
+	}
+	login, err := getSecondFactorLogin(params.HTTPRequest)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	user := login.Session.AccountAccessKey
+	enrollment, err := getEnrollment(ctx, user)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	if !login.Enroll || (enrollment != nil && enrollment.Confirmed) {
+		return nil, ErrorWithContext(ctx, ErrSecondFactorEnrolled)
+	}
+	resp, err := newEnrollmentResponse(ctx, user)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return resp, nil
+}
+
+// secondFactorAccount returns the user the second factor of the session belongs to, sessions of an IDP
+// or of STS credentials can't have one
+func secondFactorAccount(principal *models.Principal) (string, error) {
+	if GlobalSecondFactors == nil {
+		return "", ErrSecondFactorDisabled
+	}
+	if principal.AccountAccessKey == "" || principal.AccountAccessKey == principal.STSAccessKeyID {
+		return "", ErrSecondFactorUnavailable
+	}
+	return principal.AccountAccessKey, nil
+}
+
+// getSecondFactorStatusResponse returns whether the user has a second factor and has to have one
+func getSecondFactorStatusResponse(session *models.Principal, params authApi.SecondFactorStatusParams) (*models.SecondFactorStatus, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	user, err := secondFactorAccount(session)
+	if err != nil {
+		return &models.SecondFactorStatus{}, nil
+	}
+	enrollment, err := getEnrollment(ctx, user)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return &models.SecondFactorStatus{
+		Available: true,
+		Enrolled:  enrollment != nil && enrollment.Confirmed,
+		Required:  secondFactorRequiredFor(user),
+	}, nil
+}
+
+// getSecondFactorEnrollResponse starts the enrollment of a second factor for the user, an enrollment that
+// wasn't confirmed is replaced
+func getSecondFactorEnrollResponse(session *models.Principal, params authApi.SecondFactorEnrollParams) (*models.SecondFactorEnrollment, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	user, err := secondFactorAccount(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	enrollment, err := getEnrollment(ctx, user)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	if enrollment != nil && enrollment.Confirmed {
+		return nil, ErrorWithContext(ctx, ErrSecondFactorEnrolled)
+	}
+	resp, err := newEnrollmentResponse(ctx, user)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return resp, nil
+}
+
+// getSecondFactorConfirmResponse confirms the enrollment of the second factor of the user with a code of the
+// authenticator, logins require the second factor from then on
+func getSecondFactorConfirmResponse(session *models.Principal, params authApi.SecondFactorConfirmParams) *CodedAPIError {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	user, err := secondFactorAccount(session)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	verified, err := useSecondFactorCode(ctx, user, func(enrollment *mfa.Enrollment) (bool, error) {
+		if enrollment == nil {
+			return false, ErrSecondFactorNotEnrolled
+		}
+		if enrollment.Confirmed {
+			return false, ErrSecondFactorEnrolled
+		}
+		enrollment.Confirmed = enrollment.Verify(*params.Body.Code, time.Now())
+		return enrollment.Confirmed, nil
+	})
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	if !verified {
+		return ErrorWithContext(ctx, ErrInvalidSecondFactor)
+	}
+	return nil
+}
+
+// getSecondFactorDisableResponse removes the second factor of the user after checking one of its codes, users
+// required to have a second factor can't remove it
+func getSecondFactorDisableResponse(session *models.Principal, params authApi.SecondFactorDisableParams) *CodedAPIError {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	user, err := secondFactorAccount(session)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	if secondFactorRequiredFor(user) {
+		return ErrorWithContext(ctx, ErrSecondFactorRequired)
+	}
+	// the code is recorded before the second factor is removed, so it is only accepted once
+	verified, err := useSecondFactorCode(ctx, user, func(enrollment *mfa.Enrollment) (bool, error) {
+		if enrollment == nil {
+			return false, ErrSecondFactorNotEnrolled
+		}
+		return !enrollment.Confirmed || enrollment.Verify(*params.Body.Code, time.Now()) || enrollment.UseRecoveryCode(*params.Body.Code), nil
+	})
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	if !verified {
+		return ErrorWithContext(ctx, ErrInvalidSecondFactor)
+	}
+	if err = GlobalSecondFactors.Delete(ctx, user); err != nil && !errors.Is(err, mfa.ErrNotEnrolled) {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
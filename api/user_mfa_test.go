@@ -0,0 +1,292 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	jwtgo "github.com/golang-jwt/jwt/v4"
+	authApi "github.com/minio/console/api/operations/auth"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/auth"
+	"github.com/minio/console/pkg/auth/idp/oauth2"
+	"github.com/minio/console/pkg/auth/mfa"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/stretchr/testify/assert"
+)
+
+// secondFactorRequest returns a request carrying the cookie of the login waiting for its second factor
+func secondFactorRequest(path, token string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, path, nil)
+	cookie := newSecondFactorCookie(token)
+	r.AddCookie(&cookie)
+	return r
+}
+
+func Test_loginSecondFactor(t *testing.T) {
+	assert := assert.New(t)
+	server, _ := newMockAssumeRoleServer(t)
+	t.Setenv(oauth2.ConsoleMinIOServer, server.URL)
+	t.Setenv(ConsoleMFARequiredUsers, "required")
+	GlobalSecondFactors = mfa.NewMemoryStore()
+	defer func() { GlobalSecondFactors = nil }()
+	ctx := context.Background()
+
+	accessKey, secretKey := "user", "password"
+	loginParams := authApi.LoginParams{
+		HTTPRequest: httptest.NewRequest(http.MethodPost, "/api/v1/login", nil),
+		Body:        &models.LoginRequest{AccessKey: accessKey, SecretKey: secretKey},
+	}
+
+	// Test-1 : users without a second factor get their session right away
+	resp, err := getLoginResponse(loginParams, nil)
+	assert.Nil(err)
+	assert.NotEmpty(resp.SessionID)
+	assert.Empty(resp.SecondFactorToken)
+
+	// Test-2 : once a second factor is enrolled, the login waits for its code
+	enrollment, recoveryCodes, _ := mfa.NewEnrollment(time.Now())
+	enrollment.Confirmed = true
+	assert.Nil(GlobalSecondFactors.Save(ctx, "user", enrollment))
+	resp, err = getLoginResponse(loginParams, nil)
+	assert.Nil(err)
+	assert.Empty(resp.SessionID)
+	assert.False(resp.SecondFactorEnrollment)
+	pendingToken := resp.SecondFactorToken
+	// the token of the login isn't a session token
+	assert.False(auth.IsSessionTokenValid(pendingToken))
+
+	// Test-3 : wrong codes are refused
+	wrong := "000000"
+	_, err = getLoginSecondFactorResponse(authApi.LoginSecondFactorParams{
+		HTTPRequest: secondFactorRequest("/api/v1/login/mfa", pendingToken),
+		Body:        &models.SecondFactorCodeRequest{Code: &wrong},
+	}, nil)
+	assert.NotNil(err)
+	assert.Equal(401, err.Code)
+
+	// Test-4 : the code of the authenticator issues the session, once
+	passcode, _ := mfa.GenerateCode(enrollment.Secret, time.Now())
+	resp, err = getLoginSecondFactorResponse(authApi.LoginSecondFactorParams{
+		HTTPRequest: secondFactorRequest("/api/v1/login/mfa", pendingToken),
+		Body:        &models.SecondFactorCodeRequest{Code: &passcode},
+	}, nil)
+	assert.Nil(err)
+	claims, sErr := auth.SessionTokenAuthenticate(resp.SessionID)
+	assert.Nil(sErr)
+	assert.Equal("user", claims.AccountAccessKey)
+	_, err = getLoginSecondFactorResponse(authApi.LoginSecondFactorParams{
+		HTTPRequest: secondFactorRequest("/api/v1/login/mfa", pendingToken),
+		Body:        &models.SecondFactorCodeRequest{Code: &passcode},
+	}, nil)
+	assert.NotNil(err)
+
+	// Test-5 : a recovery code replaces the code of the authenticator
+	resp, err = getLoginSecondFactorResponse(authApi.LoginSecondFactorParams{
+		HTTPRequest: secondFactorRequest("/api/v1/login/mfa", pendingToken),
+		Body:        &models.SecondFactorCodeRequest{Code: &recoveryCodes[0]},
+	}, nil)
+	assert.Nil(err)
+	assert.NotEmpty(resp.SessionID)
+
+	// Test-6 : the login doesn't wait forever
+	data, _ := json.Marshal(secondFactorLogin{Session: &pendingSession{AccountAccessKey: "user"}, Expires: time.Now().Add(-time.Second).Unix()})
+	expired, _ := auth.EncryptPayload(data, secondFactorTokenPurpose)
+	_, lErr := getSecondFactorLogin(secondFactorRequest("/api/v1/login/mfa", expired))
+	assert.ErrorIs(lErr, ErrSecondFactorLoginExpired)
+	_, lErr = getSecondFactorLogin(httptest.NewRequest(http.MethodPost, "/api/v1/login/mfa", nil))
+	assert.ErrorIs(lErr, ErrSecondFactorLoginExpired)
+}
+
+func Test_accountSecondFactor(t *testing.T) {
+	assert := assert.New(t)
+	GlobalSecondFactors = mfa.NewMemoryStore()
+	defer func() { GlobalSecondFactors = nil }()
+	principal := &models.Principal{AccountAccessKey: "user", STSAccessKeyID: "sts-access"}
+	request := func(path string) *http.Request { return httptest.NewRequest(http.MethodPost, path, nil) }
+
+	// Test-1 : the enrollment only protects the logins once it's confirmed
+	enrollment, err := getSecondFactorEnrollResponse(principal, authApi.SecondFactorEnrollParams{HTTPRequest: request("/api/v1/mfa/enroll")})
+	assert.Nil(err)
+	pending, _, sErr := secondFactorForLogin(context.Background(), "user", nil)
+	assert.Nil(sErr)
+	assert.False(pending)
+	passcode, _ := mfa.GenerateCode(enrollment.Secret, time.Now())
+	err = getSecondFactorConfirmResponse(principal, authApi.SecondFactorConfirmParams{
+		HTTPRequest: request("/api/v1/mfa/confirm"),
+		Body:        &models.SecondFactorCodeRequest{Code: &passcode},
+	})
+	assert.Nil(err)
+	pending, _, sErr = secondFactorForLogin(context.Background(), "user", nil)
+	assert.Nil(sErr)
+	assert.True(pending)
+	_, _, sErr = secondFactorForLogin(context.Background(), "user", &credentials.Value{})
+	assert.ErrorIs(sErr, ErrSecondFactorRequired)
+
+	// Test-2 : a confirmed second factor can't be replaced without removing it
+	_, err = getSecondFactorEnrollResponse(principal, authApi.SecondFactorEnrollParams{HTTPRequest: request("/api/v1/mfa/enroll")})
+	assert.Equal(409, err.Code)
+
+	// Test-3 : removing the second factor takes one of its codes
+	wrong := "000000"
+	err = getSecondFactorDisableResponse(principal, authApi.SecondFactorDisableParams{
+		HTTPRequest: request("/api/v1/mfa/disable"),
+		Body:        &models.SecondFactorCodeRequest{Code: &wrong},
+	})
+	assert.Equal(401, err.Code)
+	err = getSecondFactorDisableResponse(principal, authApi.SecondFactorDisableParams{
+		HTTPRequest: request("/api/v1/mfa/disable"),
+		Body:        &models.SecondFactorCodeRequest{Code: &enrollment.RecoveryCodes[0]},
+	})
+	assert.Nil(err)
+	_, err = getSecondFactorStatusResponse(principal, authApi.SecondFactorStatusParams{HTTPRequest: request("/api/v1/mfa")})
+	assert.Nil(err)
+
+	// Test-4 : sessions of STS credentials can't have a second factor
+	_, err = getSecondFactorEnrollResponse(&models.Principal{AccountAccessKey: "sts-access", STSAccessKeyID: "sts-access"}, authApi.SecondFactorEnrollParams{HTTPRequest: request("/api/v1/mfa/enroll")})
+	assert.Equal(400, err.Code)
+}
+
+func Test_enrollSecondFactorAtLogin(t *testing.T) {
+	assert := assert.New(t)
+	server, _ := newMockAssumeRoleServer(t)
+	t.Setenv(oauth2.ConsoleMinIOServer, server.URL)
+	t.Setenv(ConsoleMFARequiredUsers, "required")
+	GlobalSecondFactors = mfa.NewMemoryStore()
+	defer func() { GlobalSecondFactors = nil }()
+
+	// Test-1 : users required to have a second factor enroll it before getting their session
+	resp, err := getLoginResponse(authApi.LoginParams{
+		HTTPRequest: httptest.NewRequest(http.MethodPost, "/api/v1/login", nil),
+		Body:        &models.LoginRequest{AccessKey: "required", SecretKey: "password"},
+	}, nil)
+	assert.Nil(err)
+	assert.True(resp.SecondFactorEnrollment)
+	pendingToken := resp.SecondFactorToken
+	enrollment, err := getLoginSecondFactorEnrollResponse(authApi.LoginSecondFactorEnrollParams{
+		HTTPRequest: secondFactorRequest("/api/v1/login/mfa/enroll", pendingToken),
+	})
+	assert.Nil(err)
+	assert.Contains(enrollment.ProvisioningURI, "otpauth://totp/MinIO%20Console:required?")
+	assert.Len(enrollment.RecoveryCodes, 10)
+
+	// Test-2 : recovery codes can't confirm the enrollment
+	_, err = getLoginSecondFactorResponse(authApi.LoginSecondFactorParams{
+		HTTPRequest: secondFactorRequest("/api/v1/login/mfa", pendingToken),
+		Body:        &models.SecondFactorCodeRequest{Code: &enrollment.RecoveryCodes[0]},
+	}, nil)
+	assert.NotNil(err)
+
+	// Test-3 : the first code confirms the enrollment and issues the session
+	passcode, _ := mfa.GenerateCode(enrollment.Secret, time.Now())
+	resp, err = getLoginSecondFactorResponse(authApi.LoginSecondFactorParams{
+		HTTPRequest: secondFactorRequest("/api/v1/login/mfa", pendingToken),
+		Body:        &models.SecondFactorCodeRequest{Code: &passcode},
+	}, nil)
+	assert.Nil(err)
+	assert.NotEmpty(resp.SessionID)
+	saved, _ := GlobalSecondFactors.Get(context.Background(), "required")
+	assert.True(saved.Confirmed)
+
+	// Test-4 : users required to have a second factor can't remove it
+	principal := &models.Principal{AccountAccessKey: "required", STSAccessKeyID: "sts-access"}
+	status, err := getSecondFactorStatusResponse(principal, authApi.SecondFactorStatusParams{HTTPRequest: httptest.NewRequest(http.MethodGet, "/api/v1/mfa", nil)})
+	assert.Nil(err)
+	assert.Equal(&models.SecondFactorStatus{Available: true, Enrolled: true, Required: true}, status)
+	err = getSecondFactorDisableResponse(principal, authApi.SecondFactorDisableParams{
+		HTTPRequest: httptest.NewRequest(http.MethodPost, "/api/v1/mfa/disable", nil),
+		Body:        &models.SecondFactorCodeRequest{Code: &enrollment.RecoveryCodes[0]},
+	})
+	assert.NotNil(err)
+	assert.Equal(403, err.Code)
+
+	// Test-5 : logins with STS credentials can't have a second factor, they are refused for users who need one
+	_, _, sErr := secondFactorForLogin(context.Background(), "sts-access", &credentials.Value{})
+	assert.Nil(sErr)
+	token, _ := jwtgo.NewWithClaims(jwtgo.SigningMethodHS256, jwtgo.MapClaims{"parent": "required"}).SignedString([]byte("secret"))
+	_, _, sErr = secondFactorForLogin(context.Background(), "sts-access", &credentials.Value{SessionToken: token})
+	assert.ErrorIs(sErr, ErrSecondFactorRequired)
+	t.Setenv(ConsoleMFARequired, "on")
+	_, _, sErr = secondFactorForLogin(context.Background(), "sts-access", &credentials.Value{})
+	assert.ErrorIs(sErr, ErrSecondFactorRequired)
+}
+
+// unreadableSecondFactors is a second factor store whose second factors were encrypted with another key
+type unreadableSecondFactors struct {
+	*mfa.MemoryStore
+}
+
+func (unreadableSecondFactors) Get(context.Context, string) (*mfa.Enrollment, error) {
+	return nil, fmt.Errorf("%w: message authentication failed", mfa.ErrUndecryptable)
+}
+
+func Test_unreadableSecondFactor(t *testing.T) {
+	assert := assert.New(t)
+	server, _ := newMockAssumeRoleServer(t)
+	t.Setenv(oauth2.ConsoleMinIOServer, server.URL)
+	t.Setenv(ConsoleLoginMaxFailures, "1")
+	GlobalSecondFactors = unreadableSecondFactors{mfa.NewMemoryStore()}
+	GlobalLoginThrottle = NewLoginThrottle()
+	defer func() { GlobalSecondFactors, GlobalLoginThrottle = nil, nil }()
+
+	// the deployment is at fault, the login fails without counting against the user
+	attempt, _ := reserveLoginAttempt("10.0.0.1", "user")
+	_, err := getLoginResponse(authApi.LoginParams{
+		HTTPRequest: httptest.NewRequest(http.MethodPost, "/api/v1/login", nil),
+		Body:        &models.LoginRequest{AccessKey: "user", SecretKey: "password"},
+	}, attempt)
+	assert.Equal(500, err.Code)
+	assert.Equal(ErrSecondFactorUnreadable.Error(), err.APIError.Message)
+	attempt, retryAfter := reserveLoginAttempt("10.0.0.1", "user")
+	assert.NotNil(attempt)
+	assert.Zero(retryAfter)
+}
+
+func Test_useSecondFactorCode(t *testing.T) {
+	assert := assert.New(t)
+	GlobalSecondFactors = mfa.NewMemoryStore()
+	defer func() { GlobalSecondFactors = nil }()
+	ctx := context.Background()
+	now := time.Now()
+	enrollment, _, _ := mfa.NewEnrollment(now)
+	enrollment.Confirmed = true
+	assert.Nil(GlobalSecondFactors.Save(ctx, "user", enrollment))
+	passcode, _ := mfa.GenerateCode(enrollment.Secret, now)
+	verify := func(enrollment *mfa.Enrollment) (bool, error) {
+		return enrollment.Verify(passcode, now), nil
+	}
+
+	// a code checked by two logins at once is only accepted by the one that records it first
+	var concurrent bool
+	verified, err := useSecondFactorCode(ctx, "user", func(enrollment *mfa.Enrollment) (bool, error) {
+		if !concurrent {
+			concurrent = true
+			other, err := useSecondFactorCode(ctx, "user", verify)
+			assert.Nil(err)
+			assert.True(other)
+		}
+		return verify(enrollment)
+	})
+	assert.Nil(err)
+	assert.False(verified)
+}
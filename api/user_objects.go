@@ -874,6 +874,11 @@ func deleteSingleObject(ctx context.Context, client MCClient, bucket, object str
 }
 
 func deleteNonCurrentVersions(ctx context.Context, client MCClient, isBypass bool) error {
+	return deleteMatchingNonCurrentVersions(ctx, client, isBypass, func(_ *mc.ClientContent) bool { return true })
+}
+
+// deleteMatchingNonCurrentVersions removes the non-current versions for which match returns true
+func deleteMatchingNonCurrentVersions(ctx context.Context, client MCClient, isBypass bool, match func(*mc.ClientContent) bool) error {
 	lctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -892,7 +897,7 @@ func deleteNonCurrentVersions(ctx context.Context, client MCClient, isBypass boo
 				continue
 			}
 
-			if lsObj.IsLatest {
+			if lsObj.IsLatest || !match(lsObj) {
 				continue
 			}
 
@@ -923,14 +928,25 @@ func getUploadObjectResponse(session *models.Principal, params objectApi.PostBuc
 	// create a minioClient interface implementation
 	// defining the client to be used
 	minioClient := minioClient{client: mClient}
-	if err := uploadFiles(ctx, minioClient, params); err != nil {
+	mAdmin, err := NewMinioAdminClient(ctx, session)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	// the usage of the bucket is read from the account info cache of the session, the quota is
+	// enforced by MinIO when the check is made with a stale usage
+	adminClient := newCachedAccountInfoClient(session, AdminClient{Client: mAdmin})
+	if err := uploadFiles(ctx, minioClient, adminClient, params); err != nil {
+		if errors.Is(err, ErrFileTooLarge) {
+			return ErrorWithContext(ctx, err)
+		}
 		return ErrorWithContext(ctx, err, ErrDefault)
 	}
 	return nil
 }
 
-// uploadFiles gets files from http.Request form and uploads them to MinIO
-func uploadFiles(ctx context.Context, client MinioClient, params objectApi.PostBucketsBucketNameObjectsUploadParams) error {
+// uploadFiles gets files from http.Request form and uploads them to MinIO,
+// files that don't fit in the bucket quota are rejected before being sent
+func uploadFiles(ctx context.Context, client MinioClient, adminClient MinioAdmin, params objectApi.PostBucketsBucketNameObjectsUploadParams) error {
 	var prefix string
 	if params.Prefix != nil {
 		prefix = *params.Prefix
@@ -946,6 +962,8 @@ func uploadFiles(ctx context.Context, client MinioClient, params objectApi.PostB
 		return err
 	}
 
+	headroom, limited := getBucketQuotaHeadroom(ctx, adminClient, params.BucketName)
+
 	for {
 		p, err := mr.NextPart()
 		if err == io.EOF {
@@ -956,6 +974,12 @@ func uploadFiles(ctx context.Context, client MinioClient, params objectApi.PostB
 		if err != nil {
 			return err
 		}
+		if limited {
+			if err := checkQuotaHeadroom(params.BucketName, headroom, size); err != nil {
+				return err
+			}
+			headroom -= size
+		}
 
 		contentType := p.Header.Get("content-type")
 		if contentType == "" {
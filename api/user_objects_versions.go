@@ -0,0 +1,246 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/api/operations"
+	objectApi "github.com/minio/console/api/operations/object"
+	"github.com/minio/console/models"
+	mc "github.com/minio/mc/cmd"
+	"github.com/minio/minio-go/v7"
+)
+
+func registerObjectVersionsHandlers(api *operations.ConsoleAPI) {
+	// get the version history of an object
+	api.ObjectGetObjectVersionHistoryHandler = objectApi.GetObjectVersionHistoryHandlerFunc(func(params objectApi.GetObjectVersionHistoryParams, session *models.Principal) middleware.Responder {
+		resp, err := getObjectVersionHistoryResponse(session, params)
+		if err != nil {
+			return objectApi.NewGetObjectVersionHistoryDefault(err.Code).WithPayload(err.APIError)
+		}
+		return objectApi.NewGetObjectVersionHistoryOK().WithPayload(resp)
+	})
+	// prune the noncurrent versions of an object
+	api.ObjectPruneObjectVersionsHandler = objectApi.PruneObjectVersionsHandlerFunc(func(params objectApi.PruneObjectVersionsParams, session *models.Principal) middleware.Responder {
+		resp, err := getPruneObjectVersionsResponse(session, params)
+		if err != nil {
+			return objectApi.NewPruneObjectVersionsDefault(err.Code).WithPayload(err.APIError)
+		}
+		return objectApi.NewPruneObjectVersionsOK().WithPayload(resp)
+	})
+}
+
+// objectVersionWriter returns who wrote a version from the first configured metadata key it has
+func objectVersionWriter(metadata map[string]string, keys []string) string {
+	for _, key := range keys {
+		for k, v := range metadata {
+			if strings.EqualFold(k, key) {
+				return v
+			}
+		}
+	}
+	return ""
+}
+
+// isObjectLockNotConfigured tells whether the error of a retention or legal hold lookup only
+// means the bucket has no object locking
+func isObjectLockNotConfigured(err error) bool {
+	code := minio.ToErrorResponse(err).Code
+	return code == "NoSuchObjectLockConfiguration" || code == "InvalidRequest"
+}
+
+// objectVersionLockStatus adds the retention and legal hold of a version
+func objectVersionLockStatus(ctx context.Context, client MinioClient, bucketName, objectName string, version *models.ObjectVersion) error {
+	mode, retainUntil, err := client.getObjectRetention(ctx, bucketName, objectName, version.VersionID)
+	if err != nil && !isObjectLockNotConfigured(err) {
+		return err
+	}
+	if mode != nil && retainUntil != nil {
+		version.RetentionMode = string(*mode)
+		version.RetentionUntilDate = retainUntil.Format(time.RFC3339)
+	}
+	legalHold, err := client.getObjectLegalHold(ctx, bucketName, objectName, minio.GetObjectLegalHoldOptions{VersionID: version.VersionID})
+	if err != nil && !isObjectLockNotConfigured(err) {
+		return err
+	}
+	if legalHold != nil {
+		version.LegalHoldStatus = string(*legalHold)
+	}
+	return nil
+}
+
+// getObjectVersionHistory lists every version of an object newest first, with the lock status
+// of each version that isn't a delete marker
+func getObjectVersionHistory(ctx context.Context, client MinioClient, bucketName, objectName string) (*models.ObjectVersionHistory, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	writerKeys := getVersionWriterMetadata()
+	history := &models.ObjectVersionHistory{Name: objectName, Versions: []*models.ObjectVersion{}}
+	for obj := range client.listObjects(ctx, bucketName, minio.ListObjectsOptions{Prefix: objectName, WithVersions: true, WithMetadata: true}) {
+		if obj.Err != nil {
+			return nil, obj.Err
+		}
+		// the listing also returns the objects the name is a prefix of
+		if obj.Key != objectName {
+			continue
+		}
+		version := &models.ObjectVersion{
+			VersionID:      obj.VersionID,
+			Size:           obj.Size,
+			LastModified:   obj.LastModified.Format(time.RFC3339),
+			Etag:           obj.ETag,
+			IsLatest:       obj.IsLatest,
+			IsDeleteMarker: obj.IsDeleteMarker,
+			Writer:         objectVersionWriter(obj.UserMetadata, writerKeys),
+		}
+		history.TotalVersions++
+		history.TotalBytes += obj.Size
+		if obj.IsDeleteMarker {
+			history.DeleteMarkers++
+		} else if err := objectVersionLockStatus(ctx, client, bucketName, objectName, version); err != nil {
+			return nil, err
+		}
+		if !obj.IsLatest {
+			history.NoncurrentBytes += obj.Size
+		}
+		history.Versions = append(history.Versions, version)
+	}
+	if history.TotalVersions == 0 {
+		return nil, ErrNotFound
+	}
+	return history, nil
+}
+
+// objectVersionLock returns why a version can't be deleted without bypassing object locking,
+// governance retention is never bypassed when pruning
+func objectVersionLock(version *models.ObjectVersion, now time.Time) string {
+	if version.LegalHoldStatus == string(minio.LegalHoldEnabled) {
+		return "legal hold"
+	}
+	if version.RetentionUntilDate != "" {
+		retainUntil, err := time.Parse(time.RFC3339, version.RetentionUntilDate)
+		if err != nil || retainUntil.After(now) {
+			return fmt.Sprintf("%s retention until %s", version.RetentionMode, version.RetentionUntilDate)
+		}
+	}
+	return ""
+}
+
+// planObjectVersionsPrune splits the noncurrent versions matching the request into the ones to delete
+// and the ones object locking protects, the current version and the most recent ones are always kept
+func planObjectVersionsPrune(history *models.ObjectVersionHistory, req *models.PruneObjectVersionsRequest, now time.Time) *models.PruneObjectVersionsResponse {
+	cutoff := now.AddDate(0, 0, -int(req.OlderThanDays))
+	plan := &models.PruneObjectVersionsResponse{
+		Pruned:  []*models.ObjectVersion{},
+		Skipped: []*models.SkippedObjectVersion{},
+		DryRun:  req.DryRun,
+	}
+	for i, version := range history.Versions {
+		if version.IsLatest || i < int(req.KeepLatest) {
+			continue
+		}
+		lastModified, err := time.Parse(time.RFC3339, version.LastModified)
+		if err != nil || lastModified.After(cutoff) {
+			continue
+		}
+		if reason := objectVersionLock(version, now); reason != "" {
+			plan.Skipped = append(plan.Skipped, &models.SkippedObjectVersion{VersionID: version.VersionID, Reason: reason})
+			continue
+		}
+		plan.Pruned = append(plan.Pruned, version)
+		plan.FreedBytes += version.Size
+	}
+	return plan
+}
+
+// pruneObjectVersions deletes the planned versions through the same path as the removal of all the
+// noncurrent versions of an object
+func pruneObjectVersions(ctx context.Context, client MinioClient, s3Client MCClient, bucketName string, req *models.PruneObjectVersionsRequest, now time.Time) (*models.PruneObjectVersionsResponse, error) {
+	history, err := getObjectVersionHistory(ctx, client, bucketName, *req.Prefix)
+	if err != nil {
+		return nil, err
+	}
+	plan := planObjectVersionsPrune(history, req, now)
+	if req.DryRun || len(plan.Pruned) == 0 {
+		return plan, nil
+	}
+	pruned := map[string]bool{}
+	for _, version := range plan.Pruned {
+		pruned[version.VersionID] = true
+	}
+	objectPath := fmt.Sprintf("/%s/%s", bucketName, *req.Prefix)
+	err = deleteMatchingNonCurrentVersions(ctx, s3Client, false, func(content *mc.ClientContent) bool {
+		return content.URL.Path == objectPath && pruned[content.VersionID]
+	})
+	if err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+func getObjectVersionHistoryResponse(session *models.Principal, params objectApi.GetObjectVersionHistoryParams) (*models.ObjectVersionHistory, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	history, err := getObjectVersionHistory(ctx, minioClient, params.BucketName, params.Prefix)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return history, nil
+}
+
+func getPruneObjectVersionsResponse(session *models.Principal, params objectApi.PruneObjectVersionsParams) (*models.PruneObjectVersionsResponse, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if params.Body.OlderThanDays < 0 || params.Body.KeepLatest < 0 {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, errors.New("older_than_days and keep_latest can't be negative"))
+	}
+	mClient, err := newMinioClient(session, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+	s3Client, err := newS3BucketClient(session, params.BucketName, *params.Body.Prefix, getClientIP(params.HTTPRequest))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a mc S3Client interface implementation
+	// defining the client to be used
+	mcClient := mcClient{client: s3Client}
+
+	resp, err := pruneObjectVersions(ctx, minioClient, mcClient, params.BucketName, params.Body, time.Now())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,115 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	mc "github.com/minio/mc/cmd"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_pruneObjectVersions(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	now := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	daysAgo := func(days int) time.Time {
+		return now.AddDate(0, 0, -days)
+	}
+	minioListObjectsMock = listObjectsFrom([]minio.ObjectInfo{
+		{Key: "report.pdf", VersionID: "v5", IsLatest: true, Size: 50, LastModified: daysAgo(1), UserMetadata: minio.StringMap{"X-Amz-Meta-Uploaded-By": "alice"}},
+		{Key: "report.pdf", VersionID: "v4", IsDeleteMarker: true, LastModified: daysAgo(2)},
+		{Key: "report.pdf", VersionID: "v3", Size: 30, LastModified: daysAgo(40)},
+		{Key: "report.pdf", VersionID: "v2", Size: 20, LastModified: daysAgo(50)},
+		{Key: "report.pdf", VersionID: "v1", Size: 10, LastModified: daysAgo(60)},
+		{Key: "report.pdf.bak", VersionID: "b1", IsLatest: true, Size: 5, LastModified: daysAgo(60)},
+	})
+	retainUntil := now.Add(24 * time.Hour)
+	minioGetObjectRetentionMock = func(_ context.Context, _, _, versionID string) (*minio.RetentionMode, *time.Time, error) {
+		if versionID != "v2" {
+			return nil, nil, minio.ErrorResponse{Code: "NoSuchObjectLockConfiguration"}
+		}
+		mode := minio.Governance
+		return &mode, &retainUntil, nil
+	}
+	minioGetObjectLegalHoldMock = func(_ context.Context, _, _ string, _ minio.GetObjectLegalHoldOptions) (*minio.LegalHoldStatus, error) {
+		return nil, minio.ErrorResponse{Code: "NoSuchObjectLockConfiguration"}
+	}
+	client := minioClientMock{}
+
+	history, err := getObjectVersionHistory(ctx, client, "bucket", "report.pdf")
+	assert.Nil(err)
+	assert.Len(history.Versions, 5)
+	assert.Equal(int64(5), history.TotalVersions)
+	assert.Equal(int64(1), history.DeleteMarkers)
+	assert.Equal(int64(110), history.TotalBytes)
+	assert.Equal(int64(60), history.NoncurrentBytes)
+	assert.Equal("alice", history.Versions[0].Writer)
+	assert.Equal("GOVERNANCE", history.Versions[3].RetentionMode)
+
+	_, err = getObjectVersionHistory(ctx, client, "bucket", "missing.pdf")
+	assert.ErrorIs(err, ErrNotFound)
+
+	var removed []string
+	mcListMock = func(_ context.Context, _ mc.ListOptions) <-chan *mc.ClientContent {
+		ch := make(chan *mc.ClientContent)
+		go func() {
+			defer close(ch)
+			for _, v := range []string{"v5", "v4", "v3", "v2", "v1"} {
+				ch <- &mc.ClientContent{URL: mc.ClientURL{Path: "/bucket/report.pdf"}, VersionID: v, IsLatest: v == "v5"}
+			}
+			ch <- &mc.ClientContent{URL: mc.ClientURL{Path: "/bucket/report.pdf.bak"}, VersionID: "v1"}
+		}()
+		return ch
+	}
+	mcRemoveMock = func(_ context.Context, _, _, isBypass, _ bool, contentCh <-chan *mc.ClientContent) <-chan mc.RemoveResult {
+		assert.False(isBypass)
+		for content := range contentCh {
+			removed = append(removed, content.URL.Path+"@"+content.VersionID)
+		}
+		ch := make(chan mc.RemoveResult)
+		close(ch)
+		return ch
+	}
+
+	// versions older than 30 days, keeping the 3 most recent ones; v2 is under retention
+	req := &models.PruneObjectVersionsRequest{Prefix: swag.String("report.pdf"), OlderThanDays: 30, KeepLatest: 3, DryRun: true}
+	resp, err := pruneObjectVersions(ctx, client, s3ClientMock{}, "bucket", req, now)
+	assert.Nil(err)
+	assert.Len(resp.Pruned, 1)
+	assert.Equal("v1", resp.Pruned[0].VersionID)
+	assert.Equal(int64(10), resp.FreedBytes)
+	assert.Equal([]*models.SkippedObjectVersion{{VersionID: "v2", Reason: "GOVERNANCE retention until " + retainUntil.Format(time.RFC3339)}}, resp.Skipped)
+	assert.Empty(removed)
+
+	req.DryRun = false
+	_, err = pruneObjectVersions(ctx, client, s3ClientMock{}, "bucket", req, now)
+	assert.Nil(err)
+	assert.Equal([]string{"/bucket/report.pdf@v1"}, removed)
+
+	// without limits every unlocked noncurrent version is pruned, delete markers included
+	removed = nil
+	resp, err = pruneObjectVersions(ctx, client, s3ClientMock{}, "bucket", &models.PruneObjectVersionsRequest{Prefix: swag.String("report.pdf")}, now)
+	assert.Nil(err)
+	assert.Len(resp.Pruned, 3)
+	assert.Equal([]string{"/bucket/report.pdf@v4", "/bucket/report.pdf@v3", "/bucket/report.pdf@v1"}, removed)
+}
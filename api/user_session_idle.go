@@ -0,0 +1,208 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/auth"
+	"github.com/minio/websocket"
+)
+
+const (
+	// sessionActivityInterval throttles how often the token of a session in use is re-issued
+	sessionActivityInterval = time.Minute
+	// sessionIdleWarning is how long before an idle session expires its websockets are warned
+	sessionIdleWarning = time.Minute
+)
+
+// sessionActivityTracker keeps the last time each session was used on this console, so the
+// websockets of a session see the activity of its requests
+type sessionActivityTracker struct {
+	mu     sync.Mutex
+	last   map[string]time.Time
+	pruned time.Time
+}
+
+var globalSessionActivity = &sessionActivityTracker{last: map[string]time.Time{}}
+
+// touch records the session was used, sessions idle for longer than the idle timeout are forgotten
+func (t *sessionActivityTracker) touch(sessionID string, now time.Time, idleTimeout time.Duration) {
+	if sessionID == "" {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.last[sessionID] = now
+	if now.Sub(t.pruned) < idleTimeout {
+		return
+	}
+	for id, last := range t.last {
+		if now.Sub(last) >= idleTimeout {
+			delete(t.last, id)
+		}
+	}
+	t.pruned = now
+}
+
+// lastActivity returns the last time the session was used on this console
+func (t *sessionActivityTracker) lastActivity(sessionID string) time.Time {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.last[sessionID]
+}
+
+// wsSessionConn is the connection of a websocket opened by a session, whichever handler serves it. Every message
+// the client sends counts as activity of the session, the client is warned before the session expires for being
+// idle and told once it did before the connection closes.
+//
+// The activity of a websocket is only known to the console serving it, the other consoles of a deployment see it
+// once the token of the session carries it.
+type wsSessionConn struct {
+	WSConn
+	session *models.Principal
+
+	// a websocket has a single writer, the writes of the handler and of the idle watcher take turns
+	mu     sync.Mutex
+	closed chan struct{}
+	once   sync.Once
+}
+
+func newWSSessionConn(conn WSConn, session *models.Principal) *wsSessionConn {
+	return &wsSessionConn{WSConn: conn, session: session, closed: make(chan struct{})}
+}
+
+func (c *wsSessionConn) writeMessage(messageType int, data []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.WSConn.writeMessage(messageType, data)
+}
+
+func (c *wsSessionConn) readMessage() (messageType int, p []byte, err error) {
+	messageType, p, err = c.WSConn.readMessage()
+	if err == nil && c.session != nil {
+		if idleTimeout := getSessionIdleTimeout(); idleTimeout > 0 {
+			globalSessionActivity.touch(c.session.SessionID, time.Now(), idleTimeout)
+		}
+	}
+	return messageType, p, err
+}
+
+func (c *wsSessionConn) close() error {
+	c.once.Do(func() { close(c.closed) })
+	return c.WSConn.close()
+}
+
+// watchIdle warns the client when the session is about to expire for being idle and closes the connection once it
+// did, the activity of the other requests of the session pushes the expiration back. It returns once the connection
+// is closed.
+func (c *wsSessionConn) watchIdle() {
+	if c.session == nil {
+		return
+	}
+	var warned time.Time
+	for {
+		deadline := sessionIdleDeadline(c.session)
+		if deadline.IsZero() {
+			return
+		}
+		now := time.Now()
+		wait := deadline.Add(-sessionIdleWarning).Sub(now)
+		switch {
+		case !now.Before(deadline):
+			c.send(WSResponse{SessionExpired: true})
+			c.close()
+			return
+		case wait <= 0:
+			if !warned.Equal(deadline) {
+				c.send(WSResponse{SessionExpiring: deadline.Format(time.RFC3339)})
+				warned = deadline
+			}
+			wait = deadline.Sub(now)
+		}
+		select {
+		case <-c.closed:
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+func (c *wsSessionConn) send(response WSResponse) {
+	select {
+	case <-c.closed:
+		return
+	default:
+	}
+	jsonData, err := json.Marshal(response)
+	if err != nil {
+		LogInfo("Error while marshaling the response: %s", err)
+		return
+	}
+	if err = c.writeMessage(websocket.TextMessage, jsonData); err != nil {
+		LogInfo("Error while writing the message: %s", err)
+	}
+}
+
+// sessionIdleDeadline returns when the session expires unless it's used again, the zero time
+// when sessions don't expire for being idle
+func sessionIdleDeadline(principal *models.Principal) time.Time {
+	idleTimeout := getSessionIdleTimeout()
+	if idleTimeout == 0 || principal.LastActivity == 0 {
+		return time.Time{}
+	}
+	last := time.Unix(principal.LastActivity, 0)
+	if tracked := globalSessionActivity.lastActivity(principal.SessionID); tracked.After(last) {
+		last = tracked
+	}
+	return last.Add(idleTimeout)
+}
+
+// sessionIdle returns true when the session has been idle for longer than the idle timeout
+func sessionIdle(principal *models.Principal, now time.Time) bool {
+	deadline := sessionIdleDeadline(principal)
+	return !deadline.IsZero() && !now.Before(deadline)
+}
+
+// sessionActivityDue returns true when the token of the session has to be re-issued to carry its activity
+func sessionActivityDue(claims *auth.TokenClaims, now time.Time) bool {
+	return getSessionIdleTimeout() > 0 && claims.LastActivity != 0 &&
+		now.Sub(time.Unix(claims.LastActivity, 0)) >= sessionActivityInterval
+}
+
+// touchSession records the session of the claims was used, the token of the session is re-issued
+// when it has to carry the activity
+func touchSession(claims *auth.TokenClaims, now time.Time) (*renewedSession, error) {
+	idleTimeout := getSessionIdleTimeout()
+	if idleTimeout == 0 {
+		return nil, nil
+	}
+	globalSessionActivity.touch(claims.SessionID, now, idleTimeout)
+	if !sessionActivityDue(claims, now) {
+		return nil, nil
+	}
+	touched := *claims
+	touched.LastActivity = now.Unix()
+	token, err := auth.NewEncryptedTokenFromClaims(&touched)
+	if err != nil {
+		return nil, err
+	}
+	return &renewedSession{token: token}, nil
+}
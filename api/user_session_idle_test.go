@@ -0,0 +1,163 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/auth"
+	"github.com/minio/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_sessionIdleTimeout(t *testing.T) {
+	assert := assert.New(t)
+	t.Setenv(ConsoleSessionIdleTimeout, "15m")
+	now := time.Now()
+	principal := &models.Principal{SessionID: "idle-session", LastActivity: now.Add(-20 * time.Minute).Unix()}
+
+	// Test-1 : sessions unused for longer than the idle timeout expire
+	assert.ErrorIs(validateSession(context.Background(), principal), ErrSessionIdle)
+
+	// Test-2 : the activity of the session on this console pushes the expiration back
+	globalSessionActivity.touch(principal.SessionID, now.Add(-5*time.Minute), 15*time.Minute)
+	assert.Nil(validateSession(context.Background(), principal))
+	assert.Equal(now.Add(10*time.Minute).Unix(), sessionIdleDeadline(principal).Unix())
+
+	// Test-3 : the token carries the activity once it's older than the activity interval
+	claims := &auth.TokenClaims{SessionID: "idle-session", LastActivity: now.Add(-30 * time.Second).Unix()}
+	touched, err := touchSession(claims, now)
+	assert.Nil(err)
+	assert.Nil(touched)
+	claims.LastActivity = now.Add(-2 * time.Minute).Unix()
+	touched, err = touchSession(claims, now)
+	assert.Nil(err)
+	touchedClaims, err := auth.SessionTokenAuthenticate(touched.token)
+	assert.Nil(err)
+	assert.Equal(now.Unix(), touchedClaims.LastActivity)
+	assert.Equal(now, globalSessionActivity.lastActivity("idle-session"))
+
+	// Test-4 : sessions never expire for being idle without an idle timeout
+	t.Setenv(ConsoleSessionIdleTimeout, "")
+	principal.LastActivity = now.Add(-24 * time.Hour).Unix()
+	principal.SessionID = "another-session"
+	assert.Nil(validateSession(context.Background(), principal))
+}
+
+func Test_AuthenticationMiddlewareIdleSession(t *testing.T) {
+	assert := assert.New(t)
+	t.Setenv(ConsoleSessionIdleTimeout, "15m")
+	token, err := auth.NewEncryptedTokenFromClaims(&auth.TokenClaims{
+		STSAccessKeyID: "sts-access",
+		SessionID:      "middleware-session",
+		LastActivity:   time.Now().Add(-time.Hour).Unix(),
+	})
+	assert.Nil(err)
+
+	var authorization string
+	handler := AuthenticationMiddleware(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+	}))
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/buckets", nil)
+	r.AddCookie(&http.Cookie{Name: "token", Value: token})
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+
+	// the idle session goes on as anonymous and the browser forgets it
+	assert.Equal("Bearer Anonymous", authorization)
+	cookies := w.Result().Cookies()
+	assert.Len(cookies, 1)
+	assert.Equal("token", cookies[0].Name)
+	assert.Equal(-1, cookies[0].MaxAge)
+}
+
+// idleTestConn is a websocket whose client sends the messages of reads
+type idleTestConn struct {
+	reads  chan []byte
+	writes chan WSResponse
+	closed chan struct{}
+}
+
+func (c *idleTestConn) writeMessage(_ int, data []byte) error {
+	var response WSResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return err
+	}
+	c.writes <- response
+	return nil
+}
+
+func (c *idleTestConn) readMessage() (int, []byte, error) {
+	message, ok := <-c.reads
+	if !ok {
+		return 0, nil, errors.New("closed")
+	}
+	return websocket.TextMessage, message, nil
+}
+
+func (c *idleTestConn) close() error {
+	select {
+	case <-c.closed:
+	default:
+		close(c.closed)
+	}
+	return nil
+}
+
+func (c *idleTestConn) remoteAddress() string {
+	return "127.0.0.1"
+}
+
+func Test_wsSessionConn(t *testing.T) {
+	assert := assert.New(t)
+	t.Setenv(ConsoleSessionIdleTimeout, "2s")
+	principal := &models.Principal{SessionID: "ws-session", LastActivity: time.Now().Add(-time.Minute).Unix()}
+	conn := &idleTestConn{reads: make(chan []byte, 1), writes: make(chan WSResponse, 2), closed: make(chan struct{})}
+	wsConnection := newWSSessionConn(conn, principal)
+
+	// the messages of the client count as activity of the session
+	conn.reads <- []byte(`{"mode":"objects"}`)
+	_, _, err := wsConnection.readMessage()
+	assert.Nil(err)
+	assert.False(sessionIdle(principal, time.Now()))
+
+	// the socket is warned first, then told the session expired and closed
+	go wsConnection.watchIdle()
+	warning := <-conn.writes
+	assert.NotEmpty(warning.SessionExpiring)
+	assert.False(warning.SessionExpired)
+	select {
+	case expired := <-conn.writes:
+		assert.True(expired.SessionExpired)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the idle session didn't expire")
+	}
+	select {
+	case <-conn.closed:
+	case <-time.After(time.Second):
+		t.Fatal("the socket of the idle session wasn't closed")
+	}
+	// the handler closing the socket afterwards is fine
+	assert.Nil(wsConnection.close())
+}
@@ -0,0 +1,309 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/api/operations"
+	authApi "github.com/minio/console/api/operations/auth"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/auth"
+	"github.com/minio/console/pkg/auth/idp/oauth2"
+	"github.com/minio/console/pkg/auth/session"
+	xjwt "github.com/minio/console/pkg/auth/token"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"golang.org/x/sync/singleflight"
+)
+
+func registerSessionRenewalHandlers(api *operations.ConsoleAPI) {
+	// renew the STS credentials of the current session
+	api.AuthSessionRefreshHandler = authApi.SessionRefreshHandlerFunc(func(params authApi.SessionRefreshParams, _ *models.Principal) middleware.Responder {
+		renewed, err := getSessionRefreshResponse(params)
+		if err != nil {
+			return authApi.NewSessionRefreshDefault(err.Code).WithPayload(err.APIError)
+		}
+		// Custom response writer to set the session cookies
+		return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+			setRenewedSessionCookies(w, renewed)
+			authApi.NewSessionRefreshOK().WithPayload(&models.SessionRefreshResponse{
+				Expires:    renewed.expires.Format(time.RFC3339),
+				MaxExpires: renewed.maxExpires.Format(time.RFC3339),
+			}).WriteResponse(w, p)
+		})
+	})
+}
+
+// renewedSession is a session carrying new STS credentials
+type renewedSession struct {
+	token        string
+	refreshToken string
+	expires      time.Time
+	maxExpires   time.Time
+}
+
+// newIDPRefreshTokenCookie returns the cookie keeping the refresh token the IDP issued to the session
+func newIDPRefreshTokenCookie(refreshToken string) http.Cookie {
+	return http.Cookie{
+		Path:     "/",
+		Name:     "idp-refresh-token",
+		Value:    refreshToken,
+		HttpOnly: true,
+		Secure:   len(GlobalPublicCerts) > 0,
+		SameSite: http.SameSiteLaxMode,
+	}
+}
+
+// setRenewedSessionCookies replaces the session cookie, and the refresh token of the IDP when it rotated
+func setRenewedSessionCookies(w http.ResponseWriter, renewed *renewedSession) {
+	cookie := NewSessionCookieForConsole(renewed.token)
+	http.SetCookie(w, &cookie)
+	if renewed.refreshToken != "" {
+		refreshCookie := newIDPRefreshTokenCookie(renewed.refreshToken)
+		http.SetCookie(w, &refreshCookie)
+	}
+}
+
+// sessionRenewalDue returns true when the STS credentials of a renewable session are in the last
+// quarter of their life
+func sessionRenewalDue(claims *auth.TokenClaims, now time.Time) bool {
+	if claims.SessionExpiration == 0 || claims.STSExpiration == 0 {
+		return false
+	}
+	return time.Unix(claims.STSExpiration, 0).Sub(now) < xjwt.GetConsoleSTSDuration()/4
+}
+
+// sessionRenewalPurpose binds the secret key kept in the registry to the owner of the session
+func sessionRenewalPurpose(owner string) string {
+	return "console session renewal " + owner
+}
+
+// sessionRenewalSecretKey returns the secret key the session of the principal assumes the role again with,
+// it's only kept in the registry so these sessions are not renewed when the registry is disabled
+func sessionRenewalSecretKey(ctx context.Context, principal *models.Principal) (string, error) {
+	if GlobalSessions == nil {
+		return "", ErrSessionNotRenewable
+	}
+	owner := sessionOwner(principal)
+	renewal, err := GlobalSessions.Renewal(ctx, owner, principal.SessionID)
+	if err != nil {
+		return "", err
+	}
+	if renewal == "" {
+		return "", ErrSessionNotRenewable
+	}
+	secretKey, err := auth.DecryptPayload(renewal, sessionRenewalPurpose(owner))
+	if err != nil {
+		return "", err
+	}
+	return string(secretKey), nil
+}
+
+// renewSession gets new STS credentials for the session of the claims, sessions logged in with access and
+// secret keys assume the role again while sessions of an OpenID provider use the refresh token it issued
+func renewSession(r *http.Request, claims *auth.TokenClaims) (*renewedSession, error) {
+	if claims.SessionExpiration == 0 {
+		return nil, ErrSessionNotRenewable
+	}
+	deadline := time.Unix(claims.SessionExpiration, 0)
+	duration, err := sessionRenewalDuration(deadline)
+	if err != nil {
+		return nil, err
+	}
+	client := GetConsoleHTTPClient(getClientIP(r))
+	renewed := &renewedSession{maxExpires: deadline}
+
+	var creds *credentials.Credentials
+	var idpClient *oauth2.Provider
+	var idpRefreshToken string
+	switch {
+	case claims.IDPName == "":
+		secretKey, err := sessionRenewalSecretKey(r.Context(), &models.Principal{
+			STSAccessKeyID:   claims.STSAccessKeyID,
+			STSSessionToken:  claims.STSSessionToken,
+			AccountAccessKey: claims.AccountAccessKey,
+			SessionID:        claims.SessionID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		creds = credentials.New(consoleSTSAssumeRole{
+			stsAssumeRole: &credentials.STSAssumeRole{
+				Client:      client,
+				STSEndpoint: getMinIOServer(),
+				Options: credentials.STSAssumeRoleOptions{
+					AccessKey: claims.AccountAccessKey,
+					SecretKey: secretKey,
+					Location:  GetMinIORegion(),
+				},
+			},
+			deadline: deadline,
+		})
+	default:
+		refreshToken, err := r.Cookie("idp-refresh-token")
+		if err != nil || refreshToken.Value == "" {
+			return nil, ErrSessionNotRenewable
+		}
+		idpRefreshToken = refreshToken.Value
+		provider, ok := GlobalMinIOConfig.OpenIDProviders[claims.IDPName]
+		if !ok {
+			return nil, ErrSessionNotRenewable
+		}
+		if idpClient, err = provider.GetOauth2Provider(claims.IDPName, nil, r, client); err != nil {
+			return nil, err
+		}
+		identityProvider := auth.IdentityProvider{
+			KeyFunc: provider.GetStateKeyFunc(),
+			Client:  idpClient,
+			RoleARN: provider.RoleArn,
+		}
+		if creds, err = identityProvider.RenewIdentity(r.Context(), idpRefreshToken, duration); err != nil {
+			return nil, err
+		}
+	}
+
+	tokens, err := creds.GetWithContext(&credentials.CredContext{Client: client})
+	if err != nil {
+		return nil, err
+	}
+	// the IDP may have rotated the refresh token while issuing the new id_token
+	if idpClient != nil && idpClient.RefreshToken != idpRefreshToken {
+		renewed.refreshToken = idpClient.RefreshToken
+	}
+	features := &auth.SessionFeatures{
+		HideMenu:      claims.HideMenu,
+		ObjectBrowser: claims.ObjectBrowser,
+		CustomStyleOB: claims.CustomStyleOB,
+	}
+	renewal := &auth.SessionRenewal{
+		IDPName:    claims.IDPName,
+		Expiration: deadline,
+	}
+	if renewed.token, err = auth.NewEncryptedTokenForClient(&tokens, claims.AccountAccessKey, claims.SessionID, features, renewal); err != nil {
+		return nil, err
+	}
+	renewed.expires = tokens.Expiration
+	return renewed, nil
+}
+
+// sessionRenewer renews each session once at a time, the requests made while a session is renewed wait for it and
+// get the same credentials. It remembers the sessions renewed recently, so requests still carrying their previous
+// token don't renew them again, and the ones that can't be renewed, so they're not retried on every request.
+type sessionRenewer struct {
+	group singleflight.Group
+
+	mu      sync.Mutex
+	renewed map[string]*renewedSession
+	failed  map[string]sessionRenewalFailure
+}
+
+// sessionRenewalFailure is why a session can't be renewed, until the session is over
+type sessionRenewalFailure struct {
+	err   error
+	until time.Time
+}
+
+// globalSessionRenewer renews the sessions of this console
+var globalSessionRenewer = newSessionRenewer()
+
+func newSessionRenewer() *sessionRenewer {
+	return &sessionRenewer{
+		renewed: map[string]*renewedSession{},
+		failed:  map[string]sessionRenewalFailure{},
+	}
+}
+
+// renew renews the session of the claims unless it was renewed recently or can't be renewed
+func (s *sessionRenewer) renew(r *http.Request, claims *auth.TokenClaims) (*renewedSession, error) {
+	id := claims.SessionID
+	if id == "" {
+		return renewSession(r, claims)
+	}
+	now := time.Now()
+	s.mu.Lock()
+	if failure, ok := s.failed[id]; ok && now.Before(failure.until) {
+		s.mu.Unlock()
+		return nil, failure.err
+	}
+	if renewed, ok := s.renewed[id]; ok && renewed.expires.Sub(now) > xjwt.GetConsoleSTSDuration()/4 {
+		s.mu.Unlock()
+		return renewed, nil
+	}
+	s.mu.Unlock()
+
+	v, err, _ := s.group.Do(id, func() (interface{}, error) {
+		renewed, err := renewSession(r, claims)
+		s.record(id, renewed, err, time.Unix(claims.SessionExpiration, 0), time.Now())
+		return renewed, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*renewedSession), nil
+}
+
+// record remembers how the renewal of a session went, it drops what it remembers of the sessions that are over
+func (s *sessionRenewer) record(id string, renewed *renewedSession, err error, sessionEnd, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for k, v := range s.renewed {
+		if !now.Before(v.maxExpires) || !now.Before(v.expires) {
+			delete(s.renewed, k)
+		}
+	}
+	for k, v := range s.failed {
+		if !now.Before(v.until) {
+			delete(s.failed, k)
+		}
+	}
+	switch {
+	case err == nil:
+		s.renewed[id] = renewed
+	case errors.Is(err, ErrSessionMaxLifetime), errors.Is(err, ErrSessionNotRenewable), errors.Is(err, session.ErrSessionRevoked):
+		// sessions that can't be renewed are not retried until they're over, transient errors are
+		delete(s.renewed, id)
+		if !sessionEnd.After(now) {
+			sessionEnd = now.Add(xjwt.GetConsoleSTSDuration())
+		}
+		s.failed[id] = sessionRenewalFailure{err: err, until: sessionEnd}
+	}
+}
+
+// getSessionRefreshResponse renews the session of the request
+func getSessionRefreshResponse(params authApi.SessionRefreshParams) (*renewedSession, *CodedAPIError) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	token, err := auth.GetTokenFromRequest(params.HTTPRequest)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, ErrInvalidSession)
+	}
+	claims, err := auth.SessionTokenAuthenticate(token)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, ErrInvalidSession)
+	}
+	renewed, err := globalSessionRenewer.renew(params.HTTPRequest, claims)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return renewed, nil
+}
@@ -0,0 +1,231 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/auth"
+	"github.com/minio/console/pkg/auth/idp/oauth2"
+	"github.com/minio/console/pkg/auth/session"
+	xjwt "github.com/minio/console/pkg/auth/token"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/stretchr/testify/assert"
+)
+
+// newMockAssumeRoleServer returns a MinIO answering AssumeRole with new credentials every time
+func newMockAssumeRoleServer(t *testing.T) (*httptest.Server, *int32) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.Form.Get("Action") != "AssumeRole" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		n := atomic.AddInt32(&calls, 1)
+		fmt.Fprintf(w, `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <Credentials>
+      <AccessKeyId>sts-access-%d</AccessKeyId>
+      <SecretAccessKey>sts-secret</SecretAccessKey>
+      <SessionToken>sts-token</SessionToken>
+      <Expiration>%s</Expiration>
+    </Credentials>
+  </AssumeRoleResult>
+</AssumeRoleResponse>`, n, time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func Test_renewSession(t *testing.T) {
+	assert := assert.New(t)
+	server, _ := newMockAssumeRoleServer(t)
+	t.Setenv(oauth2.ConsoleMinIOServer, server.URL)
+	t.Setenv(xjwt.ConsoleSTSDuration, "1h")
+	t.Setenv(ConsoleSessionMaxLifetime, "24h")
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/session/refresh", nil)
+	GlobalSessions = session.NewRegistry(session.NewMemoryStore(), 0)
+	defer func() { GlobalSessions = nil }()
+
+	consoleCreds, err := getConsoleCredentials("user", "password", GetConsoleHTTPClient(""))
+	assert.Nil(err)
+	token, err := login(consoleCreds, &auth.SessionFeatures{HideMenu: true}, r)
+	assert.Nil(err)
+	claims, err := auth.SessionTokenAuthenticate(*token)
+	assert.Nil(err)
+	assert.Equal("sts-access-1", claims.STSAccessKeyID)
+	assert.NotContains(*token, "password")
+	secretKey, err := sessionRenewalSecretKey(r.Context(), &models.Principal{AccountAccessKey: "user", SessionID: claims.SessionID})
+	assert.Nil(err)
+	assert.Equal("password", secretKey)
+	assert.InDelta(time.Now().Add(24*time.Hour).Unix(), claims.SessionExpiration, 5)
+	assert.False(sessionRenewalDue(claims, time.Now()))
+	assert.True(sessionRenewalDue(claims, time.Now().Add(55*time.Minute)))
+
+	// Test-1 : the session gets new credentials and keeps its features and its end
+	renewed, err := renewSession(r, claims)
+	assert.Nil(err)
+	renewedClaims, err := auth.SessionTokenAuthenticate(renewed.token)
+	assert.Nil(err)
+	assert.Equal("sts-access-2", renewedClaims.STSAccessKeyID)
+	assert.Equal("user", renewedClaims.AccountAccessKey)
+	assert.True(renewedClaims.HideMenu)
+	assert.Equal(claims.SessionExpiration, renewedClaims.SessionExpiration)
+	assert.Equal(time.Unix(claims.SessionExpiration, 0), renewed.maxExpires)
+
+	// Test-2 : sessions past their maximum lifetime are over
+	claims.SessionExpiration = time.Now().Add(-time.Minute).Unix()
+	_, err = renewSession(r, claims)
+	assert.ErrorIs(err, ErrSessionMaxLifetime)
+	assert.ErrorIs(validateSession(r.Context(), &models.Principal{SessionExpiration: claims.SessionExpiration}), ErrSessionMaxLifetime)
+
+	// Test-3 : revoked sessions are not renewed
+	token, err = login(consoleCreds, nil, r)
+	assert.Nil(err)
+	claims, err = auth.SessionTokenAuthenticate(*token)
+	assert.Nil(err)
+	assert.Nil(GlobalSessions.Revoke(r.Context(), "user", claims.SessionID))
+	_, err = renewSession(r, claims)
+	assert.ErrorIs(err, session.ErrSessionRevoked)
+
+	// Test-4 : sessions of access and secret keys are not renewed without the registry keeping the secret key
+	GlobalSessions = nil
+	token, err = login(consoleCreds, nil, r)
+	assert.Nil(err)
+	claims, err = auth.SessionTokenAuthenticate(*token)
+	assert.Nil(err)
+	assert.Zero(claims.SessionExpiration)
+	_, err = renewSession(r, claims)
+	assert.ErrorIs(err, ErrSessionNotRenewable)
+
+	// Test-5 : sessions are not renewed unless the maximum lifetime is set
+	t.Setenv(ConsoleSessionMaxLifetime, "")
+	GlobalSessions = session.NewRegistry(session.NewMemoryStore(), 0)
+	token, err = login(consoleCreds, nil, r)
+	assert.Nil(err)
+	claims, err = auth.SessionTokenAuthenticate(*token)
+	assert.Nil(err)
+	_, err = renewSession(r, claims)
+	assert.ErrorIs(err, ErrSessionNotRenewable)
+}
+
+func Test_getConsoleCredentialsFromRenewableSession(t *testing.T) {
+	assert := assert.New(t)
+	server, calls := newMockAssumeRoleServer(t)
+	t.Setenv(oauth2.ConsoleMinIOServer, server.URL)
+	GlobalSessions = session.NewRegistry(session.NewMemoryStore(), 0)
+	defer func() { GlobalSessions = nil }()
+	sessionID, err := registerSession(nil, credentials.Value{}, "user", "password", time.Now().Add(24*time.Hour))
+	assert.Nil(err)
+	principal := &models.Principal{
+		STSAccessKeyID:     "sts-access",
+		STSSecretAccessKey: "sts-secret",
+		STSSessionToken:    "sts-token",
+		AccountAccessKey:   "user",
+		SessionID:          sessionID,
+		StsExpiration:      time.Now().Add(time.Hour).Unix(),
+		SessionExpiration:  time.Now().Add(24 * time.Hour).Unix(),
+	}
+
+	// Test-1 : the credentials of the session are used while they're valid
+	value, err := getConsoleCredentialsFromSession(principal).GetWithContext(&credentials.CredContext{Client: http.DefaultClient})
+	assert.Nil(err)
+	assert.Equal("sts-access", value.AccessKeyID)
+	assert.Equal(int32(0), atomic.LoadInt32(calls))
+
+	// Test-2 : expired credentials are renewed
+	principal.StsExpiration = time.Now().Add(-time.Minute).Unix()
+	value, err = getConsoleCredentialsFromSession(principal).GetWithContext(&credentials.CredContext{Client: http.DefaultClient})
+	assert.Nil(err)
+	assert.Equal("sts-access-1", value.AccessKeyID)
+
+	// Test-3 : but not past the end of the session
+	principal.SessionExpiration = time.Now().Add(-time.Minute).Unix()
+	_, err = getConsoleCredentialsFromSession(principal).GetWithContext(&credentials.CredContext{Client: http.DefaultClient})
+	assert.ErrorIs(err, ErrSessionMaxLifetime)
+
+	// Test-4 : nor once the session was revoked
+	principal.SessionExpiration = time.Now().Add(24 * time.Hour).Unix()
+	assert.Nil(GlobalSessions.Revoke(context.Background(), "user", sessionID))
+	_, err = getConsoleCredentialsFromSession(principal).GetWithContext(&credentials.CredContext{Client: http.DefaultClient})
+	assert.ErrorIs(err, session.ErrSessionRevoked)
+	assert.Equal(int32(1), atomic.LoadInt32(calls))
+}
+
+func Test_sessionRenewer(t *testing.T) {
+	assert := assert.New(t)
+	server, calls := newMockAssumeRoleServer(t)
+	t.Setenv(oauth2.ConsoleMinIOServer, server.URL)
+	t.Setenv(xjwt.ConsoleSTSDuration, "1h")
+	t.Setenv(ConsoleSessionMaxLifetime, "24h")
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/session/refresh", nil)
+	GlobalSessions = session.NewRegistry(session.NewMemoryStore(), 0)
+	defer func() { GlobalSessions = nil }()
+
+	consoleCreds, err := getConsoleCredentials("user", "password", GetConsoleHTTPClient(""))
+	assert.Nil(err)
+	token, err := login(consoleCreds, nil, r)
+	assert.Nil(err)
+	claims, err := auth.SessionTokenAuthenticate(*token)
+	assert.Nil(err)
+	renewer := newSessionRenewer()
+
+	// Test-1 : concurrent requests renew the session once and get the same credentials
+	var wg sync.WaitGroup
+	tokens := make([]string, 8)
+	for i := range tokens {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			renewed, err := renewer.renew(r, claims)
+			assert.Nil(err)
+			if renewed != nil {
+				tokens[i] = renewed.token
+			}
+		}(i)
+	}
+	wg.Wait()
+	assert.Equal(int32(2), atomic.LoadInt32(calls))
+	for _, token := range tokens {
+		assert.Equal(tokens[0], token)
+	}
+
+	// Test-2 : requests still carrying the previous token get the credentials it was renewed with
+	renewed, err := renewer.renew(r, claims)
+	assert.Nil(err)
+	assert.Equal(tokens[0], renewed.token)
+	assert.Equal(int32(2), atomic.LoadInt32(calls))
+
+	// Test-3 : sessions that can't be renewed are not retried
+	claims.SessionID = "over"
+	claims.SessionExpiration = time.Now().Add(-time.Minute).Unix()
+	_, err = renewer.renew(r, claims)
+	assert.ErrorIs(err, ErrSessionMaxLifetime)
+	claims.SessionExpiration = time.Now().Add(time.Hour).Unix()
+	_, err = renewer.renew(r, claims)
+	assert.ErrorIs(err, ErrSessionMaxLifetime)
+	assert.Equal(int32(2), atomic.LoadInt32(calls))
+}
@@ -44,7 +44,7 @@ const (
 
 type wsMinioClient struct {
 	// websocket connection.
-	conn wsConn
+	conn WSConn
 	// MinIO admin Client
 	client minioClient
 }
@@ -104,6 +104,13 @@ func serveWS(w http.ResponseWriter, req *http.Request) {
 		errorsApi.ServeError(w, req, errorsApi.New(http.StatusUnauthorized, "%v", err))
 		return
 	}
+	if session != nil {
+		if err = validateSession(ctx, session); err != nil {
+			ErrorWithContext(ctx, err)
+			errorsApi.ServeError(w, req, errorsApi.New(http.StatusUnauthorized, "%v", err))
+			return
+		}
+	}
 
 	// If we are using a subpath we are most likely behind a reverse proxy so we most likely
 	// can't validate the proper Origin since we don't know the source domain, so we are going
@@ -132,15 +139,19 @@ func serveWS(w http.ResponseWriter, req *http.Request) {
 		}
 	}
 
+	// the messages of every websocket count as activity of its session, which closes it once idle for too long
+	wsConnection := newWSSessionConn(wsConn{conn: conn}, session)
+
 	switch {
 	case strings.HasPrefix(wsPath, `/objectManager`):
-		wsMinioClient, err := newWebSocketMinioClient(conn, session, clientIP)
+		wsMinioClient, err := newWebSocketMinioClient(wsConnection, session, clientIP)
 		if err != nil {
 			ErrorWithContext(ctx, err)
 			closeWsConn(conn)
 			return
 		}
 
+		go wsConnection.watchIdle()
 		go wsMinioClient.objectManager(session)
 	default:
 		// path not found
@@ -148,16 +159,13 @@ func serveWS(w http.ResponseWriter, req *http.Request) {
 	}
 }
 
-func newWebSocketMinioClient(conn *websocket.Conn, claims *models.Principal, clientIP string) (*wsMinioClient, error) {
+func newWebSocketMinioClient(wsConnection WSConn, claims *models.Principal, clientIP string) (*wsMinioClient, error) {
 	mClient, err := newMinioClient(claims, clientIP)
 	if err != nil {
 		LogError("error creating MinioClient:", err)
 		return nil, err
 	}
 
-	// create a websocket connection interface implementation
-	// defining the connection to be used
-	wsConnection := wsConn{conn: conn}
 	// create a minioClient interface implementation
 	// defining the client to be used
 	minioClient := minioClient{client: mClient}
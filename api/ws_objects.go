@@ -31,6 +31,8 @@ import (
 func (wsc *wsMinioClient) objectManager(session *models.Principal) {
 	// Storage of Cancel Contexts for this connection
 	var cancelContexts sync.Map
+	// Requests running in the background, these are not cancelled by new listings
+	var backgroundRequests sync.Map
 	// Initial goroutine
 	defer func() {
 		// We close socket at the end of requests
@@ -56,7 +58,17 @@ func (wsc *wsMinioClient) objectManager(session *models.Principal) {
 
 	// Read goroutine
 	go func() {
+		// requests running in the background
+		var wg sync.WaitGroup
 		defer close(writeChannel)
+		defer func() {
+			// background requests must stop writing before the write channel is closed
+			cancelContexts.Range(func(_, value interface{}) bool {
+				value.(context.CancelFunc)()
+				return true
+			})
+			wg.Wait()
+		}()
 
 		for {
 			select {
@@ -91,6 +103,9 @@ func (wsc *wsMinioClient) objectManager(session *models.Principal) {
 					// cancel all previous open objects requests for listing
 					cancelContexts.Range(func(key, value interface{}) bool {
 						rid := key.(int64)
+						if _, ok := backgroundRequests.Load(rid); ok {
+							return true
+						}
 						if rid < messageRequest.RequestID {
 							cancelFunc := value.(context.CancelFunc)
 							cancelFunc()
@@ -182,6 +197,28 @@ func (wsc *wsMinioClient) objectManager(session *models.Principal) {
 						cancelFunc.(context.CancelFunc)()
 						cancelContexts.Delete(messageRequest.RequestID)
 					}
+				case "usage", "diff", "mirror", "rewind-diff":
+					// walk the buckets in the background so the request can be cancelled
+					backgroundRequests.Store(messageRequest.RequestID, true)
+					wg.Add(1)
+					go func(ctx context.Context, request ObjectsRequest) {
+						defer wg.Done()
+						defer backgroundRequests.Delete(request.RequestID)
+						switch request.Mode {
+						case "usage":
+							streamPrefixUsage(ctx, wsc.client, session, request, sendWSResponse)
+						case "diff":
+							streamObjectsDiff(ctx, wsc.client, request, sendWSResponse)
+						case "rewind-diff":
+							streamRewindDiff(ctx, session, wsc.conn.remoteAddress(), request, sendWSResponse)
+						default:
+							streamMirrorJob(ctx, session, request, sendWSResponse)
+						}
+
+						if cancelFunc, ok := cancelContexts.LoadAndDelete(request.RequestID); ok {
+							cancelFunc.(context.CancelFunc)()
+						}
+					}(ctx, messageRequest)
 				case "rewind":
 					// start listing and writing to web socket
 					objectRqConfigs, err := getObjectsOptionsFromReq(messageRequest)
@@ -0,0 +1,390 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+)
+
+const (
+	// defaultUsageDepth is the number of prefix levels reported when the request doesn't specify one
+	defaultUsageDepth = 1
+	// maxUsageDepth limits how many prefix levels can be aggregated in a single walk
+	maxUsageDepth = 10
+	// maxUsagePrefixes is the number of prefixes sent back, the largest prefixes are kept
+	maxUsagePrefixes = 1000
+	// usageProgressInterval is how often partial results are streamed while walking the bucket
+	usageProgressInterval = 2 * time.Second
+
+	usageDay = 24 * time.Hour
+)
+
+type usageHistogramRange struct {
+	label string
+	upper int64 // exclusive upper bound, 0 means unbounded
+}
+
+var (
+	usageSizeRanges = []usageHistogramRange{
+		{"LESS_THAN_1_KiB", 1 << 10},
+		{"1_KiB_TO_1_MiB", 1 << 20},
+		{"1_MiB_TO_10_MiB", 10 << 20},
+		{"10_MiB_TO_64_MiB", 64 << 20},
+		{"64_MiB_TO_128_MiB", 128 << 20},
+		{"128_MiB_TO_512_MiB", 512 << 20},
+		{"GREATER_THAN_512_MiB", 0},
+	}
+	usageAgeRanges = []usageHistogramRange{
+		{"LESS_THAN_1_DAY", int64(usageDay)},
+		{"1_TO_7_DAYS", int64(7 * usageDay)},
+		{"7_TO_30_DAYS", int64(30 * usageDay)},
+		{"30_TO_90_DAYS", int64(90 * usageDay)},
+		{"90_TO_365_DAYS", int64(365 * usageDay)},
+		{"MORE_THAN_1_YEAR", 0},
+	}
+)
+
+type prefixUsageOpts struct {
+	BucketName string
+	Prefix     string
+	Depth      int
+	Now        time.Time
+}
+
+// PrefixUsage aggregates the objects found under a prefix
+type PrefixUsage struct {
+	Prefix          string `json:"prefix"`
+	Size            int64  `json:"size"`
+	Objects         int64  `json:"objects"`
+	Versions        int64  `json:"versions"`
+	DeleteMarkers   int64  `json:"delete_markers"`
+	NoncurrentBytes int64  `json:"noncurrent_bytes"`
+}
+
+// UsageHistogramBucket counts the current object versions that fall in a size or age range
+type UsageHistogramBucket struct {
+	Label   string `json:"label"`
+	Objects int64  `json:"objects"`
+	Size    int64  `json:"size"`
+}
+
+// PrefixUsageReport is streamed over the objectManager websocket for `usage` requests
+type PrefixUsageReport struct {
+	BucketName    string                 `json:"bucket_name"`
+	Prefix        string                 `json:"prefix"`
+	Depth         int                    `json:"depth"`
+	Total         PrefixUsage            `json:"total"`
+	Prefixes      []PrefixUsage          `json:"prefixes"`
+	Truncated     bool                   `json:"truncated,omitempty"`
+	SizeHistogram []UsageHistogramBucket `json:"size_histogram"`
+	AgeHistogram  []UsageHistogramBucket `json:"age_histogram"`
+	Complete      bool                   `json:"complete"`
+	Cached        bool                   `json:"cached,omitempty"`
+	GeneratedAt   string                 `json:"generated_at"`
+}
+
+func getPrefixUsageOptsFromReq(request ObjectsRequest) (*prefixUsageOpts, error) {
+	if request.BucketName == "" {
+		return nil, ErrBucketNameNotInRequest
+	}
+	depth := request.Depth
+	if depth == 0 {
+		depth = defaultUsageDepth
+	}
+	if depth < 0 || depth > maxUsageDepth {
+		return nil, fmt.Errorf("depth must be between 1 and %d", maxUsageDepth)
+	}
+	return &prefixUsageOpts{
+		BucketName: request.BucketName,
+		Prefix:     request.Prefix,
+		Depth:      depth,
+		Now:        time.Now(),
+	}, nil
+}
+
+func (u *PrefixUsage) add(obj minio.ObjectInfo) {
+	switch {
+	case obj.IsDeleteMarker:
+		u.DeleteMarkers++
+	case obj.IsLatest:
+		u.Objects++
+		u.Versions++
+		u.Size += obj.Size
+	default:
+		u.Versions++
+		u.NoncurrentBytes += obj.Size
+	}
+}
+
+func newUsageHistogram(ranges []usageHistogramRange) []UsageHistogramBucket {
+	histogram := make([]UsageHistogramBucket, len(ranges))
+	for i, r := range ranges {
+		histogram[i].Label = r.label
+	}
+	return histogram
+}
+
+func addToUsageHistogram(histogram []UsageHistogramBucket, ranges []usageHistogramRange, value, size int64) {
+	for i, r := range ranges {
+		if r.upper == 0 || value < r.upper {
+			histogram[i].Objects++
+			histogram[i].Size += size
+			return
+		}
+	}
+}
+
+// usagePrefixes returns the prefixes of key relative to the walked prefix up to depth levels,
+// e.g. `a/b/c/file` with depth 2 is accounted in `a/` and `a/b/`
+func usagePrefixes(basePrefix, key string, depth int) []string {
+	rel := strings.TrimPrefix(key, basePrefix)
+	var prefixes []string
+	for level := 0; level < depth; level++ {
+		idx := strings.Index(rel, SlashSeparator)
+		if idx < 0 {
+			break
+		}
+		offset := len(key) - len(rel)
+		prefixes = append(prefixes, key[:offset+idx+1])
+		rel = rel[idx+1:]
+	}
+	return prefixes
+}
+
+// usageLarger orders the prefixes by the space they use, noncurrent versions included, larger first
+func usageLarger(a, b PrefixUsage) bool {
+	sa, sb := a.Size+a.NoncurrentBytes, b.Size+b.NoncurrentBytes
+	if sa != sb {
+		return sa > sb
+	}
+	return a.Prefix < b.Prefix
+}
+
+// usageHeap holds the largest prefixes walked so far, the smallest of them on top
+type usageHeap []PrefixUsage
+
+func (h usageHeap) Len() int           { return len(h) }
+func (h usageHeap) Less(i, j int) bool { return usageLarger(h[j], h[i]) }
+func (h usageHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *usageHeap) Push(x any)        { *h = append(*h, x.(PrefixUsage)) }
+
+func (h *usageHeap) Pop() any {
+	old := *h
+	usage := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return usage
+}
+
+// prefixUsageWalk aggregates the objects of a walk. Objects are listed in lexical order so every object
+// under a prefix is listed before the walk moves past it: only the prefixes of the current key are still
+// growing, the others are complete and only the largest ones are kept. The usage of the prefixes that
+// don't make it to the report stays accounted in their parent prefixes and in the total.
+type prefixUsageWalk struct {
+	opts  *prefixUsageOpts
+	total PrefixUsage
+	// active holds the prefixes of the last key walked, one per level
+	active []*PrefixUsage
+	// top holds the largest complete prefixes, up to limit of them
+	top           usageHeap
+	limit         int
+	truncated     bool
+	sizeHistogram []UsageHistogramBucket
+	ageHistogram  []UsageHistogramBucket
+}
+
+func newPrefixUsageWalk(opts *prefixUsageOpts, limit int) *prefixUsageWalk {
+	return &prefixUsageWalk{
+		opts:          opts,
+		limit:         limit,
+		sizeHistogram: newUsageHistogram(usageSizeRanges),
+		ageHistogram:  newUsageHistogram(usageAgeRanges),
+	}
+}
+
+func (w *prefixUsageWalk) add(obj minio.ObjectInfo) {
+	w.total.add(obj)
+	prefixes := usagePrefixes(w.opts.Prefix, obj.Key, w.opts.Depth)
+	level := 0
+	for level < len(prefixes) && level < len(w.active) && w.active[level].Prefix == prefixes[level] {
+		level++
+	}
+	w.complete(level)
+	for _, prefix := range prefixes[level:] {
+		w.active = append(w.active, &PrefixUsage{Prefix: prefix})
+	}
+	for _, usage := range w.active {
+		usage.add(obj)
+	}
+	if obj.IsLatest && !obj.IsDeleteMarker {
+		addToUsageHistogram(w.sizeHistogram, usageSizeRanges, obj.Size, obj.Size)
+		addToUsageHistogram(w.ageHistogram, usageAgeRanges, int64(w.opts.Now.Sub(obj.LastModified)), obj.Size)
+	}
+}
+
+// complete moves the active prefixes from level down to the largest prefixes, the walk moved past them
+func (w *prefixUsageWalk) complete(level int) {
+	for _, usage := range w.active[level:] {
+		heap.Push(&w.top, *usage)
+		if w.top.Len() > w.limit {
+			heap.Pop(&w.top)
+			w.truncated = true
+		}
+	}
+	w.active = w.active[:level]
+}
+
+func (w *prefixUsageWalk) report(complete bool) *PrefixUsageReport {
+	if complete {
+		w.complete(0)
+	}
+	prefixes := make([]PrefixUsage, 0, len(w.top)+len(w.active))
+	prefixes = append(prefixes, w.top...)
+	for _, usage := range w.active {
+		prefixes = append(prefixes, *usage)
+	}
+	// largest prefixes first
+	sort.Slice(prefixes, func(i, j int) bool {
+		return usageLarger(prefixes[i], prefixes[j])
+	})
+	report := &PrefixUsageReport{
+		BucketName:    w.opts.BucketName,
+		Prefix:        w.opts.Prefix,
+		Depth:         w.opts.Depth,
+		Total:         w.total,
+		Prefixes:      prefixes,
+		Truncated:     w.truncated,
+		SizeHistogram: append([]UsageHistogramBucket{}, w.sizeHistogram...),
+		AgeHistogram:  append([]UsageHistogramBucket{}, w.ageHistogram...),
+		Complete:      complete,
+		GeneratedAt:   w.opts.Now.UTC().Format(time.RFC3339),
+	}
+	report.Total.Prefix = w.opts.Prefix
+	if len(report.Prefixes) > w.limit {
+		report.Prefixes = report.Prefixes[:w.limit]
+		report.Truncated = true
+	}
+	return report
+}
+
+// computePrefixUsage walks every object version under the prefix and aggregates the usage per
+// prefix, progress is called periodically with partial results
+func computePrefixUsage(ctx context.Context, client MinioClient, opts *prefixUsageOpts, progress func(*PrefixUsageReport)) (*PrefixUsageReport, error) {
+	walk := newPrefixUsageWalk(opts, maxUsagePrefixes)
+	lastProgress := time.Now()
+	for obj := range client.listObjects(ctx, opts.BucketName, minio.ListObjectsOptions{
+		Prefix:       opts.Prefix,
+		Recursive:    true,
+		WithVersions: true,
+	}) {
+		if obj.Err != nil {
+			return nil, obj.Err
+		}
+		walk.add(obj)
+		if progress != nil && time.Since(lastProgress) >= usageProgressInterval {
+			progress(walk.report(false))
+			lastProgress = time.Now()
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return walk.report(true), nil
+}
+
+type prefixUsageCacheEntry struct {
+	report  *PrefixUsageReport
+	expires time.Time
+}
+
+// prefixUsageCache keeps completed reports so repeated views of the same bucket don't walk it again
+type prefixUsageCache struct {
+	mu      sync.Mutex
+	entries map[string]prefixUsageCacheEntry
+}
+
+var globalPrefixUsageCache = &prefixUsageCache{entries: map[string]prefixUsageCacheEntry{}}
+
+func prefixUsageCacheKey(session *models.Principal, opts *prefixUsageOpts) string {
+	return fmt.Sprintf("%s/%s/%s/%d", sessionCacheKey(session), opts.BucketName, opts.Prefix, opts.Depth)
+}
+
+func (c *prefixUsageCache) get(key string, now time.Time) (*PrefixUsageReport, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || now.After(entry.expires) {
+		return nil, false
+	}
+	report := *entry.report
+	report.Cached = true
+	return &report, true
+}
+
+func (c *prefixUsageCache) set(key string, report *PrefixUsageReport, now time.Time, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// drop expired entries so the cache doesn't grow with every bucket visited
+	for k, entry := range c.entries {
+		if now.After(entry.expires) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = prefixUsageCacheEntry{report: report, expires: now.Add(ttl)}
+}
+
+// streamPrefixUsage handles `usage` requests of the objectManager websocket
+func streamPrefixUsage(ctx context.Context, client MinioClient, session *models.Principal, request ObjectsRequest, send func(WSResponse)) {
+	sendError := func(err error) {
+		send(WSResponse{
+			RequestID:  request.RequestID,
+			Error:      ErrorWithContext(ctx, err),
+			Prefix:     request.Prefix,
+			BucketName: request.BucketName,
+		})
+	}
+	opts, err := getPrefixUsageOptsFromReq(request)
+	if err != nil {
+		sendError(err)
+		return
+	}
+	key := prefixUsageCacheKey(session, opts)
+	report, ok := globalPrefixUsageCache.get(key, opts.Now)
+	if !ok || request.Refresh {
+		report, err = computePrefixUsage(ctx, client, opts, func(partial *PrefixUsageReport) {
+			send(WSResponse{RequestID: request.RequestID, Usage: partial})
+		})
+		if err != nil {
+			sendError(err)
+			return
+		}
+		globalPrefixUsageCache.set(key, report, time.Now(), getPrefixUsageCacheTTL())
+	}
+	send(WSResponse{RequestID: request.RequestID, Usage: report})
+	send(WSResponse{RequestID: request.RequestID, RequestEnd: true})
+}
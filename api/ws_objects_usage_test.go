@@ -0,0 +1,183 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+func listObjectsFrom(objects []minio.ObjectInfo) func(ctx context.Context, bucket string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+	return func(_ context.Context, _ string, _ minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		objectStatCh := make(chan minio.ObjectInfo, len(objects))
+		for _, obj := range objects {
+			objectStatCh <- obj
+		}
+		close(objectStatCh)
+		return objectStatCh
+	}
+}
+
+func Test_usagePrefixes(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal([]string{"a/", "a/b/"}, usagePrefixes("", "a/b/c/file", 2))
+	assert.Equal([]string{"a/", "a/b/", "a/b/c/"}, usagePrefixes("", "a/b/c/file", 5))
+	assert.Equal([]string{"logs/a/"}, usagePrefixes("logs/", "logs/a/b/file", 1))
+	assert.Empty(usagePrefixes("logs/", "logs/file", 1))
+}
+
+func Test_computePrefixUsage(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	now := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	minioListObjectsMock = listObjectsFrom([]minio.ObjectInfo{
+		{Key: "a/1.txt", Size: 100, IsLatest: true, LastModified: now.Add(-time.Hour)},
+		{Key: "a/1.txt", Size: 50, LastModified: now.Add(-48 * time.Hour)},
+		{Key: "a/b/2.bin", Size: 20 << 20, IsLatest: true, LastModified: now.Add(-400 * usageDay)},
+		{Key: "c/3.txt", IsLatest: true, IsDeleteMarker: true, LastModified: now},
+		{Key: "c/3.txt", Size: 10, LastModified: now.Add(-10 * usageDay)},
+		{Key: "root.txt", Size: 1, IsLatest: true, LastModified: now},
+	})
+
+	report, err := computePrefixUsage(ctx, minioClientMock{}, &prefixUsageOpts{BucketName: "bucket", Depth: 1, Now: now}, nil)
+	assert.Nil(err)
+	assert.True(report.Complete)
+	assert.Equal(PrefixUsage{Size: 100 + 20<<20 + 1, Objects: 3, Versions: 5, DeleteMarkers: 1, NoncurrentBytes: 60}, report.Total)
+	assert.Equal([]PrefixUsage{
+		{Prefix: "a/", Size: 100 + 20<<20, Objects: 2, Versions: 3, NoncurrentBytes: 50},
+		{Prefix: "c/", Versions: 1, DeleteMarkers: 1, NoncurrentBytes: 10},
+	}, report.Prefixes)
+	assert.Equal(int64(2), report.SizeHistogram[0].Objects)
+	assert.Equal(int64(1), report.SizeHistogram[3].Objects)
+	assert.Equal(int64(2), report.AgeHistogram[0].Objects)
+	assert.Equal(int64(1), report.AgeHistogram[5].Objects)
+
+	report, err = computePrefixUsage(ctx, minioClientMock{}, &prefixUsageOpts{BucketName: "bucket", Depth: 2, Now: now}, nil)
+	assert.Nil(err)
+	assert.Len(report.Prefixes, 3)
+	assert.Equal("a/b/", report.Prefixes[1].Prefix)
+
+	minioListObjectsMock = listObjectsFrom([]minio.ObjectInfo{{Err: errors.New("access denied")}})
+	_, err = computePrefixUsage(ctx, minioClientMock{}, &prefixUsageOpts{BucketName: "bucket", Depth: 1, Now: now}, nil)
+	assert.Equal("access denied", err.Error())
+}
+
+func Test_prefixUsageWalk(t *testing.T) {
+	assert := assert.New(t)
+	walk := newPrefixUsageWalk(&prefixUsageOpts{BucketName: "bucket", Depth: 2}, 2)
+	for _, obj := range []minio.ObjectInfo{
+		{Key: "a/x/1", Size: 5, IsLatest: true},
+		{Key: "a/y/1", Size: 1, IsLatest: true},
+		{Key: "b/1", Size: 3, IsLatest: true},
+		{Key: "c/x/1", Size: 4, IsLatest: true},
+	} {
+		walk.add(obj)
+		// only the largest complete prefixes are kept along with the ones of the last key
+		assert.LessOrEqual(walk.top.Len(), 2)
+		assert.LessOrEqual(len(walk.active), 2)
+	}
+
+	// Test-1 : partial reports include the prefixes still being walked
+	partial := walk.report(false)
+	assert.False(partial.Complete)
+	assert.Equal([]string{"a/", "a/x/"}, []string{partial.Prefixes[0].Prefix, partial.Prefixes[1].Prefix})
+	assert.True(partial.Truncated)
+
+	// Test-2 : the smaller prefixes are left out, their usage stays in the total
+	report := walk.report(true)
+	assert.Equal([]PrefixUsage{
+		{Prefix: "a/", Size: 6, Objects: 2, Versions: 2},
+		{Prefix: "a/x/", Size: 5, Objects: 1, Versions: 1},
+	}, report.Prefixes)
+	assert.True(report.Truncated)
+	assert.Equal(int64(13), report.Total.Size)
+	assert.Empty(walk.active)
+}
+
+func Test_streamPrefixUsage(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	session := &models.Principal{AccountAccessKey: "usage-test", SessionID: "usage-session"}
+	walks := 0
+	minioListObjectsMock = func(_ context.Context, _ string, _ minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		walks++
+		return listObjectsFrom([]minio.ObjectInfo{{Key: "a/1.txt", Size: 100, IsLatest: true}})(ctx, "", minio.ListObjectsOptions{})
+	}
+	request := ObjectsRequest{Mode: "usage", BucketName: "bucket", RequestID: 1}
+	var frames []WSResponse
+	send := func(r WSResponse) {
+		frames = append(frames, r)
+	}
+
+	streamPrefixUsage(ctx, minioClientMock{}, session, request, send)
+	assert.Len(frames, 2)
+	assert.Equal(int64(100), frames[0].Usage.Total.Size)
+	assert.False(frames[0].Usage.Cached)
+	assert.True(frames[1].RequestEnd)
+
+	// the second request is served from the cache
+	frames = nil
+	streamPrefixUsage(ctx, minioClientMock{}, session, request, send)
+	assert.Equal(1, walks)
+	assert.True(frames[0].Usage.Cached)
+
+	// other sessions of the same user walk the bucket with their own credentials
+	frames = nil
+	streamPrefixUsage(ctx, minioClientMock{}, &models.Principal{AccountAccessKey: "usage-test", SessionID: "other-usage-session"}, request, send)
+	assert.Equal(2, walks)
+	assert.False(frames[0].Usage.Cached)
+
+	// the session is served from the cache unless a refresh is requested
+	frames = nil
+	request.Refresh = true
+	streamPrefixUsage(ctx, minioClientMock{}, session, request, send)
+	assert.Equal(3, walks)
+	assert.False(frames[0].Usage.Cached)
+
+	frames = nil
+	request.Depth = maxUsageDepth + 1
+	streamPrefixUsage(ctx, minioClientMock{}, session, request, send)
+	assert.Len(frames, 1)
+	assert.NotNil(frames[0].Error)
+}
+
+func Test_prefixUsageCache(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Now()
+	cache := &prefixUsageCache{entries: map[string]prefixUsageCacheEntry{}}
+	cache.set("expired", &PrefixUsageReport{}, now.Add(-time.Hour), time.Minute)
+	cache.set("key", &PrefixUsageReport{BucketName: "bucket"}, now, time.Minute)
+	_, ok := cache.get("expired", now)
+	assert.False(ok)
+	assert.Len(cache.entries, 1)
+	report, ok := cache.get("key", now)
+	assert.True(ok)
+	assert.Equal("bucket", report.BucketName)
+	_, ok = cache.get("key", now.Add(2*time.Minute))
+	assert.False(ok)
+
+	// a zero TTL disables the cache
+	cache.set("disabled", &PrefixUsageReport{}, now, 0)
+	_, ok = cache.get("disabled", now)
+	assert.False(ok)
+}
@@ -20,13 +20,18 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"os"
+	"os/signal"
 	"strconv"
+	"syscall"
 	"time"
 
 	"github.com/minio/console/pkg/logger"
 
 	"github.com/minio/cli"
 	"github.com/minio/console/api"
+	"github.com/minio/console/pkg/auth"
+	"github.com/minio/console/pkg/auth/idp/oauth2"
 )
 
 var appCmds = []cli.Command{
@@ -59,6 +64,37 @@ func StartServer(ctx *cli.Context) error {
 		return err
 	}
 
+	// a console running on its own takes its SSO providers from the environment
+	if len(api.GlobalMinIOConfig.OpenIDProviders) == 0 {
+		openIDProviders, err := oauth2.GetOpenIDPCfgFromEnv()
+		if err != nil {
+			api.LogError("Unable to load the openid providers: %v", err)
+			return err
+		}
+		api.GlobalMinIOConfig.OpenIDProviders = openIDProviders
+	}
+
+	if err := auth.ReloadKeyring(); err != nil {
+		api.LogError("Unable to load the session keyring: %v", err)
+		return err
+	}
+	go reloadKeyringOnSignal()
+
+	sessions, err := api.NewSessionRegistry(xctx)
+	if err != nil {
+		api.LogError("Unable to set up the session registry: %v", err)
+		return err
+	}
+	api.GlobalSessions = sessions
+	api.GlobalLoginThrottle = api.NewLoginThrottle()
+
+	secondFactors, err := api.NewSecondFactorStore(xctx)
+	if err != nil {
+		api.LogError("Unable to set up the second factor store: %v", err)
+		return err
+	}
+	api.GlobalSecondFactors = secondFactors
+
 	server, err := buildServer()
 	if err != nil {
 		api.LogError("Unable to initialize console server: %v", err)
@@ -94,3 +130,17 @@ func StartServer(ctx *cli.Context) error {
 
 	return nil
 }
+
+// reloadKeyringOnSignal reloads the session keyring every time console receives a SIGHUP, so
+// the session encryption keys can be rotated without restarting
+func reloadKeyringOnSignal() {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	for range hup {
+		if err := auth.ReloadKeyring(); err != nil {
+			api.LogError("Unable to reload the session keyring, keeping the current one: %v", err)
+			continue
+		}
+		api.LogInfo("Session keyring reloaded")
+	}
+}
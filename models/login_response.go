@@ -37,6 +37,12 @@ type LoginResponse struct {
 	// ID p refresh token
 	IDPRefreshToken string `json:"IDPRefreshToken,omitempty"`
 
+	// second factor enrollment
+	SecondFactorEnrollment bool `json:"secondFactorEnrollment,omitempty"`
+
+	// second factor token
+	SecondFactorToken string `json:"secondFactorToken,omitempty"`
+
 	// session Id
 	SessionID string `json:"sessionId,omitempty"`
 }
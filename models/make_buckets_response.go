@@ -36,6 +36,15 @@ type MakeBucketsResponse struct {
 
 	// bucket name
 	BucketName string `json:"bucketName,omitempty"`
+
+	// the bucket wasn't created, COMPLIANCE retention must be confirmed with the confirmation token
+	ConfirmationRequired bool `json:"confirmationRequired,omitempty"`
+
+	// confirmation token
+	ConfirmationToken string `json:"confirmationToken,omitempty"`
+
+	// warning
+	Warning string `json:"warning,omitempty"`
 }
 
 // Validate validates this make buckets response
@@ -52,8 +52,20 @@ type Principal struct {
 	// hm
 	Hm bool `json:"hm,omitempty"`
 
+	// last activity
+	LastActivity int64 `json:"lastActivity,omitempty"`
+
 	// ob
 	Ob bool `json:"ob,omitempty"`
+
+	// session expiration
+	SessionExpiration int64 `json:"sessionExpiration,omitempty"`
+
+	// session Id
+	SessionID string `json:"sessionId,omitempty"`
+
+	// sts expiration
+	StsExpiration int64 `json:"stsExpiration,omitempty"`
 }
 
 // Validate validates this principal
@@ -18,6 +18,7 @@ package auth
 
 import (
 	"context"
+	"time"
 
 	"github.com/minio/console/pkg/auth/idp/oauth2"
 	"github.com/minio/minio-go/v7/pkg/credentials"
@@ -28,9 +29,9 @@ import (
 // by mock when testing, it should include all IdentityProvider respective api calls
 // that are used within this project.
 type IdentityProviderI interface {
-	VerifyIdentity(ctx context.Context, code, state string) (*credentials.Credentials, error)
-	VerifyIdentityForOperator(ctx context.Context, code, state string) (*xoauth2.Token, error)
-	GenerateLoginURL() string
+	VerifyIdentity(ctx context.Context, code, state string, secrets *oauth2.LoginSecrets) (*credentials.Credentials, error)
+	VerifyIdentityForOperator(ctx context.Context, code, state string, secrets *oauth2.LoginSecrets) (*xoauth2.Token, error)
+	GenerateLoginURL() (string, *oauth2.LoginSecrets)
 }
 
 // Interface implementation
@@ -44,16 +45,21 @@ type IdentityProvider struct {
 }
 
 // VerifyIdentity will verify the user identity against the idp using the authorization code flow
-func (c IdentityProvider) VerifyIdentity(ctx context.Context, code, state string) (*credentials.Credentials, error) {
-	return c.Client.VerifyIdentity(ctx, code, state, c.RoleARN, c.KeyFunc)
+func (c IdentityProvider) VerifyIdentity(ctx context.Context, code, state string, secrets *oauth2.LoginSecrets) (*credentials.Credentials, error) {
+	return c.Client.VerifyIdentity(ctx, code, state, c.RoleARN, c.KeyFunc, secrets)
+}
+
+// RenewIdentity will get new credentials for the user of a session from the refresh token the idp issued
+func (c IdentityProvider) RenewIdentity(ctx context.Context, refreshToken string, duration time.Duration) (*credentials.Credentials, error) {
+	return c.Client.RenewIdentity(ctx, refreshToken, c.RoleARN, duration)
 }
 
 // VerifyIdentityForOperator will verify the user identity against the idp using the authorization code flow
-func (c IdentityProvider) VerifyIdentityForOperator(ctx context.Context, code, state string) (*xoauth2.Token, error) {
-	return c.Client.VerifyIdentityForOperator(ctx, code, state, c.KeyFunc)
+func (c IdentityProvider) VerifyIdentityForOperator(ctx context.Context, code, state string, secrets *oauth2.LoginSecrets) (*xoauth2.Token, error) {
+	return c.Client.VerifyIdentityForOperator(ctx, code, state, c.KeyFunc, secrets)
 }
 
-// GenerateLoginURL returns a new URL used by the user to login against the idp
-func (c IdentityProvider) GenerateLoginURL() string {
+// GenerateLoginURL returns a new URL used by the user to login against the idp, and the secrets of the login
+func (c IdentityProvider) GenerateLoginURL() (string, *oauth2.LoginSecrets) {
 	return c.Client.GenerateLoginURL(c.KeyFunc, c.Client.IDPName)
 }
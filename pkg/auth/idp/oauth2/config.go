@@ -20,10 +20,13 @@ package oauth2
 
 import (
 	"crypto/sha1"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
 	"strings"
 
+	"github.com/minio/console/pkg/auth/utils"
 	"github.com/minio/minio-go/v7/pkg/set"
 	"github.com/minio/pkg/v3/env"
 	"golang.org/x/crypto/pbkdf2"
@@ -33,16 +36,20 @@ import (
 
 // ProviderConfig - OpenID IDP Configuration for console.
 type ProviderConfig struct {
-	URL                      string
-	DisplayName              string // user-provided - can be empty
-	ClientID, ClientSecret   string
-	HMACSalt, HMACPassphrase string
-	Scopes                   string
-	Userinfo                 bool
-	RedirectCallbackDynamic  bool
-	RedirectCallback         string
-	EndSessionEndpoint       string
-	RoleArn                  string // can be empty
+	URL                     string `json:"url"`
+	DisplayName             string `json:"display_name"` // user-provided - can be empty
+	ClientID                string `json:"client_id"`
+	ClientSecret            string `json:"client_secret"`
+	HMACSalt                string `json:"hmac_salt"`
+	HMACPassphrase          string `json:"hmac_passphrase"`
+	Scopes                  string `json:"scopes"`
+	Userinfo                bool   `json:"userinfo"`
+	RedirectCallbackDynamic bool   `json:"redirect_callback_dynamic"`
+	RedirectCallback        string `json:"redirect_callback"`
+	EndSessionEndpoint      string `json:"end_session_endpoint"`
+	RoleArn                 string `json:"role_arn"` // can be empty
+	PKCE                    bool   `json:"pkce"`
+	Nonce                   bool   `json:"nonce"`
 }
 
 // GetOauth2Provider instantiates a new oauth2 client using the configured credentials
@@ -111,6 +118,8 @@ func (pc ProviderConfig) GetOauth2Provider(name string, scopes []string, r *http
 
 	client.IDPName = name
 	client.UserInfo = pc.Userinfo
+	client.PKCE = pc.PKCE
+	client.Nonce = pc.Nonce
 	client.client = clnt
 
 	return client, nil
@@ -134,3 +143,55 @@ type OpenIDPCfg map[string]ProviderConfig
 func GetSTSEndpoint() string {
 	return strings.TrimSpace(env.Get(ConsoleMinIOServer, "http://localhost:9000"))
 }
+
+// DefaultProviderName is the name of the provider configured through the CONSOLE_IDP_* variables
+const DefaultProviderName = "_"
+
+// GetOpenIDPCfgFromEnv returns the OpenID providers of a console running without a MinIO configuration,
+// the ones listed in the CONSOLE_IDP_CONFIG_FILE JSON file plus the one set through CONSOLE_IDP_URL
+func GetOpenIDPCfgFromEnv() (OpenIDPCfg, error) {
+	providers := OpenIDPCfg{}
+	if path := strings.TrimSpace(env.Get(ConsoleIDPConfigFile, "")); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal(data, &providers); err != nil {
+			return nil, fmt.Errorf("unable to parse %s: %w", path, err)
+		}
+	}
+	if idpURL := strings.TrimSpace(env.Get(ConsoleIDPURL, "")); idpURL != "" {
+		providers[DefaultProviderName] = ProviderConfig{
+			URL:                     idpURL,
+			DisplayName:             env.Get(ConsoleIDPDisplayName, ""),
+			ClientID:                env.Get(ConsoleIDPClientID, ""),
+			ClientSecret:            env.Get(ConsoleIDPSecret, ""),
+			HMACSalt:                env.Get(ConsoleIDPHmacSalt, ""),
+			HMACPassphrase:          env.Get(ConsoleIDPHmacPassphrase, ""),
+			Scopes:                  env.Get(ConsoleIDPScopes, ""),
+			Userinfo:                env.Get(ConsoleIDPUserinfo, "") == "on",
+			RedirectCallbackDynamic: env.Get(ConsoleIDPCallbackDynamic, "") == "on",
+			RedirectCallback:        env.Get(ConsoleIDPCallback, ""),
+			EndSessionEndpoint:      env.Get(ConsoleIDPEndSessionEndpoint, ""),
+			RoleArn:                 env.Get(ConsoleIDPRoleArn, ""),
+			PKCE:                    env.Get(ConsoleIDPPKCE, "") == "on",
+			Nonce:                   env.Get(ConsoleIDPNonce, "") == "on",
+		}
+	}
+	for name, pc := range providers {
+		if pc.URL == "" || pc.ClientID == "" {
+			return nil, fmt.Errorf("openid provider %s requires a configuration URL and a client ID", name)
+		}
+		if pc.RedirectCallback == "" && !pc.RedirectCallbackDynamic {
+			return nil, fmt.Errorf("openid provider %s requires a redirect callback or dynamic redirect callbacks", name)
+		}
+		// a random key signs the login state, logins started before a restart or on
+		// another console replica will fail
+		if pc.HMACPassphrase == "" || pc.HMACSalt == "" {
+			pc.HMACPassphrase = utils.RandomCharString(64)
+			pc.HMACSalt = utils.RandomCharString(64)
+		}
+		providers[name] = pc
+	}
+	return providers, nil
+}
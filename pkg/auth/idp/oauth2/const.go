@@ -18,5 +18,20 @@ package oauth2
 
 // Environment constants for console IDP/SSO configuration
 const (
-	ConsoleMinIOServer = "CONSOLE_MINIO_SERVER"
+	ConsoleMinIOServer           = "CONSOLE_MINIO_SERVER"
+	ConsoleIDPConfigFile         = "CONSOLE_IDP_CONFIG_FILE"
+	ConsoleIDPURL                = "CONSOLE_IDP_URL"
+	ConsoleIDPDisplayName        = "CONSOLE_IDP_DISPLAY_NAME"
+	ConsoleIDPClientID           = "CONSOLE_IDP_CLIENT_ID"
+	ConsoleIDPSecret             = "CONSOLE_IDP_SECRET"
+	ConsoleIDPHmacSalt           = "CONSOLE_IDP_HMAC_SALT"
+	ConsoleIDPHmacPassphrase     = "CONSOLE_IDP_HMAC_PASSPHRASE"
+	ConsoleIDPScopes             = "CONSOLE_IDP_SCOPES"
+	ConsoleIDPUserinfo           = "CONSOLE_IDP_USERINFO"
+	ConsoleIDPCallback           = "CONSOLE_IDP_CALLBACK"
+	ConsoleIDPCallbackDynamic    = "CONSOLE_IDP_CALLBACK_DYNAMIC"
+	ConsoleIDPEndSessionEndpoint = "CONSOLE_IDP_END_SESSION_ENDPOINT"
+	ConsoleIDPRoleArn            = "CONSOLE_IDP_ROLE_ARN"
+	ConsoleIDPPKCE               = "CONSOLE_IDP_PKCE"
+	ConsoleIDPNonce              = "CONSOLE_IDP_NONCE"
 )
@@ -18,6 +18,7 @@ package oauth2
 
 import (
 	"context"
+	"crypto/subtle"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
@@ -107,7 +108,11 @@ type Provider struct {
 	// - Scopes specifies optional requested permissions.
 	IDPName string
 	// if enabled means that we need extrace access_token as well
-	UserInfo     bool
+	UserInfo bool
+	// if enabled the authorization code is bound to the login with a S256 PKCE challenge
+	PKCE bool
+	// if enabled the id_token must carry the nonce sent with the login
+	Nonce        bool
 	RefreshToken string
 	oauth2Config Configuration
 	client       *http.Client
@@ -200,15 +205,19 @@ type User struct {
 type StateKeyFunc func() []byte
 
 // VerifyIdentity will contact the configured IDP to the user identity based on the authorization code and state
-// if the user is valid, then it will contact MinIO to get valid sts credentials based on the identity provided by the IDP
-func (client *Provider) VerifyIdentity(ctx context.Context, code, state, roleARN string, keyFunc StateKeyFunc) (*credentials.Credentials, error) {
+// if the user is valid, then it will contact MinIO to get valid sts credentials based on the identity provided by the IDP,
+// secrets are the ones GenerateLoginURL returned for the login
+func (client *Provider) VerifyIdentity(ctx context.Context, code, state, roleARN string, keyFunc StateKeyFunc, secrets *LoginSecrets) (*credentials.Credentials, error) {
 	// verify the provided state is valid (prevents CSRF attacks)
 	if err := validateOauth2State(state, keyFunc); err != nil {
 		return nil, err
 	}
+	if err := client.validateLoginSecrets(state, secrets); err != nil {
+		return nil, err
+	}
 	getWebTokenExpiry := func() (*credentials.WebIdentityToken, error) {
 		customCtx := context.WithValue(ctx, oauth2.HTTPClient, client.client)
-		oauth2Token, err := client.oauth2Config.Exchange(customCtx, code)
+		oauth2Token, err := client.oauth2Config.Exchange(customCtx, code, client.exchangeOptions(secrets)...)
 		if err != nil {
 			return nil, err
 		}
@@ -241,6 +250,11 @@ func (client *Provider) VerifyIdentity(ctx context.Context, code, state, roleARN
 		if idToken == nil {
 			return nil, errors.New("missing id_token")
 		}
+		if client.Nonce {
+			if err := validateIDTokenNonce(idToken.(string), secrets.Nonce); err != nil {
+				return nil, err
+			}
+		}
 		token := &credentials.WebIdentityToken{
 			Token:  idToken.(string),
 			Expiry: int(expiration.Seconds()),
@@ -274,14 +288,59 @@ func (client *Provider) VerifyIdentity(ctx context.Context, code, state, roleARN
 	return sts, nil
 }
 
+// RenewIdentity will exchange the refresh token of a session for a new id_token at the IDP, then it will contact MinIO
+// to get new sts credentials lasting for the provided duration, RefreshToken holds the token to use next time
+func (client *Provider) RenewIdentity(ctx context.Context, refreshToken, roleARN string, duration time.Duration) (*credentials.Credentials, error) {
+	getWebTokenExpiry := func() (*credentials.WebIdentityToken, error) {
+		customCtx := context.WithValue(ctx, oauth2.HTTPClient, client.client)
+		oauth2Token, err := client.oauth2Config.TokenSource(customCtx, &xoauth2.Token{RefreshToken: refreshToken}).Token()
+		if err != nil {
+			return nil, err
+		}
+		if !oauth2Token.Valid() {
+			return nil, errors.New("invalid token")
+		}
+		// IDPs rotating the refresh tokens return a new one
+		client.RefreshToken = refreshToken
+		if oauth2Token.RefreshToken != "" {
+			client.RefreshToken = oauth2Token.RefreshToken
+		}
+
+		idToken := oauth2Token.Extra("id_token")
+		if idToken == nil {
+			return nil, errors.New("missing id_token")
+		}
+		token := &credentials.WebIdentityToken{
+			Token:  idToken.(string),
+			Expiry: int(duration.Seconds()),
+		}
+		if client.UserInfo {
+			token.AccessToken = oauth2Token.AccessToken
+			token.RefreshToken = client.RefreshToken
+		}
+		return token, nil
+	}
+
+	sts := credentials.New(&credentials.STSWebIdentity{
+		Client:              client.client,
+		STSEndpoint:         GetSTSEndpoint(),
+		GetWebIDTokenExpiry: getWebTokenExpiry,
+		RoleARN:             roleARN,
+	})
+	return sts, nil
+}
+
 // VerifyIdentityForOperator will contact the configured IDP and validate the user identity based on the authorization code and state
-func (client *Provider) VerifyIdentityForOperator(ctx context.Context, code, state string, keyFunc StateKeyFunc) (*xoauth2.Token, error) {
+func (client *Provider) VerifyIdentityForOperator(ctx context.Context, code, state string, keyFunc StateKeyFunc, secrets *LoginSecrets) (*xoauth2.Token, error) {
 	// verify the provided state is valid (prevents CSRF attacks)
 	if err := validateOauth2State(state, keyFunc); err != nil {
 		return nil, err
 	}
+	if err := client.validateLoginSecrets(state, secrets); err != nil {
+		return nil, err
+	}
 	customCtx := context.WithValue(ctx, oauth2.HTTPClient, client.client)
-	oauth2Token, err := client.oauth2Config.Exchange(customCtx, code)
+	oauth2Token, err := client.oauth2Config.Exchange(customCtx, code, client.exchangeOptions(secrets)...)
 	if err != nil {
 		return nil, err
 	}
@@ -320,6 +379,86 @@ func validateOauth2State(state string, keyFunc StateKeyFunc) error {
 	return nil
 }
 
+// LoginSecrets are the random PKCE verifier and nonce of a login, they are kept by the browser that started
+// the login until its callback, next to the state they belong to
+type LoginSecrets struct {
+	State    string `json:"state"`
+	Verifier string `json:"verifier,omitempty"`
+	Nonce    string `json:"nonce,omitempty"`
+}
+
+// newLoginSecrets returns the secrets of a new login, nil when the provider uses neither PKCE nor a nonce
+func (client *Provider) newLoginSecrets(state string) *LoginSecrets {
+	if !client.PKCE && !client.Nonce {
+		return nil
+	}
+	secrets := &LoginSecrets{State: state}
+	if client.PKCE {
+		secrets.Verifier = xoauth2.GenerateVerifier()
+	}
+	if client.Nonce {
+		secrets.Nonce = utils.RandomCharString(32)
+	}
+	return secrets
+}
+
+// validateLoginSecrets checks the callback carries the secrets of the login the state was issued for
+func (client *Provider) validateLoginSecrets(state string, secrets *LoginSecrets) error {
+	if !client.PKCE && !client.Nonce {
+		return nil
+	}
+	if secrets == nil || subtle.ConstantTimeCompare([]byte(secrets.State), []byte(state)) != 1 {
+		return errors.New("the login was not started by this client")
+	}
+	if (client.PKCE && secrets.Verifier == "") || (client.Nonce && secrets.Nonce == "") {
+		return errors.New("the login is missing its PKCE verifier or nonce")
+	}
+	return nil
+}
+
+// authCodeURLOptions returns the PKCE challenge and nonce sent with the login
+func (client *Provider) authCodeURLOptions(secrets *LoginSecrets) []xoauth2.AuthCodeOption {
+	var opts []xoauth2.AuthCodeOption
+	if client.PKCE {
+		opts = append(opts, xoauth2.S256ChallengeOption(secrets.Verifier))
+	}
+	if client.Nonce {
+		opts = append(opts, xoauth2.SetAuthURLParam("nonce", secrets.Nonce))
+	}
+	return opts
+}
+
+// exchangeOptions returns the PKCE verifier of the login the authorization code was issued for
+func (client *Provider) exchangeOptions(secrets *LoginSecrets) []xoauth2.AuthCodeOption {
+	if !client.PKCE {
+		return nil
+	}
+	return []xoauth2.AuthCodeOption{xoauth2.VerifierOption(secrets.Verifier)}
+}
+
+// validateIDTokenNonce checks the id_token was issued for the login that sent the nonce, the signature
+// of the token is verified by MinIO when assuming the role
+func validateIDTokenNonce(idToken, nonce string) error {
+	parts := strings.Split(idToken, ".")
+	if len(parts) != 3 {
+		return errors.New("id_token is not a JWT")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return fmt.Errorf("unable to decode the id_token: %w", err)
+	}
+	var claims struct {
+		Nonce string `json:"nonce"`
+	}
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		return fmt.Errorf("unable to decode the id_token: %w", err)
+	}
+	if subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(nonce)) != 1 {
+		return errors.New("id_token nonce doesn't match the login")
+	}
+	return nil
+}
+
 // parseDiscoveryDoc parses a discovery doc from an OAuth provider
 // into a DiscoveryDoc struct that have the correct endpoints
 func parseDiscoveryDoc(ctx context.Context, ustr string, httpClient *http.Client) (DiscoveryDoc, error) {
@@ -358,8 +497,9 @@ type LoginURLParams struct {
 	IDPName string `json:"idp_name"`
 }
 
-// GenerateLoginURL returns a new login URL based on the configured IDP
-func (client *Provider) GenerateLoginURL(keyFunc StateKeyFunc, iDPName string) string {
+// GenerateLoginURL returns a new login URL based on the configured IDP, along with the secrets of the login
+// VerifyIdentity needs back, if any
+func (client *Provider) GenerateLoginURL(keyFunc StateKeyFunc, iDPName string) (string, *LoginSecrets) {
 	// generates random state and sign it using HMAC256
 	state := GetRandomStateWithHMAC(25, keyFunc)
 
@@ -376,11 +516,12 @@ func (client *Provider) GenerateLoginURL(keyFunc StateKeyFunc, iDPName string) s
 
 	jsonEnc, err := json.Marshal(lgParams)
 	if err != nil {
-		return ""
+		return "", nil
 	}
 
+	secrets := client.newLoginSecrets(state)
 	stEncode := base64.StdEncoding.EncodeToString(jsonEnc)
-	loginURL := client.oauth2Config.AuthCodeURL(stEncode)
+	loginURL := client.oauth2Config.AuthCodeURL(stEncode, client.authCodeURLOptions(secrets)...)
 
-	return strings.TrimSpace(loginURL)
+	return strings.TrimSpace(loginURL), secrets
 }
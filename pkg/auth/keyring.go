@@ -0,0 +1,182 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/minio/console/pkg/auth/token"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// maxKeyIDLength bounds the key identifier stored in the session token header
+const maxKeyIDLength = 255
+
+// ErrUnknownSessionKey is returned when a session token was encrypted with a key no longer in the keyring
+var ErrUnknownSessionKey = errors.New("session token was encrypted with an unknown key")
+
+// sessionKey is a pbkdf2 derived key used to encrypt the session token claims
+type sessionKey struct {
+	id  string
+	key []byte
+}
+
+// keyring holds the key new session tokens are encrypted with plus the previous keys, which are
+// only accepted to decrypt the tokens issued before a rotation
+type keyring struct {
+	current  sessionKey
+	previous []sessionKey
+}
+
+// keyringFile is the format of CONSOLE_PBKDF_KEYRING_FILE, the first key is the current one
+//
+//	{"keys": [{"id": "2024-06", "passphrase": "...", "salt": "..."}, {"id": "2024-01", ...}]}
+type keyringFile struct {
+	Keys []struct {
+		ID         string `json:"id"`
+		Passphrase string `json:"passphrase"`
+		Salt       string `json:"salt"`
+	} `json:"keys"`
+}
+
+var (
+	keyringMu     sync.RWMutex
+	globalKeyring *keyring
+)
+
+// deriveKey derives a session key using pbkdf on the passphrase and salt, when no identifier is
+// provided one is computed from the key itself
+func deriveKey(id, passphrase, salt string) (sessionKey, error) {
+	if passphrase == "" || salt == "" {
+		return sessionKey{}, errors.New("session keys require a passphrase and a salt")
+	}
+	key := pbkdf2.Key([]byte(passphrase), []byte(salt), 4096, 32, sha1.New)
+	if id == "" {
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte("console session key id"))
+		id = hex.EncodeToString(mac.Sum(nil)[:4])
+	}
+	if len(id) > maxKeyIDLength {
+		return sessionKey{}, fmt.Errorf("session key id %q is longer than %d characters", id, maxKeyIDLength)
+	}
+	return sessionKey{id: id, key: key}, nil
+}
+
+// loadKeyring builds the keyring from CONSOLE_PBKDF_KEYRING_FILE if set, otherwise from
+// CONSOLE_PBKDF_PASSPHRASE/CONSOLE_PBKDF_SALT and the optional CONSOLE_PBKDF_PREVIOUS_* key
+func loadKeyring() (*keyring, error) {
+	var keys []sessionKey
+	if path := token.GetPBKDFKeyringFile(); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var f keyringFile
+		if err = json.Unmarshal(data, &f); err != nil {
+			return nil, fmt.Errorf("unable to parse the session keyring %s: %w", path, err)
+		}
+		if len(f.Keys) == 0 {
+			return nil, fmt.Errorf("the session keyring %s has no keys", path)
+		}
+		for _, k := range f.Keys {
+			key, err := deriveKey(k.ID, k.Passphrase, k.Salt)
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, key)
+		}
+	} else {
+		current, err := deriveKey(token.GetPBKDFKeyID(), token.GetPBKDFPassphrase(), token.GetPBKDFSalt())
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, current)
+		if passphrase, salt, id := token.GetPBKDFPrevious(); passphrase != "" {
+			previous, err := deriveKey(id, passphrase, salt)
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, previous)
+		}
+	}
+	seen := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		if seen[k.id] {
+			return nil, fmt.Errorf("duplicated session key id %q", k.id)
+		}
+		seen[k.id] = true
+	}
+	return &keyring{current: keys[0], previous: keys[1:]}, nil
+}
+
+// ReloadKeyring reads the session keyring again, tokens encrypted with a key dropped from the
+// keyring stop being valid, the keyring in use is kept if the new one can't be loaded
+func ReloadKeyring() error {
+	kr, err := loadKeyring()
+	if err != nil {
+		return err
+	}
+	keyringMu.Lock()
+	globalKeyring = kr
+	keyringMu.Unlock()
+	return nil
+}
+
+// currentKeyring returns the keyring in use, loading it on first use
+func currentKeyring() (*keyring, error) {
+	keyringMu.RLock()
+	kr := globalKeyring
+	keyringMu.RUnlock()
+	if kr != nil {
+		return kr, nil
+	}
+	keyringMu.Lock()
+	defer keyringMu.Unlock()
+	if globalKeyring == nil {
+		var err error
+		if globalKeyring, err = loadKeyring(); err != nil {
+			return nil, err
+		}
+	}
+	return globalKeyring, nil
+}
+
+// lookup returns the key with the given identifier
+func (k *keyring) lookup(id string) (sessionKey, bool) {
+	if k.current.id == id {
+		return k.current, true
+	}
+	for _, key := range k.previous {
+		if key.id == id {
+			return key, true
+		}
+	}
+	return sessionKey{}, false
+}
+
+// all returns every key in the keyring, the current one first
+func (k *keyring) all() []sessionKey {
+	return append([]sessionKey{k.current}, k.previous...)
+}
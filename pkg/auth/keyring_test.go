@@ -0,0 +1,87 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package auth
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/minio/console/pkg/auth/token"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyringRotation(t *testing.T) {
+	funcAssert := assert.New(t)
+	t.Cleanup(func() {
+		keyringMu.Lock()
+		globalKeyring = nil
+		keyringMu.Unlock()
+	})
+
+	t.Setenv(token.ConsolePBKDFPassphrase, "first-passphrase")
+	t.Setenv(token.ConsolePBKDFSalt, "first-salt")
+	t.Setenv(token.ConsolePBKDFKeyID, "first")
+	funcAssert.NoError(ReloadKeyring())
+	oldToken, err := NewEncryptedTokenForClient(creds, "", "", nil, nil)
+	funcAssert.NoError(err)
+
+	// a token from before key ids were introduced
+	kr, err := currentKeyring()
+	funcAssert.NoError(err)
+	legacy, err := encrypt(kr.current.key, []byte(`{"stsAccessKeyID":"legacy"}`), []byte{})
+	funcAssert.NoError(err)
+	legacyToken := base64.StdEncoding.EncodeToString(legacy)
+
+	// Test-1 : after a rotation the previous key is still accepted for decryption
+	t.Setenv(token.ConsolePBKDFPassphrase, "second-passphrase")
+	t.Setenv(token.ConsolePBKDFSalt, "second-salt")
+	t.Setenv(token.ConsolePBKDFKeyID, "second")
+	t.Setenv(token.ConsolePBKDFPreviousPassphrase, "first-passphrase")
+	t.Setenv(token.ConsolePBKDFPreviousSalt, "first-salt")
+	t.Setenv(token.ConsolePBKDFPreviousKeyID, "first")
+	funcAssert.NoError(ReloadKeyring())
+	claims, err := SessionTokenAuthenticate(oldToken)
+	funcAssert.NoError(err)
+	funcAssert.Equal(creds.AccessKeyID, claims.STSAccessKeyID)
+	claims, err = SessionTokenAuthenticate(legacyToken)
+	funcAssert.NoError(err)
+	funcAssert.Equal("legacy", claims.STSAccessKeyID)
+
+	// Test-2 : new tokens are encrypted with the current key
+	newToken, err := NewEncryptedTokenForClient(creds, "", "", nil, nil)
+	funcAssert.NoError(err)
+	decoded, err := base64.StdEncoding.DecodeString(newToken)
+	funcAssert.NoError(err)
+	funcAssert.Equal("second", string(decoded[2:2+int(decoded[1])]))
+
+	// Test-3 : once the previous key is dropped, its tokens are rejected
+	dir := t.TempDir()
+	path := filepath.Join(dir, "keyring.json")
+	funcAssert.NoError(os.WriteFile(path, []byte(`{"keys":[{"id":"second","passphrase":"second-passphrase","salt":"second-salt"}]}`), 0o600))
+	t.Setenv(token.ConsolePBKDFKeyringFile, path)
+	funcAssert.NoError(ReloadKeyring())
+	_, err = DecryptToken(oldToken)
+	funcAssert.ErrorIs(err, ErrUnknownSessionKey)
+	funcAssert.True(IsSessionTokenValid(newToken))
+
+	// Test-4 : a broken keyring file keeps the keyring in use
+	funcAssert.NoError(os.WriteFile(path, []byte(`{"keys":[]}`), 0o600))
+	funcAssert.Error(ReloadKeyring())
+	funcAssert.True(IsSessionTokenValid(newToken))
+}
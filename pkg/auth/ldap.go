@@ -16,5 +16,52 @@
 
 package auth
 
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/minio/console/pkg/auth/utils"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/pkg/v3/env"
+)
+
 // GetCredentialsFromLDAP authenticates the user against MinIO when the LDAP integration is enabled
 // if the authentication succeed *credentials.Login object is returned and we continue with the normal STSAssumeRole flow
+func GetCredentialsFromLDAP(client *http.Client, endpoint, ldapUser, ldapPassword string) (*credentials.Credentials, error) {
+	return credentials.NewLDAPIdentity(endpoint, ldapUser, ldapPassword, func(i *credentials.LDAPIdentity) {
+		i.Client = client
+	})
+}
+
+// ConsoleLDAPEnabled forces LDAP logins "on" or "off", when unset MinIO is asked whether it has LDAP configured
+const ConsoleLDAPEnabled = "CONSOLE_LDAP_ENABLED"
+
+// DetectLDAPIdentity tells whether MinIO accepts LDAP logins by asking credentials for a user that can't
+// exist, MinIO rejects the user as an invalid parameter when LDAP is configured and reports the API as not
+// initialized otherwise
+func DetectLDAPIdentity(client *http.Client, endpoint string) (bool, error) {
+	switch strings.ToLower(env.Get(ConsoleLDAPEnabled, "")) {
+	case "on":
+		return true, nil
+	case "off":
+		return false, nil
+	}
+	creds, err := GetCredentialsFromLDAP(client, endpoint, "console-ldap-probe-"+utils.RandomCharString(8), utils.RandomCharString(32))
+	if err != nil {
+		return false, err
+	}
+	_, err = creds.GetWithContext(&credentials.CredContext{Client: client})
+	if err == nil {
+		return true, nil
+	}
+	var stsErr credentials.ErrorResponse
+	if !errors.As(err, &stsErr) {
+		return false, err
+	}
+	switch stsErr.STSError.Code {
+	case "InvalidParameterValue", "AccessDenied":
+		return true, nil
+	}
+	return false, nil
+}
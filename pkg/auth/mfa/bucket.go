@@ -0,0 +1,151 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package mfa
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/minio/console/pkg/auth"
+	"github.com/minio/minio-go/v7"
+)
+
+// KeySize is the size of the key the bucket store encrypts the second factors with
+const KeySize = 32
+
+// BucketStore keeps the second factors as objects of a bucket so every console of a deployment shares
+// them, the objects are encrypted with a key of their own and bound to their user. The key isn't part
+// of the session keyring, rotating the session keys would lock the users out of their second factor.
+type BucketStore struct {
+	client *minio.Client
+	bucket string
+	key    []byte
+}
+
+// NewBucketStore returns a BucketStore on the bucket encrypting the second factors with the key, the
+// bucket is created when missing
+func NewBucketStore(ctx context.Context, client *minio.Client, bucket string, key []byte) (*BucketStore, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("the key of the second factors must be %d bytes long", KeySize)
+	}
+	exists, err := client.BucketExists(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		if err = client.MakeBucket(ctx, bucket, minio.MakeBucketOptions{}); err != nil {
+			return nil, err
+		}
+	}
+	return &BucketStore{client: client, bucket: bucket, key: key}, nil
+}
+
+func enrollmentObject(user string) string {
+	sum := sha256.Sum256([]byte(user))
+	return "mfa/" + hex.EncodeToString(sum[:]) + ".enc"
+}
+
+// purpose binds the ciphertext of an enrollment to its user, so an object copied over the one of another
+// user can't be decrypted
+func purpose(user string) string {
+	return "console second factor " + user
+}
+
+// Get implements Store.Get
+func (b *BucketStore) Get(ctx context.Context, user string) (*Enrollment, error) {
+	object, err := b.client.GetObject(ctx, b.bucket, enrollmentObject(user), minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer object.Close()
+	ciphertext, err := io.ReadAll(object)
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, ErrNotEnrolled
+		}
+		return nil, err
+	}
+	data, err := auth.DecryptPayloadWithKey(b.key, strings.TrimSpace(string(ciphertext)), purpose(user))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUndecryptable, err)
+	}
+	var enrollment Enrollment
+	if err = json.Unmarshal(data, &enrollment); err != nil {
+		return nil, err
+	}
+	info, err := object.Stat()
+	if err != nil {
+		return nil, err
+	}
+	enrollment.version = info.ETag
+	return &enrollment, nil
+}
+
+// Save implements Store.Save
+func (b *BucketStore) Save(ctx context.Context, user string, enrollment *Enrollment) error {
+	return b.put(ctx, user, enrollment, minio.PutObjectOptions{})
+}
+
+// Update implements Store.Update, the object is only replaced if its ETag didn't change
+func (b *BucketStore) Update(ctx context.Context, user string, enrollment *Enrollment) error {
+	if enrollment.version == "" {
+		return ErrConflict
+	}
+	opts := minio.PutObjectOptions{}
+	opts.SetMatchETag(enrollment.version)
+	err := b.put(ctx, user, enrollment, opts)
+	switch minio.ToErrorResponse(err).Code {
+	case "PreconditionFailed", "NoSuchKey":
+		return ErrConflict
+	}
+	return err
+}
+
+func (b *BucketStore) put(ctx context.Context, user string, enrollment *Enrollment, opts minio.PutObjectOptions) error {
+	data, err := json.Marshal(enrollment)
+	if err != nil {
+		return err
+	}
+	ciphertext, err := auth.EncryptPayloadWithKey(b.key, data, purpose(user))
+	if err != nil {
+		return err
+	}
+	opts.ContentType = "application/octet-stream"
+	info, err := b.client.PutObject(ctx, b.bucket, enrollmentObject(user), strings.NewReader(ciphertext), int64(len(ciphertext)), opts)
+	if err != nil {
+		return err
+	}
+	enrollment.version = info.ETag
+	return nil
+}
+
+// Delete implements Store.Delete
+func (b *BucketStore) Delete(ctx context.Context, user string) error {
+	object := enrollmentObject(user)
+	if _, err := b.client.StatObject(ctx, b.bucket, object, minio.StatObjectOptions{}); err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return ErrNotEnrolled
+		}
+		return err
+	}
+	return b.client.RemoveObject(ctx, b.bucket, object, minio.RemoveObjectOptions{})
+}
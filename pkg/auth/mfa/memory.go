@@ -0,0 +1,85 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package mfa
+
+import (
+	"context"
+	"strconv"
+	"sync"
+)
+
+// MemoryStore keeps the second factors on a single console, they are lost on restart
+type MemoryStore struct {
+	mu          sync.Mutex
+	enrollments map[string]Enrollment
+	versions    int64
+}
+
+// NewMemoryStore returns an empty MemoryStore
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{enrollments: map[string]Enrollment{}}
+}
+
+// Get implements Store.Get
+func (m *MemoryStore) Get(_ context.Context, user string) (*Enrollment, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	enrollment, ok := m.enrollments[user]
+	if !ok {
+		return nil, ErrNotEnrolled
+	}
+	enrollment.RecoveryCodes = append([]string{}, enrollment.RecoveryCodes...)
+	return &enrollment, nil
+}
+
+// Save implements Store.Save
+func (m *MemoryStore) Save(_ context.Context, user string, enrollment *Enrollment) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.save(user, enrollment)
+	return nil
+}
+
+// Update implements Store.Update
+func (m *MemoryStore) Update(_ context.Context, user string, enrollment *Enrollment) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if saved, ok := m.enrollments[user]; !ok || saved.version != enrollment.version {
+		return ErrConflict
+	}
+	m.save(user, enrollment)
+	return nil
+}
+
+func (m *MemoryStore) save(user string, enrollment *Enrollment) {
+	m.versions++
+	enrollment.version = strconv.FormatInt(m.versions, 10)
+	saved := *enrollment
+	saved.RecoveryCodes = append([]string{}, enrollment.RecoveryCodes...)
+	m.enrollments[user] = saved
+}
+
+// Delete implements Store.Delete
+func (m *MemoryStore) Delete(_ context.Context, user string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.enrollments[user]; !ok {
+		return ErrNotEnrolled
+	}
+	delete(m.enrollments, user)
+	return nil
+}
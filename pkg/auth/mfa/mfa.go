@@ -0,0 +1,123 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package mfa
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+)
+
+// Second factor errors
+var (
+	// ErrNotEnrolled is returned by the stores for users without a second factor
+	ErrNotEnrolled = errors.New("second factor not enrolled")
+	// ErrUndecryptable is returned by the stores for second factors encrypted with another key
+	ErrUndecryptable = errors.New("second factor can't be decrypted")
+	// ErrConflict is returned by the stores updating a second factor that changed since it was read
+	ErrConflict = errors.New("second factor changed concurrently")
+)
+
+// recoveryCodeCount is how many recovery codes an enrollment comes with
+const recoveryCodeCount = 10
+
+var recoveryEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// Enrollment is the second factor of a user
+type Enrollment struct {
+	Secret string `json:"secret"`
+	// Confirmed is set once a code of the authenticator was entered, the second factor isn't required before
+	Confirmed bool `json:"confirmed"`
+	// RecoveryCodes are the hashes of the recovery codes left, each of them can be used once
+	RecoveryCodes []string `json:"recoveryCodes"`
+	// LastCounter is the counter of the last code used, it can't be used again
+	LastCounter int64     `json:"lastCounter"`
+	Created     time.Time `json:"created"`
+
+	// version is the version of the enrollment in the store it was read from
+	version string
+}
+
+// Store persists the second factors of the users
+type Store interface {
+	// Get returns ErrNotEnrolled when the user has no second factor
+	Get(ctx context.Context, user string) (*Enrollment, error)
+	Save(ctx context.Context, user string, enrollment *Enrollment) error
+	// Update saves an enrollment read from the store unless it changed since, ErrConflict then. The
+	// codes used are recorded with it, so the same code can't be accepted by concurrent logins.
+	Update(ctx context.Context, user string, enrollment *Enrollment) error
+	Delete(ctx context.Context, user string) error
+}
+
+// NewEnrollment returns an unconfirmed enrollment with a new secret, along with its recovery codes
+// which are only kept hashed
+func NewEnrollment(now time.Time) (*Enrollment, []string, error) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		return nil, nil, err
+	}
+	enrollment := &Enrollment{Secret: secret, Created: now}
+	codes := make([]string, 0, recoveryCodeCount)
+	for i := 0; i < recoveryCodeCount; i++ {
+		raw := make([]byte, 7)
+		if _, err = rand.Read(raw); err != nil {
+			return nil, nil, err
+		}
+		c := recoveryEncoding.EncodeToString(raw)[:10]
+		codes = append(codes, c[:5]+"-"+c[5:])
+		enrollment.RecoveryCodes = append(enrollment.RecoveryCodes, hashRecoveryCode(c))
+	}
+	return enrollment, codes, nil
+}
+
+func hashRecoveryCode(c string) string {
+	c = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(strings.TrimSpace(c)))
+	sum := sha256.Sum256([]byte(c))
+	return hex.EncodeToString(sum[:])
+}
+
+// Verify checks a code of the authenticator, the enrollment records it so it can't be used again
+func (e *Enrollment) Verify(passcode string, now time.Time) bool {
+	counter, ok := ValidateCode(e.Secret, passcode, now, e.LastCounter)
+	if !ok {
+		return false
+	}
+	e.LastCounter = counter
+	return true
+}
+
+// UseRecoveryCode checks a recovery code of a confirmed enrollment, the enrollment forgets it so it can't
+// be used again
+func (e *Enrollment) UseRecoveryCode(passcode string) bool {
+	if !e.Confirmed {
+		return false
+	}
+	hash := hashRecoveryCode(passcode)
+	for i, h := range e.RecoveryCodes {
+		if subtle.ConstantTimeCompare([]byte(h), []byte(hash)) == 1 {
+			e.RecoveryCodes = append(e.RecoveryCodes[:i], e.RecoveryCodes[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
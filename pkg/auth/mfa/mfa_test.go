@@ -0,0 +1,110 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package mfa
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// rfcSecret is the SHA-1 secret of the test vectors of RFC 6238
+var rfcSecret = secretEncoding.EncodeToString([]byte("12345678901234567890"))
+
+func TestValidateCode(t *testing.T) {
+	assert := assert.New(t)
+
+	// Test-1 : the codes of the RFC 6238 test vectors, truncated to six digits
+	counter, ok := ValidateCode(rfcSecret, "287082", time.Unix(59, 0), 0)
+	assert.True(ok)
+	assert.Equal(int64(1), counter)
+	_, ok = ValidateCode(rfcSecret, "081804", time.Unix(1111111109, 0), 0)
+	assert.True(ok)
+	_, ok = ValidateCode(rfcSecret, "005924", time.Unix(1234567890, 0), 0)
+	assert.True(ok)
+
+	// Test-2 : the codes of the previous and next periods are accepted, older ones aren't
+	_, ok = ValidateCode(rfcSecret, "287082", time.Unix(59+30, 0), 0)
+	assert.True(ok)
+	_, ok = ValidateCode(rfcSecret, "287082", time.Unix(59+60, 0), 0)
+	assert.False(ok)
+
+	// Test-3 : a code can't be used twice
+	_, ok = ValidateCode(rfcSecret, "287082", time.Unix(59, 0), 1)
+	assert.False(ok)
+
+	// Test-4 : malformed codes and secrets are rejected
+	_, ok = ValidateCode(rfcSecret, "28708", time.Unix(59, 0), 0)
+	assert.False(ok)
+	_, ok = ValidateCode("not base32!", "287082", time.Unix(59, 0), 0)
+	assert.False(ok)
+}
+
+func TestProvisioningURI(t *testing.T) {
+	assert.Equal(t,
+		"otpauth://totp/MinIO%20Console:admin?algorithm=SHA1&digits=6&issuer=MinIO+Console&period=30&secret="+rfcSecret,
+		ProvisioningURI("MinIO Console", "admin", rfcSecret))
+}
+
+func TestEnrollment(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	now := time.Now()
+	enrollment, recoveryCodes, err := NewEnrollment(now)
+	assert.Nil(err)
+	assert.Len(recoveryCodes, recoveryCodeCount)
+	assert.Len(enrollment.RecoveryCodes, recoveryCodeCount)
+
+	// Test-1 : the codes of the authenticator are accepted once
+	passcode, err := GenerateCode(enrollment.Secret, now)
+	assert.Nil(err)
+	assert.True(enrollment.Verify(passcode, now))
+	assert.False(enrollment.Verify(passcode, now))
+
+	// Test-2 : recovery codes only work once the enrollment is confirmed, once each
+	assert.False(enrollment.UseRecoveryCode(recoveryCodes[0]))
+	enrollment.Confirmed = true
+	assert.True(enrollment.UseRecoveryCode(recoveryCodes[0]))
+	assert.False(enrollment.UseRecoveryCode(recoveryCodes[0]))
+	assert.True(enrollment.UseRecoveryCode(" " + recoveryCodes[1][:5] + recoveryCodes[1][6:]))
+	assert.Len(enrollment.RecoveryCodes, recoveryCodeCount-2)
+
+	// Test-3 : the store keeps the enrollment of each user
+	store := NewMemoryStore()
+	_, err = store.Get(ctx, "user")
+	assert.ErrorIs(err, ErrNotEnrolled)
+	assert.Nil(store.Save(ctx, "user", enrollment))
+	saved, err := store.Get(ctx, "user")
+	assert.Nil(err)
+	assert.Equal(enrollment, saved)
+
+	// Test-4 : an enrollment is only updated if it didn't change since it was read
+	first, _ := store.Get(ctx, "user")
+	second, _ := store.Get(ctx, "user")
+	first.LastCounter++
+	assert.Nil(store.Update(ctx, "user", first))
+	second.LastCounter++
+	assert.ErrorIs(store.Update(ctx, "user", second), ErrConflict)
+	first.LastCounter++
+	assert.Nil(store.Update(ctx, "user", first))
+
+	assert.Nil(store.Delete(ctx, "user"))
+	assert.ErrorIs(store.Delete(ctx, "user"), ErrNotEnrolled)
+	assert.ErrorIs(store.Update(ctx, "user", first), ErrConflict)
+}
@@ -0,0 +1,114 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package mfa implements the time-based one-time passwords (RFC 6238) console requires as a second
+// factor, along with the recovery codes replacing them when the authenticator is lost
+package mfa
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// Period is how long a code is valid
+	Period = 30 * time.Second
+	// Digits is the length of a code
+	Digits = 6
+	// skew is how many periods before and after the current one codes are accepted from, it absorbs
+	// the drift between the clocks of console and the authenticator
+	skew = 1
+	// secretSize is the size of the shared secrets, the size of a SHA-1 HMAC key as recommended by RFC 4226
+	secretSize = 20
+)
+
+var secretEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret returns a new random shared secret encoded in base32, the way authenticators take it
+func GenerateSecret() (string, error) {
+	secret := make([]byte, secretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return secretEncoding.EncodeToString(secret), nil
+}
+
+// ProvisioningURI returns the otpauth URI authenticators enroll the secret from, usually shown as a QR code
+func ProvisioningURI(issuer, account, secret string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(Digits))
+	query.Set("period", fmt.Sprint(int(Period.Seconds())))
+	return "otpauth://totp/" + url.PathEscape(issuer+":"+account) + "?" + query.Encode()
+}
+
+// counterAt returns the number of periods elapsed since the Unix epoch at t
+func counterAt(t time.Time) int64 {
+	return t.Unix() / int64(Period.Seconds())
+}
+
+// code returns the code of the secret for the counter, as defined by RFC 4226
+func code(secret []byte, counter int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(counter))
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", Digits, value%1000000)
+}
+
+// GenerateCode returns the code of the secret at t, as an authenticator shows it
+func GenerateCode(secret string, t time.Time) (string, error) {
+	key, err := secretEncoding.DecodeString(strings.ToUpper(strings.TrimSpace(secret)))
+	if err != nil {
+		return "", err
+	}
+	return code(key, counterAt(t)), nil
+}
+
+// ValidateCode checks the code against the secret at now, codes of a counter up to lastCounter were already
+// used and are rejected so a code can't be replayed. It returns the counter of the code when it's valid.
+func ValidateCode(secret, passcode string, now time.Time, lastCounter int64) (int64, bool) {
+	key, err := secretEncoding.DecodeString(strings.ToUpper(strings.TrimSpace(secret)))
+	if err != nil {
+		return 0, false
+	}
+	passcode = strings.ReplaceAll(strings.TrimSpace(passcode), " ", "")
+	if len(passcode) != Digits {
+		return 0, false
+	}
+	current := counterAt(now)
+	for counter := current - skew; counter <= current+skew; counter++ {
+		if counter <= lastCounter {
+			continue
+		}
+		if hmac.Equal([]byte(code(key, counter)), []byte(passcode)) {
+			return counter, true
+		}
+	}
+	return 0, false
+}
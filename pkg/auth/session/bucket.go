@@ -0,0 +1,122 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package session
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"path"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// BucketStore keeps the sessions as objects of a bucket so every console of a deployment shares them,
+// the objects of an owner live under the hash of the owner name
+type BucketStore struct {
+	client *minio.Client
+	bucket string
+}
+
+// NewBucketStore returns a BucketStore on the bucket, the bucket is created when missing
+func NewBucketStore(ctx context.Context, client *minio.Client, bucket string) (*BucketStore, error) {
+	exists, err := client.BucketExists(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		if err = client.MakeBucket(ctx, bucket, minio.MakeBucketOptions{}); err != nil {
+			return nil, err
+		}
+	}
+	return &BucketStore{client: client, bucket: bucket}, nil
+}
+
+func ownerPrefix(owner string) string {
+	sum := sha256.Sum256([]byte(owner))
+	return "sessions/" + hex.EncodeToString(sum[:]) + "/"
+}
+
+func sessionObject(owner, id string) string {
+	return ownerPrefix(owner) + path.Base(id) + ".json"
+}
+
+func isNoSuchKey(err error) bool {
+	return minio.ToErrorResponse(err).Code == "NoSuchKey"
+}
+
+// Save implements Store.Save
+func (b *BucketStore) Save(ctx context.Context, session *Session) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	_, err = b.client.PutObject(ctx, b.bucket, sessionObject(session.Owner, session.ID), bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{ContentType: "application/json"})
+	return err
+}
+
+// Get implements Store.Get
+func (b *BucketStore) Get(ctx context.Context, owner, id string) (*Session, error) {
+	object, err := b.client.GetObject(ctx, b.bucket, sessionObject(owner, id), minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer object.Close()
+	var session Session
+	if err = json.NewDecoder(object).Decode(&session); err != nil {
+		if isNoSuchKey(err) {
+			return nil, ErrSessionNotFound
+		}
+		return nil, err
+	}
+	return &session, nil
+}
+
+// List implements Store.List
+func (b *BucketStore) List(ctx context.Context, owner string) ([]*Session, error) {
+	var sessions []*Session
+	for obj := range b.client.ListObjects(ctx, b.bucket, minio.ListObjectsOptions{Prefix: ownerPrefix(owner)}) {
+		if obj.Err != nil {
+			return nil, obj.Err
+		}
+		session, err := b.Get(ctx, owner, strings.TrimSuffix(path.Base(obj.Key), ".json"))
+		if err != nil {
+			// revoked while listing
+			if err == ErrSessionNotFound {
+				continue
+			}
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, nil
+}
+
+// Delete implements Store.Delete
+func (b *BucketStore) Delete(ctx context.Context, owner, id string) error {
+	object := sessionObject(owner, id)
+	if _, err := b.client.StatObject(ctx, b.bucket, object, minio.StatObjectOptions{}); err != nil {
+		if isNoSuchKey(err) {
+			return ErrSessionNotFound
+		}
+		return err
+	}
+	return b.client.RemoveObject(ctx, b.bucket, object, minio.RemoveObjectOptions{})
+}
@@ -0,0 +1,77 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package session
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryStore keeps the sessions of a single console, they are lost on restart
+type MemoryStore struct {
+	mu       sync.Mutex
+	sessions map[string]Session
+}
+
+// NewMemoryStore returns an empty MemoryStore
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{sessions: map[string]Session{}}
+}
+
+// Save implements Store.Save
+func (m *MemoryStore) Save(_ context.Context, session *Session) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sessions[session.ID] = *session
+	return nil
+}
+
+// Get implements Store.Get
+func (m *MemoryStore) Get(_ context.Context, owner, id string) (*Session, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	session, ok := m.sessions[id]
+	if !ok || session.Owner != owner {
+		return nil, ErrSessionNotFound
+	}
+	return &session, nil
+}
+
+// List implements Store.List
+func (m *MemoryStore) List(_ context.Context, owner string) ([]*Session, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var sessions []*Session
+	for _, session := range m.sessions {
+		if session.Owner == owner {
+			session := session
+			sessions = append(sessions, &session)
+		}
+	}
+	return sessions, nil
+}
+
+// Delete implements Store.Delete
+func (m *MemoryStore) Delete(_ context.Context, owner, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if session, ok := m.sessions[id]; !ok || session.Owner != owner {
+		return ErrSessionNotFound
+	}
+	delete(m.sessions, id)
+	return nil
+}
@@ -0,0 +1,242 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package session keeps track of the console sessions issued to users so they can be listed
+// and revoked before the credentials they carry expire
+package session
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Session errors
+var (
+	ErrSessionNotFound = errors.New("session not found")
+	ErrSessionRevoked  = errors.New("session has been revoked")
+)
+
+// Session is a console session as seen by the registry
+type Session struct {
+	ID        string    `json:"id"`
+	Owner     string    `json:"owner"`
+	IP        string    `json:"ip"`
+	UserAgent string    `json:"userAgent"`
+	Created   time.Time `json:"created"`
+	LastSeen  time.Time `json:"lastSeen"`
+	Expires   time.Time `json:"expires"`
+	// Renewal is what renews the credentials of the session, opaque to the registry and never
+	// handed to the client
+	Renewal string `json:"renewal,omitempty"`
+}
+
+// Store persists the sessions of the registry, a session missing from the store is revoked
+type Store interface {
+	Save(ctx context.Context, session *Session) error
+	// Get returns ErrSessionNotFound when the session isn't in the store
+	Get(ctx context.Context, owner, id string) (*Session, error)
+	List(ctx context.Context, owner string) ([]*Session, error)
+	Delete(ctx context.Context, owner, id string) error
+}
+
+const (
+	// lastSeenInterval throttles the writes of the last time a session was used
+	lastSeenInterval = time.Minute
+	// DefaultCacheTTL bounds how long a session revoked on another console keeps working on this one
+	DefaultCacheTTL = 10 * time.Second
+)
+
+// Registry issues, validates and revokes sessions
+type Registry struct {
+	store    Store
+	cacheTTL time.Duration
+
+	mu        sync.Mutex
+	validated map[string]time.Time
+
+	now func() time.Time
+}
+
+// NewRegistry returns a registry keeping its sessions in the store
+func NewRegistry(store Store, cacheTTL time.Duration) *Registry {
+	return &Registry{
+		store:     store,
+		cacheTTL:  cacheTTL,
+		validated: map[string]time.Time{},
+		now:       time.Now,
+	}
+}
+
+// Create registers a new session of the owner valid until the credentials it carries expire, the
+// renewal is empty unless the session is renewed with it
+func (r *Registry) Create(ctx context.Context, owner, ip, userAgent, renewal string, expires time.Time) (*Session, error) {
+	now := r.now()
+	session := &Session{
+		ID:        uuid.NewString(),
+		Owner:     owner,
+		IP:        ip,
+		UserAgent: userAgent,
+		Created:   now,
+		LastSeen:  now,
+		Expires:   expires,
+		Renewal:   renewal,
+	}
+	if err := r.store.Save(ctx, session); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// Validate returns ErrSessionRevoked unless the session is registered and not expired, it records
+// the last time the session was used
+func (r *Registry) Validate(ctx context.Context, owner, id string) error {
+	if id == "" {
+		return ErrSessionRevoked
+	}
+	now := r.now()
+	r.mu.Lock()
+	validated, ok := r.validated[id]
+	r.mu.Unlock()
+	if ok && now.Sub(validated) < r.cacheTTL {
+		return nil
+	}
+
+	session, err := r.store.Get(ctx, owner, id)
+	if errors.Is(err, ErrSessionNotFound) {
+		r.forget(id)
+		return ErrSessionRevoked
+	}
+	if err != nil {
+		return err
+	}
+	if r.expired(session, now) {
+		r.forget(id)
+		if err = r.store.Delete(ctx, owner, id); err != nil && !errors.Is(err, ErrSessionNotFound) {
+			return err
+		}
+		return ErrSessionRevoked
+	}
+	if now.Sub(session.LastSeen) >= lastSeenInterval {
+		session.LastSeen = now
+		if err = r.store.Save(ctx, session); err != nil {
+			return err
+		}
+	}
+	r.remember(id, now)
+	return nil
+}
+
+// remember caches the validation of a session, dropping the validations that expired so the cache doesn't
+// grow with every session that stopped being used
+func (r *Registry) remember(id string, now time.Time) {
+	if r.cacheTTL <= 0 {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for k, validated := range r.validated {
+		if now.Sub(validated) >= r.cacheTTL {
+			delete(r.validated, k)
+		}
+	}
+	r.validated[id] = now
+}
+
+// Renewal returns what renews the credentials of a session of the owner, ErrSessionRevoked when the
+// session isn't registered or expired
+func (r *Registry) Renewal(ctx context.Context, owner, id string) (string, error) {
+	if id == "" {
+		return "", ErrSessionRevoked
+	}
+	session, err := r.store.Get(ctx, owner, id)
+	if errors.Is(err, ErrSessionNotFound) {
+		return "", ErrSessionRevoked
+	}
+	if err != nil {
+		return "", err
+	}
+	if r.expired(session, r.now()) {
+		return "", ErrSessionRevoked
+	}
+	return session.Renewal, nil
+}
+
+// List returns the sessions of the owner that didn't expire, most recently used first
+func (r *Registry) List(ctx context.Context, owner string) ([]*Session, error) {
+	sessions, err := r.store.List(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	now := r.now()
+	active := make([]*Session, 0, len(sessions))
+	for _, session := range sessions {
+		if r.expired(session, now) {
+			if err = r.store.Delete(ctx, owner, session.ID); err != nil && !errors.Is(err, ErrSessionNotFound) {
+				return nil, err
+			}
+			continue
+		}
+		active = append(active, session)
+	}
+	sort.Slice(active, func(i, j int) bool {
+		return active[i].LastSeen.After(active[j].LastSeen)
+	})
+	return active, nil
+}
+
+// Revoke removes a session of the owner, returns ErrSessionNotFound when the owner has no such session
+func (r *Registry) Revoke(ctx context.Context, owner, id string) error {
+	if _, err := r.store.Get(ctx, owner, id); err != nil {
+		return err
+	}
+	r.forget(id)
+	return r.store.Delete(ctx, owner, id)
+}
+
+// RevokeAll removes every session of the owner but the one to keep and returns how many were revoked
+func (r *Registry) RevokeAll(ctx context.Context, owner, keep string) (int, error) {
+	sessions, err := r.store.List(ctx, owner)
+	if err != nil {
+		return 0, err
+	}
+	revoked := 0
+	for _, session := range sessions {
+		if session.ID == keep {
+			continue
+		}
+		r.forget(session.ID)
+		if err = r.store.Delete(ctx, owner, session.ID); err != nil && !errors.Is(err, ErrSessionNotFound) {
+			return revoked, err
+		}
+		revoked++
+	}
+	return revoked, nil
+}
+
+func (r *Registry) expired(session *Session, now time.Time) bool {
+	return !session.Expires.IsZero() && !now.Before(session.Expires)
+}
+
+func (r *Registry) forget(id string) {
+	r.mu.Lock()
+	delete(r.validated, id)
+	r.mu.Unlock()
+}
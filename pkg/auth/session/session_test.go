@@ -0,0 +1,92 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistry(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	store := NewMemoryStore()
+	registry := NewRegistry(store, DefaultCacheTTL)
+	registry.now = func() time.Time { return now }
+
+	laptop, err := registry.Create(ctx, "alice", "10.0.0.1", "Firefox", "", now.Add(time.Hour))
+	assert.Nil(err)
+	now = now.Add(5 * time.Minute)
+	phone, err := registry.Create(ctx, "alice", "10.0.0.2", "Safari", "sealed", now.Add(time.Hour))
+	assert.Nil(err)
+	other, err := registry.Create(ctx, "bob", "10.0.0.3", "Chrome", "", now.Add(time.Hour))
+	assert.Nil(err)
+
+	// using a session records when it was last seen
+	now = now.Add(2 * time.Minute)
+	assert.Nil(registry.Validate(ctx, "alice", laptop.ID))
+	sessions, err := registry.List(ctx, "alice")
+	assert.Nil(err)
+	assert.Equal([]string{laptop.ID, phone.ID}, []string{sessions[0].ID, sessions[1].ID})
+	assert.Equal(now, sessions[0].LastSeen)
+
+	// the renewal of a session is only handed out to its owner
+	renewal, err := registry.Renewal(ctx, "alice", phone.ID)
+	assert.Nil(err)
+	assert.Equal("sealed", renewal)
+	_, err = registry.Renewal(ctx, "bob", phone.ID)
+	assert.ErrorIs(err, ErrSessionRevoked)
+
+	// sessions of other users can't be validated nor revoked
+	assert.ErrorIs(registry.Validate(ctx, "alice", other.ID), ErrSessionRevoked)
+	assert.ErrorIs(registry.Revoke(ctx, "alice", other.ID), ErrSessionNotFound)
+
+	// a session revoked through another console is rejected once the cache expires
+	peer := NewRegistry(store, DefaultCacheTTL)
+	assert.Nil(peer.Revoke(ctx, "alice", laptop.ID))
+	assert.Nil(registry.Validate(ctx, "alice", laptop.ID))
+	now = now.Add(DefaultCacheTTL)
+	assert.ErrorIs(registry.Validate(ctx, "alice", laptop.ID), ErrSessionRevoked)
+
+	revoked, err := registry.RevokeAll(ctx, "bob", "")
+	assert.Nil(err)
+	assert.Equal(1, revoked)
+	assert.ErrorIs(registry.Validate(ctx, "bob", other.ID), ErrSessionRevoked)
+
+	// the cache only keeps the sessions validated recently
+	assert.Nil(registry.Validate(ctx, "alice", phone.ID))
+	now = now.Add(DefaultCacheTTL)
+	fresh, err := registry.Create(ctx, "carol", "10.0.0.4", "Edge", "", now.Add(time.Hour))
+	assert.Nil(err)
+	assert.Nil(registry.Validate(ctx, "carol", fresh.ID))
+	assert.Len(registry.validated, 1)
+	assert.Contains(registry.validated, fresh.ID)
+
+	// expired sessions are rejected and dropped
+	now = now.Add(time.Hour)
+	assert.ErrorIs(registry.Validate(ctx, "alice", phone.ID), ErrSessionRevoked)
+	_, err = registry.Renewal(ctx, "alice", phone.ID)
+	assert.ErrorIs(err, ErrSessionRevoked)
+	sessions, err = registry.List(ctx, "alice")
+	assert.Nil(err)
+	assert.Empty(sessions)
+	assert.ErrorIs(registry.Validate(ctx, "alice", ""), ErrSessionRevoked)
+}
@@ -0,0 +1,264 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package throttle slows down and locks out the clients failing to log in, so console can't be
+// used to guess the passwords of MinIO users
+package throttle
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// backoffBase is how long a client waits after its second consecutive failure, the wait doubles
+	// with every failure after it
+	backoffBase = time.Second
+	// sweepInterval throttles how often the failures that expired are forgotten
+	sweepInterval = time.Minute
+)
+
+// Config sets when clients are locked out
+type Config struct {
+	// MaxFailures is the number of failures locking an access key out
+	MaxFailures int
+	// MaxIPFailures is the number of failures locking a client IP out
+	MaxIPFailures int
+	// Lockout is how long a lockout lasts, failures older than it are forgotten
+	Lockout time.Duration
+}
+
+// Lockout is an access key or a client IP that was locked out
+type Lockout struct {
+	// Kind is either "accessKey" or "ip"
+	Kind     string
+	Value    string
+	Failures int
+	Until    time.Time
+}
+
+type failures struct {
+	count int
+	last  time.Time
+	until time.Time
+	// pending is the number of attempts let through whose outcome isn't known yet
+	pending int
+}
+
+// Throttle tracks the failed logins of each access key and client IP
+type Throttle struct {
+	cfg Config
+
+	mu      sync.Mutex
+	entries map[string]*failures
+	swept   time.Time
+
+	now func() time.Time
+}
+
+// New returns a throttle locking clients out as set by the configuration
+func New(cfg Config) *Throttle {
+	return &Throttle{
+		cfg:     cfg,
+		entries: map[string]*failures{},
+		now:     time.Now,
+	}
+}
+
+// Attempt is a login the throttle let through, its outcome is reported once it's known. A nil
+// attempt reports nothing.
+type Attempt struct {
+	t             *Throttle
+	ip, accessKey string
+	once          sync.Once
+}
+
+// RetryAfter returns how long the client has to wait before trying to log in with the access key
+// again, zero when it can try now
+func (t *Throttle) RetryAfter(ip, accessKey string) time.Duration {
+	now := t.now()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.retryAfter(ip, accessKey, now)
+}
+
+// Reserve lets a login with the access key through unless the client has to wait, in which case it
+// returns how long. The attempts of an access key are let through one at a time and the attempts of
+// a client IP never outnumber the failures it has left, so concurrent logins can't get past the
+// throttle before their failures are recorded.
+func (t *Throttle) Reserve(ip, accessKey string) (*Attempt, time.Duration) {
+	now := t.now()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.sweep(now)
+	if wait := t.retryAfter(ip, accessKey, now); wait > 0 {
+		return nil, wait
+	}
+	ipFailures, accessKeyFailures := t.entry("ip", ip), t.entry("accessKey", accessKey)
+	if accessKeyFailures != nil && accessKeyFailures.pending > 0 {
+		return nil, backoffBase
+	}
+	if ipFailures != nil && t.cfg.MaxIPFailures > 0 &&
+		t.active(ipFailures, t.cfg.MaxIPFailures, now)+ipFailures.pending >= t.cfg.MaxIPFailures {
+		return nil, backoffBase
+	}
+	for _, f := range []*failures{ipFailures, accessKeyFailures} {
+		if f != nil {
+			f.pending++
+		}
+	}
+	return &Attempt{t: t, ip: ip, accessKey: accessKey}, 0
+}
+
+// Failure records the login failed and returns the access key and client IP it locked out
+func (a *Attempt) Failure() []Lockout {
+	var lockouts []Lockout
+	a.report(func(now time.Time) {
+		lockouts = a.t.failure(a.ip, a.accessKey, now)
+	})
+	return lockouts
+}
+
+// Success records the login succeeded
+func (a *Attempt) Success() {
+	a.report(func(time.Time) {
+		delete(a.t.entries, "accessKey:"+a.accessKey)
+	})
+}
+
+// Cancel records nothing, the outcome of the login says nothing about the credentials
+func (a *Attempt) Cancel() {
+	a.report(func(time.Time) {})
+}
+
+// report releases the attempt and records its outcome, only the first outcome of an attempt counts
+func (a *Attempt) report(outcome func(now time.Time)) {
+	if a == nil {
+		return
+	}
+	a.once.Do(func() {
+		now := a.t.now()
+		a.t.mu.Lock()
+		defer a.t.mu.Unlock()
+		for _, key := range []string{"ip:" + a.ip, "accessKey:" + a.accessKey} {
+			if f, ok := a.t.entries[key]; ok && f.pending > 0 {
+				f.pending--
+				if f.pending == 0 && f.count == 0 {
+					delete(a.t.entries, key)
+				}
+			}
+		}
+		outcome(now)
+	})
+}
+
+// Failure records a failed login and returns the access key and client IP it locked out
+func (t *Throttle) Failure(ip, accessKey string) []Lockout {
+	now := t.now()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.failure(ip, accessKey, now)
+}
+
+func (t *Throttle) retryAfter(ip, accessKey string, now time.Time) time.Duration {
+	var wait time.Duration
+	for _, key := range []string{"ip:" + ip, "accessKey:" + accessKey} {
+		if f, ok := t.entries[key]; ok && f.until.Sub(now) > wait {
+			wait = f.until.Sub(now)
+		}
+	}
+	return wait
+}
+
+func (t *Throttle) failure(ip, accessKey string, now time.Time) []Lockout {
+	t.sweep(now)
+	var lockouts []Lockout
+	if lockout := t.fail("ip", ip, t.cfg.MaxIPFailures, now); lockout != nil {
+		lockouts = append(lockouts, *lockout)
+	}
+	if lockout := t.fail("accessKey", accessKey, t.cfg.MaxFailures, now); lockout != nil {
+		lockouts = append(lockouts, *lockout)
+	}
+	return lockouts
+}
+
+// Success forgets the failures of the access key, the failures of the client IP are kept so a
+// client can't clear them logging in with its own account
+func (t *Throttle) Success(accessKey string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.entries, "accessKey:"+accessKey)
+}
+
+// fail records a failure of the access key or client IP, the client waits exponentially longer
+// after each failure until it's locked out
+func (t *Throttle) fail(kind, value string, maxFailures int, now time.Time) *Lockout {
+	if value == "" {
+		return nil
+	}
+	f := t.entry(kind, value)
+	f.count = t.active(f, maxFailures, now)
+	f.count++
+	f.last = now
+	if maxFailures > 0 && f.count >= maxFailures {
+		f.until = now.Add(t.cfg.Lockout)
+		return &Lockout{Kind: kind, Value: value, Failures: f.count, Until: f.until}
+	}
+	if f.count > 1 {
+		backoff := t.cfg.Lockout
+		if shift := f.count - 2; shift < 32 && backoffBase<<shift < backoff {
+			backoff = backoffBase << shift
+		}
+		f.until = now.Add(backoff)
+	}
+	return nil
+}
+
+// entry returns the failures of the access key or client IP, nil when there's no value
+func (t *Throttle) entry(kind, value string) *failures {
+	if value == "" {
+		return nil
+	}
+	key := kind + ":" + value
+	f, ok := t.entries[key]
+	if !ok {
+		f = &failures{}
+		t.entries[key] = f
+	}
+	return f
+}
+
+// active returns the failures still counting, they're forgotten once they're older than the lockout
+// or the lockout is over
+func (t *Throttle) active(f *failures, maxFailures int, now time.Time) int {
+	if !now.Before(f.until) && (now.Sub(f.last) >= t.cfg.Lockout || (maxFailures > 0 && f.count >= maxFailures)) {
+		return 0
+	}
+	return f.count
+}
+
+// sweep forgets the failures older than the lockout
+func (t *Throttle) sweep(now time.Time) {
+	if now.Sub(t.swept) < sweepInterval {
+		return
+	}
+	for key, f := range t.entries {
+		if f.pending == 0 && now.Sub(f.last) >= t.cfg.Lockout && !now.Before(f.until) {
+			delete(t.entries, key)
+		}
+	}
+	t.swept = now
+}
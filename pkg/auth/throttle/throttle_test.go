@@ -0,0 +1,111 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package throttle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThrottle(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	throttle := New(Config{MaxFailures: 4, MaxIPFailures: 6, Lockout: 15 * time.Minute})
+	throttle.now = func() time.Time { return now }
+
+	// Test-1 : the wait doubles with every failure
+	assert.Empty(throttle.Failure("10.0.0.1", "user"))
+	assert.Equal(time.Duration(0), throttle.RetryAfter("10.0.0.1", "user"))
+	assert.Empty(throttle.Failure("10.0.0.1", "user"))
+	assert.Equal(time.Second, throttle.RetryAfter("10.0.0.1", "user"))
+	assert.Empty(throttle.Failure("10.0.0.1", "user"))
+	assert.Equal(2*time.Second, throttle.RetryAfter("10.0.0.1", "user"))
+	// other access keys from other clients are not slowed down
+	assert.Equal(time.Duration(0), throttle.RetryAfter("10.0.0.2", "other"))
+
+	// Test-2 : the access key is locked out after too many failures
+	lockouts := throttle.Failure("10.0.0.2", "user")
+	assert.Equal([]Lockout{{Kind: "accessKey", Value: "user", Failures: 4, Until: now.Add(15 * time.Minute)}}, lockouts)
+	assert.Equal(15*time.Minute, throttle.RetryAfter("10.0.0.3", "user"))
+
+	// Test-3 : the client IP is locked out after too many failures with any access key
+	now = now.Add(time.Minute)
+	throttle.Failure("10.0.0.1", "a")
+	lockouts = throttle.Failure("10.0.0.1", "b")
+	assert.Len(lockouts, 0)
+	now = now.Add(time.Minute)
+	lockouts = throttle.Failure("10.0.0.1", "c")
+	assert.Equal([]Lockout{{Kind: "ip", Value: "10.0.0.1", Failures: 6, Until: now.Add(15 * time.Minute)}}, lockouts)
+	assert.Equal(15*time.Minute, throttle.RetryAfter("10.0.0.1", "someone"))
+
+	// Test-4 : logging in forgets the failures of the access key but not the ones of the client IP
+	throttle.Success("user")
+	assert.Equal(time.Duration(0), throttle.RetryAfter("10.0.0.3", "user"))
+	assert.Equal(15*time.Minute, throttle.RetryAfter("10.0.0.1", "user"))
+
+	// Test-5 : failures are forgotten once the lockout is over
+	now = now.Add(16 * time.Minute)
+	assert.Equal(time.Duration(0), throttle.RetryAfter("10.0.0.1", "c"))
+	assert.Empty(throttle.Failure("10.0.0.1", "c"))
+	assert.Equal(time.Duration(0), throttle.RetryAfter("10.0.0.1", "c"))
+}
+
+func TestThrottleReserve(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	throttle := New(Config{MaxFailures: 3, MaxIPFailures: 2, Lockout: 15 * time.Minute})
+	throttle.now = func() time.Time { return now }
+
+	// Test-1 : the attempts of an access key are let through one at a time
+	attempt, wait := throttle.Reserve("10.0.0.1", "user")
+	assert.NotNil(attempt)
+	assert.Zero(wait)
+	concurrent, wait := throttle.Reserve("10.0.0.2", "user")
+	assert.Nil(concurrent)
+	assert.Equal(time.Second, wait)
+	attempt.Success()
+	// only the first outcome of an attempt counts
+	assert.Empty(attempt.Failure())
+
+	// Test-2 : the attempts of a client IP don't outnumber the failures it has left
+	first, _ := throttle.Reserve("10.0.0.1", "a")
+	second, _ := throttle.Reserve("10.0.0.1", "b")
+	assert.NotNil(first)
+	assert.NotNil(second)
+	third, wait := throttle.Reserve("10.0.0.1", "c")
+	assert.Nil(third)
+	assert.Equal(time.Second, wait)
+	first.Failure()
+	lockouts := second.Failure()
+	assert.Equal([]Lockout{{Kind: "ip", Value: "10.0.0.1", Failures: 2, Until: now.Add(15 * time.Minute)}}, lockouts)
+	_, wait = throttle.Reserve("10.0.0.1", "c")
+	assert.Equal(15*time.Minute, wait)
+
+	// Test-3 : cancelled attempts record nothing
+	attempt, _ = throttle.Reserve("10.0.0.3", "other")
+	attempt.Cancel()
+	assert.Empty(throttle.entries["accessKey:other"])
+	attempt, _ = throttle.Reserve("10.0.0.3", "other")
+	assert.NotNil(attempt)
+
+	// Test-4 : a nil attempt reports nothing
+	var none *Attempt
+	none.Cancel()
+	assert.Empty(none.Failure())
+}
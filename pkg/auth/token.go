@@ -21,7 +21,6 @@ import (
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/hmac"
-	"crypto/sha1"
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/json"
@@ -33,12 +32,10 @@ import (
 	"time"
 
 	"github.com/minio/console/models"
-	"github.com/minio/console/pkg/auth/token"
 	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/secure-io/sio-go/sioutil"
 	"golang.org/x/crypto/chacha20"
 	"golang.org/x/crypto/chacha20poly1305"
-	"golang.org/x/crypto/pbkdf2"
 )
 
 // Session token errors
@@ -48,11 +45,6 @@ var (
 	ErrReadingToken = errors.New("session token internal data is malformed")
 )
 
-// derivedKey is the key used to encrypt the session token claims, its derived using pbkdf on CONSOLE_PBKDF_PASSPHRASE with CONSOLE_PBKDF_SALT
-var derivedKey = func() []byte {
-	return pbkdf2.Key([]byte(token.GetPBKDFPassphrase()), []byte(token.GetPBKDFSalt()), 4096, 32, sha1.New)
-}
-
 // IsSessionTokenValid returns true or false depending upon the provided session if the token is valid or not
 func IsSessionTokenValid(token string) bool {
 	_, err := SessionTokenAuthenticate(token)
@@ -68,6 +60,13 @@ type TokenClaims struct {
 	HideMenu           bool   `json:"hm,omitempty"`
 	ObjectBrowser      bool   `json:"ob,omitempty"`
 	CustomStyleOB      string `json:"customStyleOb,omitempty"`
+	SessionID          string `json:"sid,omitempty"`
+	// renewal of the STS credentials, only set when the session can be renewed
+	IDPName           string `json:"idp,omitempty"`
+	STSExpiration     int64  `json:"stsExp,omitempty"`
+	SessionExpiration int64  `json:"exp,omitempty"`
+	// last time the session was used, the token is re-issued as the session is used
+	LastActivity int64 `json:"la,omitempty"`
 }
 
 // STSClaims claims struct for STS Token
@@ -82,6 +81,17 @@ type SessionFeatures struct {
 	CustomStyleOB string
 }
 
+// SessionRenewal holds what console needs to renew the STS credentials of a session
+type SessionRenewal struct {
+	// SecretKey lets sessions of access and secret key logins assume a role again, it's kept in the
+	// session registry and never leaves the server
+	SecretKey string `json:"-"`
+	// IDPName is the OpenID provider the refresh token of the session belongs to
+	IDPName string
+	// Expiration is the end of the session, its credentials are not renewed past it
+	Expiration time.Time
+}
+
 // SessionTokenAuthenticate takes a session token, decode it, extract claims and validate the signature
 // if the session token claims are valid we proceed to decrypt the information inside
 //
@@ -112,20 +122,30 @@ func SessionTokenAuthenticate(token string) (*TokenClaims, error) {
 }
 
 // NewEncryptedTokenForClient generates a new session token with claims based on the provided STS credentials, first
-// encrypts the claims and the sign them
-func NewEncryptedTokenForClient(credentials *credentials.Value, accountAccessKey string, features *SessionFeatures) (string, error) {
+// encrypts the claims and the sign them. The session ID is empty unless the session is registered, the renewal is
+// nil unless the session can be renewed.
+func NewEncryptedTokenForClient(credentials *credentials.Value, accountAccessKey, sessionID string, features *SessionFeatures, renewal *SessionRenewal) (string, error) {
 	if credentials != nil {
 		tokenClaims := &TokenClaims{
 			STSAccessKeyID:     credentials.AccessKeyID,
 			STSSecretAccessKey: credentials.SecretAccessKey,
 			STSSessionToken:    credentials.SessionToken,
 			AccountAccessKey:   accountAccessKey,
+			SessionID:          sessionID,
+			LastActivity:       time.Now().Unix(),
 		}
 		if features != nil {
 			tokenClaims.HideMenu = features.HideMenu
 			tokenClaims.ObjectBrowser = features.ObjectBrowser
 			tokenClaims.CustomStyleOB = features.CustomStyleOB
 		}
+		if renewal != nil {
+			tokenClaims.IDPName = renewal.IDPName
+			tokenClaims.SessionExpiration = renewal.Expiration.Unix()
+			if !credentials.Expiration.IsZero() {
+				tokenClaims.STSExpiration = credentials.Expiration.Unix()
+			}
+		}
 
 		encryptedClaims, err := encryptClaims(tokenClaims)
 		if err != nil {
@@ -136,20 +156,87 @@ func NewEncryptedTokenForClient(credentials *credentials.Value, accountAccessKey
 	return "", errors.New("provided credentials are empty")
 }
 
-// encryptClaims() receives the STS claims, concatenate them and encrypt them using AES-GCM
-// returns a base64 encoded ciphertext
+// NewEncryptedTokenFromClaims encrypts the claims of an existing session, it's used to re-issue its session token
+func NewEncryptedTokenFromClaims(claims *TokenClaims) (string, error) {
+	return encryptClaims(claims)
+}
+
+// encryptClaims() receives the STS claims, concatenate them and encrypt them using AES-GCM with the current key
+// of the keyring, returns a base64 encoded ciphertext
 func encryptClaims(credentials *TokenClaims) (string, error) {
 	payload, err := json.Marshal(credentials)
 	if err != nil {
 		return "", err
 	}
-	ciphertext, err := encrypt(payload, []byte{})
+	kr, err := currentKeyring()
+	if err != nil {
+		return "", err
+	}
+	// the key id header is authenticated along with the claims
+	header := append([]byte{keyedToken, byte(len(kr.current.id))}, kr.current.id...)
+	ciphertext, err := encrypt(kr.current.key, payload, header)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(append(header, ciphertext...)), nil
+}
+
+// EncryptPayload encrypts data other than session claims with the current key of the keyring, the purpose is
+// authenticated along with the data so the ciphertext can't be used as a session token or for another purpose
+func EncryptPayload(payload []byte, purpose string) (string, error) {
+	kr, err := currentKeyring()
+	if err != nil {
+		return "", err
+	}
+	header := append([]byte{keyedToken, byte(len(kr.current.id))}, kr.current.id...)
+	ciphertext, err := encrypt(kr.current.key, payload, append(header, purpose...))
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(append(header, ciphertext...)), nil
+}
+
+// DecryptPayload decrypts the data encrypted by EncryptPayload for the same purpose
+func DecryptPayload(ciphertext, purpose string) ([]byte, error) {
+	decoded, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	if len(decoded) < 2 || decoded[0] != keyedToken || len(decoded) < 2+int(decoded[1]) {
+		return nil, ErrReadingToken
+	}
+	kr, err := currentKeyring()
+	if err != nil {
+		return nil, err
+	}
+	headerLen := 2 + int(decoded[1])
+	key, ok := kr.lookup(string(decoded[2:headerLen]))
+	if !ok {
+		return nil, ErrUnknownSessionKey
+	}
+	header := append([]byte{}, decoded[:headerLen]...)
+	return decrypt(key.key, decoded[headerLen:], append(header, purpose...))
+}
+
+// EncryptPayloadWithKey encrypts data with a key of its own rather than the keyring, for data that has to
+// outlive the keys of the keyring, the purpose is authenticated along with the data
+func EncryptPayloadWithKey(key, payload []byte, purpose string) (string, error) {
+	ciphertext, err := encrypt(key, payload, []byte(purpose))
 	if err != nil {
 		return "", err
 	}
 	return base64.StdEncoding.EncodeToString(ciphertext), nil
 }
 
+// DecryptPayloadWithKey decrypts the data encrypted by EncryptPayloadWithKey with the same key and purpose
+func DecryptPayloadWithKey(key []byte, ciphertext, purpose string) ([]byte, error) {
+	decoded, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	return decrypt(key, decoded, []byte(purpose))
+}
+
 // ParseClaimsFromToken receive token claims in string format, then unmarshal them to produce a *TokenClaims object
 func ParseClaimsFromToken(claims string) (*TokenClaims, error) {
 	tokenClaims := &TokenClaims{}
@@ -160,12 +247,35 @@ func ParseClaimsFromToken(claims string) (*TokenClaims, error) {
 }
 
 // DecryptToken receives base64 encoded ciphertext, decode it, decrypt it (AES-GCM) and produces []byte
+//
+// Tokens carry the id of the key used to encrypt them, tokens issued before key ids were introduced
+// are tried against every key in the keyring.
 func DecryptToken(ciphertext string) (plaintext []byte, err error) {
 	decoded, err := base64.StdEncoding.DecodeString(ciphertext)
 	if err != nil {
 		return nil, err
 	}
-	plaintext, err = decrypt(decoded, []byte{})
+	kr, err := currentKeyring()
+	if err != nil {
+		return nil, err
+	}
+	if len(decoded) == 0 || decoded[0] != keyedToken {
+		for _, key := range kr.all() {
+			if plaintext, err = decrypt(key.key, decoded, []byte{}); err == nil {
+				return plaintext, nil
+			}
+		}
+		return nil, err
+	}
+	if len(decoded) < 2 || len(decoded) < 2+int(decoded[1]) {
+		return nil, ErrReadingToken
+	}
+	headerLen := 2 + int(decoded[1])
+	key, ok := kr.lookup(string(decoded[2:headerLen]))
+	if !ok {
+		return nil, ErrUnknownSessionKey
+	}
+	plaintext, err = decrypt(key.key, decoded[headerLen:], decoded[:headerLen])
 	if err != nil {
 		return nil, err
 	}
@@ -175,18 +285,20 @@ func DecryptToken(ciphertext string) (plaintext []byte, err error) {
 const (
 	aesGcm   = 0x00
 	c20p1305 = 0x01
+	// keyedToken marks tokens starting with the id of the key used to encrypt them
+	keyedToken = 0x80
 )
 
 // Encrypt a blob of data using AEAD scheme, AES-GCM if the executing CPU
 // provides AES hardware support, otherwise will use ChaCha20-Poly1305
-// with a pbkdf2 derived key, this function should be used to encrypt a session
+// with the provided pbkdf2 derived key, this function should be used to encrypt a session
 // or data key provided as plaintext.
 //
 // The returned ciphertext data consists of:
 //
 //	AEAD ID | iv | nonce | encrypted data
 //	   1      16		 12     ~ len(data)
-func encrypt(plaintext, associatedData []byte) ([]byte, error) {
+func encrypt(key, plaintext, associatedData []byte) ([]byte, error) {
 	iv, err := sioutil.Random(16) // 16 bytes IV
 	if err != nil {
 		return nil, err
@@ -200,7 +312,7 @@ func encrypt(plaintext, associatedData []byte) ([]byte, error) {
 	var aead cipher.AEAD
 	switch algorithm {
 	case aesGcm:
-		mac := hmac.New(sha256.New, derivedKey())
+		mac := hmac.New(sha256.New, key)
 		mac.Write(iv)
 		sealingKey := mac.Sum(nil)
 
@@ -215,7 +327,7 @@ func encrypt(plaintext, associatedData []byte) ([]byte, error) {
 		}
 	case c20p1305:
 		var sealingKey []byte
-		sealingKey, err = chacha20.HChaCha20(derivedKey(), iv) // HChaCha20 expects nonce of 16 bytes
+		sealingKey, err = chacha20.HChaCha20(key, iv) // HChaCha20 expects nonce of 16 bytes
 		if err != nil {
 			return nil, err
 		}
@@ -244,8 +356,8 @@ func encrypt(plaintext, associatedData []byte) ([]byte, error) {
 
 // Decrypts a blob of data using AEAD scheme AES-GCM if the executing CPU
 // provides AES hardware support, otherwise will use ChaCha20-Poly1305with
-// and a pbkdf2 derived key
-func decrypt(ciphertext, associatedData []byte) ([]byte, error) {
+// and the provided pbkdf2 derived key
+func decrypt(key, ciphertext, associatedData []byte) ([]byte, error) {
 	var (
 		algorithm [1]byte
 		iv        [16]byte
@@ -266,7 +378,7 @@ func decrypt(ciphertext, associatedData []byte) ([]byte, error) {
 	var aead cipher.AEAD
 	switch algorithm[0] {
 	case aesGcm:
-		mac := hmac.New(sha256.New, derivedKey())
+		mac := hmac.New(sha256.New, key)
 		mac.Write(iv[:])
 		sealingKey := mac.Sum(nil)
 		block, err := aes.NewCipher(sealingKey)
@@ -278,7 +390,7 @@ func decrypt(ciphertext, associatedData []byte) ([]byte, error) {
 			return nil, err
 		}
 	case c20p1305:
-		sealingKey, err := chacha20.HChaCha20(derivedKey(), iv[:]) // HChaCha20 expects nonce of 16 bytes
+		sealingKey, err := chacha20.HChaCha20(key, iv[:]) // HChaCha20 expects nonce of 16 bytes
 		if err != nil {
 			return nil, err
 		}
@@ -341,5 +453,9 @@ func GetClaimsFromTokenInRequest(req *http.Request) (*models.Principal, error) {
 		STSSecretAccessKey: claims.STSSecretAccessKey,
 		STSSessionToken:    claims.STSSessionToken,
 		AccountAccessKey:   claims.AccountAccessKey,
+		SessionID:          claims.SessionID,
+		StsExpiration:      claims.STSExpiration,
+		SessionExpiration:  claims.SessionExpiration,
+		LastActivity:       claims.LastActivity,
 	}, nil
 }
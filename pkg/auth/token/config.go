@@ -45,3 +45,20 @@ var defaultPBKDFSalt = utils.RandomCharString(64)
 func GetPBKDFSalt() string {
 	return env.Get(ConsolePBKDFSalt, defaultPBKDFSalt)
 }
+
+// GetPBKDFKeyID returns the identifier of the current session encryption key, an empty value means the
+// identifier is derived from the key itself
+func GetPBKDFKeyID() string {
+	return env.Get(ConsolePBKDFKeyID, "")
+}
+
+// GetPBKDFPrevious returns the passphrase, salt and key identifier of the previous session encryption key,
+// the passphrase is empty when no previous key is configured
+func GetPBKDFPrevious() (passphrase, salt, keyID string) {
+	return env.Get(ConsolePBKDFPreviousPassphrase, ""), env.Get(ConsolePBKDFPreviousSalt, ""), env.Get(ConsolePBKDFPreviousKeyID, "")
+}
+
+// GetPBKDFKeyringFile returns the path of the file holding the session encryption keyring
+func GetPBKDFKeyringFile() string {
+	return env.Get(ConsolePBKDFKeyringFile, "")
+}
@@ -20,4 +20,11 @@ const (
 	ConsoleSTSDuration     = "CONSOLE_STS_DURATION" // time.Duration format, ie: 3600s, 2h45m, 1h, etc
 	ConsolePBKDFPassphrase = "CONSOLE_PBKDF_PASSPHRASE"
 	ConsolePBKDFSalt       = "CONSOLE_PBKDF_SALT"
+	ConsolePBKDFKeyID      = "CONSOLE_PBKDF_KEY_ID"
+	// previous passphrase and salt, only used to decrypt sessions issued before a rotation
+	ConsolePBKDFPreviousPassphrase = "CONSOLE_PBKDF_PREVIOUS_PASSPHRASE"
+	ConsolePBKDFPreviousSalt       = "CONSOLE_PBKDF_PREVIOUS_SALT"
+	ConsolePBKDFPreviousKeyID      = "CONSOLE_PBKDF_PREVIOUS_KEY_ID"
+	// JSON file with the whole keyring, takes precedence over the variables above
+	ConsolePBKDFKeyringFile = "CONSOLE_PBKDF_KEYRING_FILE"
 )
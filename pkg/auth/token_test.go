@@ -17,6 +17,7 @@
 package auth
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/minio/minio-go/v7/pkg/credentials"
@@ -39,14 +40,14 @@ func TestNewJWTWithClaimsForClient(t *testing.T) {
 	funcAssert := assert.New(t)
 	// Test-1 : NewEncryptedTokenForClient() is generated correctly without errors
 	function := "NewEncryptedTokenForClient()"
-	token, err := NewEncryptedTokenForClient(creds, "", nil)
+	token, err := NewEncryptedTokenForClient(creds, "", "", nil, nil)
 	if err != nil || token == "" {
 		t.Errorf("Failed on %s:, error occurred: %s", function, err)
 	}
 	// saving token for future tests
 	goodToken = token
 	// Test-2 : NewEncryptedTokenForClient() throws error because of empty credentials
-	if _, err = NewEncryptedTokenForClient(nil, "", nil); err != nil {
+	if _, err = NewEncryptedTokenForClient(nil, "", "", nil, nil); err != nil {
 		funcAssert.Equal("provided credentials are empty", err.Error())
 	}
 }
@@ -80,3 +81,39 @@ func TestSessionTokenValid(t *testing.T) {
 	// Test-2 : SessionTokenAuthenticate() provided token is invalid
 	funcAssert.Equal(false, IsSessionTokenValid(badToken))
 }
+
+func TestEncryptPayload(t *testing.T) {
+	assert := assert.New(t)
+	ciphertext, err := EncryptPayload([]byte("payload"), "purpose")
+	assert.Nil(err)
+
+	// Test-1 : the payload is decrypted for the purpose it was encrypted for only
+	plaintext, err := DecryptPayload(ciphertext, "purpose")
+	assert.Nil(err)
+	assert.Equal("payload", string(plaintext))
+	_, err = DecryptPayload(ciphertext, "other purpose")
+	assert.NotNil(err)
+
+	// Test-2 : the payload is not a session token
+	assert.False(IsSessionTokenValid(ciphertext))
+}
+
+func TestEncryptPayloadWithKey(t *testing.T) {
+	assert := assert.New(t)
+	key := bytes.Repeat([]byte{1}, 32)
+	ciphertext, err := EncryptPayloadWithKey(key, []byte("payload"), "purpose")
+	assert.Nil(err)
+
+	// Test-1 : the payload is decrypted with its key for the purpose it was encrypted for only
+	plaintext, err := DecryptPayloadWithKey(key, ciphertext, "purpose")
+	assert.Nil(err)
+	assert.Equal("payload", string(plaintext))
+	_, err = DecryptPayloadWithKey(key, ciphertext, "other purpose")
+	assert.NotNil(err)
+	_, err = DecryptPayloadWithKey(bytes.Repeat([]byte{2}, 32), ciphertext, "purpose")
+	assert.NotNil(err)
+
+	// Test-2 : the keyring can't decrypt it
+	_, err = DecryptPayload(ciphertext, "purpose")
+	assert.NotNil(err)
+}
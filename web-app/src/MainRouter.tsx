@@ -23,6 +23,9 @@ import { baseUrl } from "./history";
 
 const Login = React.lazy(() => import("./screens/LoginPage/Login"));
 const Logout = React.lazy(() => import("./screens/LogoutPage/LogoutPage"));
+const LoginCallback = React.lazy(
+  () => import("./screens/LoginPage/LoginCallback"),
+);
 
 const MainRouter = () => {
   return (
@@ -44,6 +47,14 @@ const MainRouter = () => {
             </Suspense>
           }
         />
+        <Route
+          path="/oauth_callback"
+          element={
+            <Suspense fallback={<LoadingComponent />}>
+              <LoginCallback />
+            </Suspense>
+          }
+        />
         <Route
           path={"/*"}
           element={<ProtectedRoute Component={AppConsole} />}
@@ -48,7 +48,7 @@ export interface ListBucketsResponse {
   /** list of resulting buckets */
   buckets?: Bucket[];
   /**
-   * number of buckets accessible to the user
+   * number of buckets accessible to the user matching the filters, before pagination
    * @format int64
    */
   total?: number;
@@ -71,6 +71,390 @@ export interface GetBucketRetentionConfig {
   validity?: number;
 }
 
+export interface PutBucketRetentionRequest {
+  mode: ObjectRetentionMode;
+  unit: ObjectRetentionUnit;
+  /** @format int32 */
+  validity: number;
+  /** token returned by a previous request, required to apply compliance mode */
+  confirmationToken?: string;
+}
+
+export interface PutBucketRetentionResponse {
+  applied?: boolean;
+  confirmationRequired?: boolean;
+  warning?: string;
+  confirmationToken?: string;
+}
+
+export interface LifecycleTag {
+  key?: string;
+  value?: string;
+}
+
+export interface LifecycleExpiration {
+  /** @format int64 */
+  days?: number;
+  date?: string;
+  delete_marker?: boolean;
+  /** @format int64 */
+  noncurrent_expiration_days?: number;
+  /** @format int64 */
+  newer_noncurrent_expiration_versions?: number;
+}
+
+export interface LifecycleTransition {
+  /** @format int64 */
+  days?: number;
+  date?: string;
+  storage_class?: string;
+  /** @format int64 */
+  noncurrent_transition_days?: number;
+  noncurrent_storage_class?: string;
+}
+
+export interface ObjectBucketLifecycle {
+  id?: string;
+  prefix?: string;
+  status?: string;
+  tags?: LifecycleTag[];
+  expiration?: LifecycleExpiration;
+  transition?: LifecycleTransition;
+  /** @format int64 */
+  abort_incomplete_multipart_days?: number;
+}
+
+export interface BucketLifecycleResponse {
+  lifecycle?: ObjectBucketLifecycle[];
+}
+
+export interface LifecycleRuleRequest {
+  /** rule ID, a random ID is generated when adding a rule without one */
+  id?: string;
+  disable?: boolean;
+  prefix?: string;
+  tags?: LifecycleTag[];
+  /** @format int32 */
+  expiry_days?: number;
+  /** expiration date in YYYY-MM-DD format */
+  expiry_date?: string;
+  expired_object_delete_marker?: boolean;
+  /** @format int32 */
+  noncurrentversion_expiration_days?: number;
+  /** @format int32 */
+  newer_noncurrentversion_expiration_versions?: number;
+  /** @format int32 */
+  abort_incomplete_multipart_days?: number;
+  /** @format int32 */
+  transition_days?: number;
+  /** transition date in YYYY-MM-DD format */
+  transition_date?: string;
+  /** name of the configured tier objects will be transitioned to */
+  storage_class?: string;
+  /** @format int32 */
+  noncurrentversion_transition_days?: number;
+  noncurrentversion_transition_storage_class?: string;
+}
+
+export interface LifecycleImportRequest {
+  /** configuration format, defaults to xml */
+  format?: "xml" | "json";
+  configuration: string;
+}
+
+export interface BucketReplicationDestination {
+  /** ARN of the remote target */
+  bucket?: string;
+  endpoint?: string;
+  target_bucket?: string;
+  region?: string;
+  sync_mode?: string;
+  online?: boolean;
+}
+
+export interface BucketReplicationRule {
+  id?: string;
+  status?: string;
+  /** @format int32 */
+  priority?: number;
+  prefix?: string;
+  tags?: string;
+  delete_marker_replication?: boolean;
+  deletes_replication?: boolean;
+  existing_objects?: boolean;
+  metadata_replication?: boolean;
+  storage_class?: string;
+  destination?: BucketReplicationDestination;
+}
+
+export interface BucketReplicationResponse {
+  rules?: BucketReplicationRule[];
+}
+
+export interface AddBucketReplicationRule {
+  /** rule ID, a random ID is generated when not provided */
+  id?: string;
+  /** @format int32 */
+  priority: number;
+  disable?: boolean;
+  prefix?: string;
+  /** tag filter in key1=value1&key2=value2 format */
+  tags?: string;
+  delete_marker_replication?: boolean;
+  deletes_replication?: boolean;
+  existing_objects?: boolean;
+  metadata_replication?: boolean;
+  storage_class?: string;
+  /** ARN of an existing remote target, when empty a new remote target is created */
+  arn?: string;
+  /** remote target URL, e.g. https://minio.example.net:9000 */
+  endpoint?: string;
+  access_key?: string;
+  secret_key?: string;
+  target_bucket?: string;
+  region?: string;
+  sync_mode?: "async" | "sync";
+  /**
+   * bandwidth limit in bytes per second
+   * @format int64
+   */
+  bandwidth?: number;
+  /**
+   * health check period in seconds
+   * @format int32
+   */
+  health_check_period?: number;
+}
+
+export interface BucketReplicationTargetMetrics {
+  arn?: string;
+  endpoint?: string;
+  target_bucket?: string;
+  online?: boolean;
+  /** @format int64 */
+  replicated_count?: number;
+  /** @format int64 */
+  replicated_bytes?: number;
+  /** @format int64 */
+  pending_count?: number;
+  /** @format int64 */
+  pending_bytes?: number;
+  /** @format int64 */
+  failed_count?: number;
+  /** @format int64 */
+  failed_bytes?: number;
+  /** @format int64 */
+  bandwidth_limit?: number;
+  /** @format double */
+  current_bandwidth?: number;
+}
+
+export interface BucketReplicationMetrics {
+  /** @format int64 */
+  uptime?: number;
+  /** @format int64 */
+  replicated_count?: number;
+  /** @format int64 */
+  replicated_bytes?: number;
+  /** @format int64 */
+  replica_count?: number;
+  /** @format int64 */
+  replica_bytes?: number;
+  /** @format int64 */
+  pending_count?: number;
+  /** @format int64 */
+  pending_bytes?: number;
+  /** @format int64 */
+  failed_count?: number;
+  /** @format int64 */
+  failed_bytes?: number;
+  /** @format int64 */
+  queued_count?: number;
+  /** @format int64 */
+  queued_bytes?: number;
+  targets?: BucketReplicationTargetMetrics[];
+}
+
+export interface CorsRule {
+  id?: string;
+  allowed_origins?: string[];
+  allowed_methods?: string[];
+  allowed_headers?: string[];
+  expose_headers?: string[];
+  /** @format int64 */
+  max_age_seconds?: number;
+}
+
+export interface BucketCorsConfiguration {
+  rules?: CorsRule[];
+}
+
+export interface CorsTestRequest {
+  origin: string;
+  method: string;
+  request_headers?: string[];
+  /** rules to evaluate instead of the bucket configuration, used to try changes before saving them */
+  rules?: CorsRule[];
+}
+
+export interface CorsRuleEvaluation {
+  /**
+   * position of the rule in the configuration, starting at 1
+   * @format int32
+   */
+  rule?: number;
+  id?: string;
+  origin_allowed?: boolean;
+  method_allowed?: boolean;
+  headers_allowed?: boolean;
+  reason?: string;
+}
+
+export interface CorsTestResponse {
+  allowed?: boolean;
+  /**
+   * position of the first matching rule starting at 1, 0 when no rule matched
+   * @format int32
+   */
+  matched_rule?: number;
+  explanation?: string;
+  /** CORS headers MinIO would send back for this request */
+  response_headers?: Record<string, string>;
+  evaluations?: CorsRuleEvaluation[];
+}
+
+export interface MirrorJobRequest {
+  prefix?: string;
+  target_bucket: string;
+  target_prefix?: string;
+  /** overwrite objects that differ only when the source is newer than the target */
+  newer_only?: boolean;
+  /** overwrite every object that differs in the target */
+  overwrite?: boolean;
+  /** remove objects in the target that don't exist in the source */
+  remove_extraneous?: boolean;
+}
+
+export interface MirrorJobError {
+  key?: string;
+  action?: string;
+  error?: string;
+}
+
+export interface MirrorJob {
+  id?: string;
+  source_bucket?: string;
+  source_prefix?: string;
+  target_bucket?: string;
+  target_prefix?: string;
+  newer_only?: boolean;
+  overwrite?: boolean;
+  remove_extraneous?: boolean;
+  /** one of planning, running, paused, canceling, completed, failed or canceled */
+  status?: string;
+  started?: string;
+  finished?: string;
+  /** @format int64 */
+  total_objects?: number;
+  /** @format int64 */
+  total_bytes?: number;
+  /** @format int64 */
+  copied_objects?: number;
+  /** @format int64 */
+  copied_bytes?: number;
+  /** @format int64 */
+  skipped_objects?: number;
+  /** @format int64 */
+  removed_objects?: number;
+  /** @format int64 */
+  failed_objects?: number;
+  /** @format int64 */
+  bytes_per_second?: number;
+  /** @format int64 */
+  eta_seconds?: number;
+  errors?: MirrorJobError[];
+}
+
+export interface MirrorJobList {
+  jobs?: MirrorJob[];
+}
+
+export interface InventoryExportRequest {
+  prefix?: string;
+  /** one of csv, jsonl or parquet, csv by default */
+  format?: string;
+  /** list every object version and delete marker */
+  versions?: boolean;
+  /** include the tags and the retention status of every object */
+  metadata?: boolean;
+  destination_bucket: string;
+  destination_prefix?: string;
+}
+
+export interface InventoryExportResult {
+  bucket?: string;
+  object?: string;
+  format?: string;
+  /** @format int64 */
+  objects?: number;
+  /** @format int64 */
+  size?: number;
+}
+
+export interface BucketConfigBundle {
+  /** name of the exported bucket, policy resources are rewritten when importing into another bucket */
+  bucket?: string;
+  versioning?: SetBucketVersioning;
+  policy?: string;
+  tags?: Record<string, string>;
+  encryption?: BucketEncryptionSettings;
+  locking?: boolean;
+  retention?: GetBucketRetentionConfig;
+  /** lifecycle configuration in the JSON format of the lifecycle export */
+  lifecycle?: object;
+  notifications?: BucketNotificationConfig[];
+  /**
+   * hard quota in bytes
+   * @format int64
+   */
+  quota?: number;
+}
+
+export interface BucketNotificationConfig {
+  id?: string;
+  arn: string;
+  events?: string[];
+  prefix?: string;
+  suffix?: string;
+}
+
+export interface BucketConfigImportRequest {
+  bundle: BucketConfigBundle;
+  /** sections of the bundle to import, all of them when empty */
+  sections?: string[];
+  /** only compute the changes without applying them */
+  dryRun?: boolean;
+  /** token returned by a previous request, required to apply a COMPLIANCE retention */
+  confirmationToken?: string;
+}
+
+export interface BucketConfigChange {
+  section?: string;
+  /** one of add, update, remove, unchanged or conflict */
+  action?: string;
+  current?: string;
+  desired?: string;
+  message?: string;
+}
+
+export interface BucketConfigImportResponse {
+  applied?: boolean;
+  changes?: BucketConfigChange[];
+  confirmationRequired?: boolean;
+  warning?: string;
+  confirmationToken?: string;
+}
+
 export interface ListObjectsResponse {
   /** list of resulting objects */
   objects?: BucketObject[];
@@ -104,6 +488,44 @@ export interface BucketObject {
 
 export interface MakeBucketRequest {
   name: string;
+  /** name of a server configured template the bucket settings are based on */
+  template?: string;
+  settings?: BucketSettings;
+  /** token returned by a previous request, required to create a bucket with COMPLIANCE retention */
+  confirmationToken?: string;
+}
+
+export interface BucketSettings {
+  locking?: boolean;
+  versioning?: SetBucketVersioning;
+  retention?: PutBucketRetentionRequest;
+  /**
+   * hard quota in bytes
+   * @format int64
+   */
+  quota?: number;
+  access?: BucketAccess;
+  /** bucket policy applied with CUSTOM access */
+  definition?: string;
+  encryption?: BucketEncryptionSettings;
+  tags?: Record<string, string>;
+  lifecycle?: LifecycleRuleRequest[];
+}
+
+export interface BucketEncryptionSettings {
+  /** sse-s3 or sse-kms */
+  type: string;
+  kmsKeyID?: string;
+}
+
+export interface BucketTemplate {
+  name: string;
+  description?: string;
+  settings?: BucketSettings;
+}
+
+export interface BucketTemplateList {
+  templates?: BucketTemplate[];
 }
 
 export interface ApiError {
@@ -113,6 +535,10 @@ export interface ApiError {
 
 export interface MakeBucketsResponse {
   bucketName?: string;
+  /** the bucket wasn't created, COMPLIANCE retention must be confirmed with the confirmation token */
+  confirmationRequired?: boolean;
+  warning?: string;
+  confirmationToken?: string;
 }
 
 export interface BucketQuota {
@@ -120,18 +546,57 @@ export interface BucketQuota {
   type?: "hard";
 }
 
+export interface SetBucketQuota {
+  /**
+   * hard quota in bytes
+   * @format int64
+   */
+  quota: number;
+}
+
 export interface LoginResponse {
   sessionId?: string;
   IDPRefreshToken?: string;
+  secondFactorToken?: string;
+  secondFactorEnrollment?: boolean;
+}
+
+export interface LoginSecondFactorResponse {
+  enrollmentRequired?: boolean;
+}
+
+export interface SecondFactorCodeRequest {
+  code: string;
+}
+
+export interface SecondFactorEnrollment {
+  secret?: string;
+  provisioningUri?: string;
+  recoveryCodes?: string[];
+}
+
+export interface SecondFactorStatus {
+  available?: boolean;
+  enrolled?: boolean;
+  required?: boolean;
 }
 
 export interface LoginDetails {
-  loginStrategy?: "form" | "service-account" | "redirect-service-account";
+  loginStrategy?:
+    | "form"
+    | "ldap"
+    | "service-account"
+    | "redirect-service-account";
   redirectRules?: RedirectRule[];
   isK8S?: boolean;
   animatedLogin?: boolean;
 }
 
+export interface LoginOauth2AuthRequest {
+  state: string;
+  code: string;
+}
+
 export interface LoginRequest {
   accessKey?: string;
   secretKey?: string;
@@ -153,6 +618,35 @@ export interface Principal {
   hm?: boolean;
   ob?: boolean;
   customStyleOb?: string;
+  sessionId?: string;
+  /** @format int64 */
+  stsExpiration?: number;
+  /** @format int64 */
+  sessionExpiration?: number;
+}
+
+export interface SessionRefreshResponse {
+  expires?: string;
+  maxExpires?: string;
+}
+
+export interface ActiveSession {
+  id?: string;
+  ip?: string;
+  user_agent?: string;
+  created?: string;
+  last_seen?: string;
+  expires?: string;
+  current?: boolean;
+}
+
+export interface ListActiveSessionsResponse {
+  sessions?: ActiveSession[];
+}
+
+export interface RevokeActiveSessionsResponse {
+  /** @format int64 */
+  revoked?: number;
 }
 
 export interface SessionResponse {
@@ -278,6 +772,102 @@ export interface Metadata {
   objectMetadata?: Record<string, any>;
 }
 
+export interface ObjectVersion {
+  version_id?: string;
+  /** @format int64 */
+  size?: number;
+  last_modified?: string;
+  etag?: string;
+  is_latest?: boolean;
+  is_delete_marker?: boolean;
+  /** who wrote the version, taken from the object metadata */
+  writer?: string;
+  legal_hold_status?: string;
+  retention_mode?: string;
+  retention_until_date?: string;
+}
+
+export interface ObjectVersionHistory {
+  name?: string;
+  versions?: ObjectVersion[];
+  /** @format int64 */
+  total_versions?: number;
+  /** @format int64 */
+  delete_markers?: number;
+  /** @format int64 */
+  total_bytes?: number;
+  /** @format int64 */
+  noncurrent_bytes?: number;
+}
+
+export interface PruneObjectVersionsRequest {
+  prefix: string;
+  /**
+   * only prune versions last modified more than this number of days ago
+   * @format int32
+   */
+  older_than_days?: number;
+  /**
+   * number of most recent versions kept, including the current one
+   * @format int32
+   */
+  keep_latest?: number;
+  /** only report the versions that would be pruned */
+  dry_run?: boolean;
+}
+
+export interface SkippedObjectVersion {
+  version_id?: string;
+  reason?: string;
+}
+
+export interface PruneObjectVersionsResponse {
+  pruned?: ObjectVersion[];
+  skipped?: SkippedObjectVersion[];
+  /** @format int64 */
+  freed_bytes?: number;
+  dry_run?: boolean;
+}
+
+export interface IncompleteUpload {
+  key?: string;
+  upload_id?: string;
+  initiated?: string;
+  /** @format int64 */
+  parts?: number;
+  /**
+   * size of the parts uploaded so far
+   * @format int64
+   */
+  size?: number;
+}
+
+export interface ListIncompleteUploadsResponse {
+  uploads?: IncompleteUpload[];
+  /** @format int64 */
+  total?: number;
+  /** @format int64 */
+  total_size?: number;
+}
+
+export interface AbortIncompleteUploadsRequest {
+  prefix?: string;
+  /**
+   * only abort uploads initiated more than this number of hours ago
+   * @format int32
+   */
+  older_than_hours: number;
+  /** only report the uploads that would be aborted */
+  dry_run?: boolean;
+}
+
+export interface AbortIncompleteUploadsResponse {
+  aborted?: IncompleteUpload[];
+  /** @format int64 */
+  freed_bytes?: number;
+  dry_run?: boolean;
+}
+
 export interface PermissionResource {
   resource?: string;
   conditionOperator?: string;
@@ -578,54 +1168,270 @@ export class Api<
      * @request POST:/login
      */
     login: (body: LoginRequest, params: RequestParams = {}) =>
-      this.request<void, ApiError>({
+      this.request<LoginSecondFactorResponse, ApiError>({
         path: `/login`,
         method: "POST",
         body: body,
         type: ContentType.Json,
+        format: "json",
         ...params,
       }),
-  };
-  logout = {
+
     /**
      * No description
      *
      * @tags Auth
-     * @name Logout
-     * @summary Logout from Console.
-     * @request POST:/logout
-     * @secure
+     * @name LoginSecondFactor
+     * @summary Finishes a login with the code of the second factor
+     * @request POST:/login/mfa
      */
-    logout: (body: LogoutRequest, params: RequestParams = {}) =>
+    loginSecondFactor: (
+      body: SecondFactorCodeRequest,
+      params: RequestParams = {},
+    ) =>
       this.request<void, ApiError>({
-        path: `/logout`,
+        path: `/login/mfa`,
         method: "POST",
         body: body,
-        secure: true,
         type: ContentType.Json,
         ...params,
       }),
-  };
-  session = {
+
     /**
      * No description
      *
      * @tags Auth
-     * @name SessionCheck
-     * @summary Endpoint to check if your session is still valid
-     * @request GET:/session
-     * @secure
+     * @name LoginSecondFactorEnroll
+     * @summary Enrolls the second factor a login requires
+     * @request POST:/login/mfa/enroll
      */
-    sessionCheck: (params: RequestParams = {}) =>
-      this.request<SessionResponse, ApiError>({
-        path: `/session`,
-        method: "GET",
-        secure: true,
+    loginSecondFactorEnroll: (params: RequestParams = {}) =>
+      this.request<SecondFactorEnrollment, ApiError>({
+        path: `/login/mfa/enroll`,
+        method: "POST",
         format: "json",
         ...params,
       }),
-  };
-  buckets = {
+
+    /**
+     * No description
+     *
+     * @tags Auth
+     * @name LoginOauth2Auth
+     * @summary Identity Provider oauth2 callback endpoint.
+     * @request POST:/login/oauth2/auth
+     */
+    loginOauth2Auth: (
+      body: LoginOauth2AuthRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<void, ApiError>({
+        path: `/login/oauth2/auth`,
+        method: "POST",
+        body: body,
+        type: ContentType.Json,
+        ...params,
+      }),
+  };
+  logout = {
+    /**
+     * No description
+     *
+     * @tags Auth
+     * @name Logout
+     * @summary Logout from Console.
+     * @request POST:/logout
+     * @secure
+     */
+    logout: (body: LogoutRequest, params: RequestParams = {}) =>
+      this.request<void, ApiError>({
+        path: `/logout`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        ...params,
+      }),
+  };
+  session = {
+    /**
+     * No description
+     *
+     * @tags Auth
+     * @name SessionCheck
+     * @summary Endpoint to check if your session is still valid
+     * @request GET:/session
+     * @secure
+     */
+    sessionCheck: (params: RequestParams = {}) =>
+      this.request<SessionResponse, ApiError>({
+        path: `/session`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Auth
+     * @name SessionRefresh
+     * @summary Renews the STS credentials of the current session before they expire
+     * @request POST:/session/refresh
+     * @secure
+     */
+    sessionRefresh: (params: RequestParams = {}) =>
+      this.request<SessionRefreshResponse, ApiError>({
+        path: `/session/refresh`,
+        method: "POST",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+  };
+  sessions = {
+    /**
+     * No description
+     *
+     * @tags Auth
+     * @name ListActiveSessions
+     * @summary Lists the active console sessions of the current user
+     * @request GET:/sessions
+     * @secure
+     */
+    listActiveSessions: (params: RequestParams = {}) =>
+      this.request<ListActiveSessionsResponse, ApiError>({
+        path: `/sessions`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Auth
+     * @name RevokeActiveSessions
+     * @summary Revokes every console session of the current user
+     * @request DELETE:/sessions
+     * @secure
+     */
+    revokeActiveSessions: (
+      query?: {
+        keep_current?: boolean;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<RevokeActiveSessionsResponse, ApiError>({
+        path: `/sessions`,
+        method: "DELETE",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Auth
+     * @name RevokeActiveSession
+     * @summary Revokes a console session of the current user
+     * @request DELETE:/sessions/{session_id}
+     * @secure
+     */
+    revokeActiveSession: (sessionId: string, params: RequestParams = {}) =>
+      this.request<void, ApiError>({
+        path: `/sessions/${encodeURIComponent(sessionId)}`,
+        method: "DELETE",
+        secure: true,
+        ...params,
+      }),
+  };
+  mfa = {
+    /**
+     * No description
+     *
+     * @tags Auth
+     * @name SecondFactorStatus
+     * @summary Returns whether the current user has a second factor
+     * @request GET:/mfa
+     * @secure
+     */
+    secondFactorStatus: (params: RequestParams = {}) =>
+      this.request<SecondFactorStatus, ApiError>({
+        path: `/mfa`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Auth
+     * @name SecondFactorEnroll
+     * @summary Starts the enrollment of a second factor for the current user
+     * @request POST:/mfa/enroll
+     * @secure
+     */
+    secondFactorEnroll: (params: RequestParams = {}) =>
+      this.request<SecondFactorEnrollment, ApiError>({
+        path: `/mfa/enroll`,
+        method: "POST",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Auth
+     * @name SecondFactorConfirm
+     * @summary Confirms the enrollment of the second factor of the current user with a code
+     * @request POST:/mfa/confirm
+     * @secure
+     */
+    secondFactorConfirm: (
+      body: SecondFactorCodeRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<void, ApiError>({
+        path: `/mfa/confirm`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Auth
+     * @name SecondFactorDisable
+     * @summary Removes the second factor of the current user
+     * @request POST:/mfa/disable
+     * @secure
+     */
+    secondFactorDisable: (
+      body: SecondFactorCodeRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<void, ApiError>({
+        path: `/mfa/disable`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        ...params,
+      }),
+  };
+  buckets = {
     /**
      * No description
      *
@@ -635,10 +1441,32 @@ export class Api<
      * @request GET:/buckets
      * @secure
      */
-    listBuckets: (params: RequestParams = {}) =>
+    listBuckets: (
+      query?: {
+        /** only list buckets whose name starts with prefix */
+        prefix?: string;
+        /** only list buckets whose name contains search, case insensitive */
+        search?: string;
+        /** only list buckets with every tag of a comma separated list of key or key=value */
+        tags?: string;
+        /** one of name, size, objects or created, name by default */
+        sort_by?: string;
+        /** asc or desc, asc by default */
+        sort_order?: string;
+        /** @format int32 */
+        offset?: number;
+        /**
+         * maximum number of buckets returned, all of them when empty
+         * @format int32
+         */
+        limit?: number;
+      },
+      params: RequestParams = {},
+    ) =>
       this.request<ListBucketsResponse, ApiError>({
         path: `/buckets`,
         method: "GET",
+        query: query,
         secure: true,
         format: "json",
         ...params,
@@ -957,6 +1785,55 @@ export class Api<
         ...params,
       }),
 
+    /**
+     * No description
+     *
+     * @tags Object
+     * @name GetObjectVersionHistory
+     * @summary Gets the full version history of an object
+     * @request GET:/buckets/{bucket_name}/objects/versions
+     * @secure
+     */
+    getObjectVersionHistory: (
+      bucketName: string,
+      query: {
+        prefix: string;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<ObjectVersionHistory, ApiError>({
+        path: `/buckets/${encodeURIComponent(bucketName)}/objects/versions`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Object
+     * @name PruneObjectVersions
+     * @summary Deletes the noncurrent versions of an object that are no longer needed
+     * @request POST:/buckets/{bucket_name}/objects/versions/prune
+     * @secure
+     */
+    pruneObjectVersions: (
+      bucketName: string,
+      body: PruneObjectVersionsRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<PruneObjectVersionsResponse, ApiError>({
+        path: `/buckets/${encodeURIComponent(bucketName)}/objects/versions/prune`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
     /**
      * No description
      *
@@ -975,6 +1852,47 @@ export class Api<
         ...params,
       }),
 
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name SetBucketQuota
+     * @summary Set Bucket Hard Quota
+     * @request PUT:/buckets/{name}/quota
+     * @secure
+     */
+    setBucketQuota: (
+      name: string,
+      body: SetBucketQuota,
+      params: RequestParams = {},
+    ) =>
+      this.request<BucketQuota, ApiError>({
+        path: `/buckets/${encodeURIComponent(name)}/quota`,
+        method: "PUT",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name DeleteBucketQuota
+     * @summary Clear Bucket Quota
+     * @request DELETE:/buckets/{name}/quota
+     * @secure
+     */
+    deleteBucketQuota: (name: string, params: RequestParams = {}) =>
+      this.request<void, ApiError>({
+        path: `/buckets/${encodeURIComponent(name)}/quota`,
+        method: "DELETE",
+        secure: true,
+        ...params,
+      }),
+
     /**
      * No description
      *
@@ -1020,23 +1938,18 @@ export class Api<
      * No description
      *
      * @tags Bucket
-     * @name GetBucketRewind
-     * @summary Get objects in a bucket for a rewind date
-     * @request GET:/buckets/{bucket_name}/rewind/{date}
+     * @name GetBucketRetentionConfig
+     * @summary Get Bucket's default retention configuration
+     * @request GET:/buckets/{bucket_name}/retention
      * @secure
      */
-    getBucketRewind: (
+    getBucketRetentionConfig: (
       bucketName: string,
-      date: string,
-      query?: {
-        prefix?: string;
-      },
       params: RequestParams = {},
     ) =>
-      this.request<RewindResponse, ApiError>({
-        path: `/buckets/${encodeURIComponent(bucketName)}/rewind/${encodeURIComponent(date)}`,
+      this.request<GetBucketRetentionConfig, ApiError>({
+        path: `/buckets/${encodeURIComponent(bucketName)}/retention`,
         method: "GET",
-        query: query,
         secure: true,
         format: "json",
         ...params,
@@ -1046,13 +1959,674 @@ export class Api<
      * No description
      *
      * @tags Bucket
-     * @name GetMaxShareLinkExp
-     * @summary Get max expiration time for share link in seconds
-     * @request GET:/buckets/max-share-exp
+     * @name SetBucketRetentionConfig
+     * @summary Set Bucket's default retention configuration
+     * @request PUT:/buckets/{bucket_name}/retention
      * @secure
      */
-    getMaxShareLinkExp: (params: RequestParams = {}) =>
-      this.request<MaxShareLinkExpResponse, ApiError>({
+    setBucketRetentionConfig: (
+      bucketName: string,
+      body: PutBucketRetentionRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<PutBucketRetentionResponse, ApiError>({
+        path: `/buckets/${encodeURIComponent(bucketName)}/retention`,
+        method: "PUT",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name GetBucketLifecycle
+     * @summary Bucket Lifecycle
+     * @request GET:/buckets/{bucket_name}/lifecycle
+     * @secure
+     */
+    getBucketLifecycle: (bucketName: string, params: RequestParams = {}) =>
+      this.request<BucketLifecycleResponse, ApiError>({
+        path: `/buckets/${encodeURIComponent(bucketName)}/lifecycle`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name AddBucketLifecycle
+     * @summary Add Bucket Lifecycle
+     * @request POST:/buckets/{bucket_name}/lifecycle
+     * @secure
+     */
+    addBucketLifecycle: (
+      bucketName: string,
+      body: LifecycleRuleRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<void, ApiError>({
+        path: `/buckets/${encodeURIComponent(bucketName)}/lifecycle`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name UpdateBucketLifecycle
+     * @summary Update Lifecycle rule
+     * @request PUT:/buckets/{bucket_name}/lifecycle/{lifecycle_id}
+     * @secure
+     */
+    updateBucketLifecycle: (
+      bucketName: string,
+      lifecycleId: string,
+      body: LifecycleRuleRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<void, ApiError>({
+        path: `/buckets/${encodeURIComponent(bucketName)}/lifecycle/${encodeURIComponent(lifecycleId)}`,
+        method: "PUT",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name DeleteBucketLifecycleRule
+     * @summary Delete Lifecycle rule
+     * @request DELETE:/buckets/{bucket_name}/lifecycle/{lifecycle_id}
+     * @secure
+     */
+    deleteBucketLifecycleRule: (
+      bucketName: string,
+      lifecycleId: string,
+      params: RequestParams = {},
+    ) =>
+      this.request<void, ApiError>({
+        path: `/buckets/${encodeURIComponent(bucketName)}/lifecycle/${encodeURIComponent(lifecycleId)}`,
+        method: "DELETE",
+        secure: true,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name ExportBucketLifecycle
+     * @summary Export Bucket Lifecycle configuration as XML or JSON
+     * @request GET:/buckets/{bucket_name}/lifecycle-export
+     * @secure
+     */
+    exportBucketLifecycle: (
+      bucketName: string,
+      query?: {
+        /** @default "xml" */
+        format?: string;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<File, ApiError>({
+        path: `/buckets/${encodeURIComponent(bucketName)}/lifecycle-export`,
+        method: "GET",
+        query: query,
+        secure: true,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name ImportBucketLifecycle
+     * @summary Replace Bucket Lifecycle configuration with an XML or JSON document
+     * @request POST:/buckets/{bucket_name}/lifecycle-import
+     * @secure
+     */
+    importBucketLifecycle: (
+      bucketName: string,
+      body: LifecycleImportRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<void, ApiError>({
+        path: `/buckets/${encodeURIComponent(bucketName)}/lifecycle-import`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name GetBucketReplication
+     * @summary Bucket Replication
+     * @request GET:/buckets/{bucket_name}/replication
+     * @secure
+     */
+    getBucketReplication: (bucketName: string, params: RequestParams = {}) =>
+      this.request<BucketReplicationResponse, ApiError>({
+        path: `/buckets/${encodeURIComponent(bucketName)}/replication`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name AddBucketReplicationRule
+     * @summary Add Bucket Replication Rule
+     * @request POST:/buckets/{bucket_name}/replication
+     * @secure
+     */
+    addBucketReplicationRule: (
+      bucketName: string,
+      body: AddBucketReplicationRule,
+      params: RequestParams = {},
+    ) =>
+      this.request<void, ApiError>({
+        path: `/buckets/${encodeURIComponent(bucketName)}/replication`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name DeleteBucketReplicationRule
+     * @summary Remove Bucket Replication Rule
+     * @request DELETE:/buckets/{bucket_name}/replication/{rule_id}
+     * @secure
+     */
+    deleteBucketReplicationRule: (
+      bucketName: string,
+      ruleId: string,
+      query?: {
+        /** also remove the remote target of the rule unless another rule replicates to it */
+        remove_target?: boolean;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<void, ApiError>({
+        path: `/buckets/${encodeURIComponent(bucketName)}/replication/${encodeURIComponent(ruleId)}`,
+        method: "DELETE",
+        query: query,
+        secure: true,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name GetBucketReplicationMetrics
+     * @summary Bucket Replication Status and Metrics
+     * @request GET:/buckets/{bucket_name}/replication-metrics
+     * @secure
+     */
+    getBucketReplicationMetrics: (
+      bucketName: string,
+      params: RequestParams = {},
+    ) =>
+      this.request<BucketReplicationMetrics, ApiError>({
+        path: `/buckets/${encodeURIComponent(bucketName)}/replication-metrics`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name GetBucketCors
+     * @summary Bucket CORS Configuration
+     * @request GET:/buckets/{bucket_name}/cors
+     * @secure
+     */
+    getBucketCors: (bucketName: string, params: RequestParams = {}) =>
+      this.request<BucketCorsConfiguration, ApiError>({
+        path: `/buckets/${encodeURIComponent(bucketName)}/cors`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name SetBucketCors
+     * @summary Set Bucket CORS Configuration
+     * @request PUT:/buckets/{bucket_name}/cors
+     * @secure
+     */
+    setBucketCors: (
+      bucketName: string,
+      body: BucketCorsConfiguration,
+      params: RequestParams = {},
+    ) =>
+      this.request<void, ApiError>({
+        path: `/buckets/${encodeURIComponent(bucketName)}/cors`,
+        method: "PUT",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name DeleteBucketCors
+     * @summary Remove Bucket CORS Configuration
+     * @request DELETE:/buckets/{bucket_name}/cors
+     * @secure
+     */
+    deleteBucketCors: (bucketName: string, params: RequestParams = {}) =>
+      this.request<void, ApiError>({
+        path: `/buckets/${encodeURIComponent(bucketName)}/cors`,
+        method: "DELETE",
+        secure: true,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name TestBucketCors
+     * @summary Evaluate a cross-origin request against the Bucket CORS rules
+     * @request POST:/buckets/{bucket_name}/cors-test
+     * @secure
+     */
+    testBucketCors: (
+      bucketName: string,
+      body: CorsTestRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<CorsTestResponse, ApiError>({
+        path: `/buckets/${encodeURIComponent(bucketName)}/cors-test`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name DownloadBucketDiff
+     * @summary Download the differences between a bucket prefix and a target location as CSV
+     * @request GET:/buckets/{bucket_name}/diff
+     * @secure
+     */
+    downloadBucketDiff: (
+      bucketName: string,
+      query: {
+        prefix?: string;
+        target_bucket: string;
+        target_prefix?: string;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<File, ApiError>({
+        path: `/buckets/${encodeURIComponent(bucketName)}/diff`,
+        method: "GET",
+        query: query,
+        secure: true,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name StartBucketMirror
+     * @summary Start a job mirroring a bucket prefix into a target location
+     * @request POST:/buckets/{bucket_name}/mirror
+     * @secure
+     */
+    startBucketMirror: (
+      bucketName: string,
+      body: MirrorJobRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<MirrorJob, ApiError>({
+        path: `/buckets/${encodeURIComponent(bucketName)}/mirror`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name ListMirrorJobs
+     * @summary List the mirror jobs started by the current user
+     * @request GET:/buckets/mirror-jobs
+     * @secure
+     */
+    listMirrorJobs: (params: RequestParams = {}) =>
+      this.request<MirrorJobList, ApiError>({
+        path: `/buckets/mirror-jobs`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name GetMirrorJob
+     * @summary Get the progress or the final report of a mirror job
+     * @request GET:/buckets/mirror-jobs/{job_id}
+     * @secure
+     */
+    getMirrorJob: (jobId: string, params: RequestParams = {}) =>
+      this.request<MirrorJob, ApiError>({
+        path: `/buckets/mirror-jobs/${encodeURIComponent(jobId)}`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name DeleteMirrorJob
+     * @summary Cancel a running mirror job or remove a finished one
+     * @request DELETE:/buckets/mirror-jobs/{job_id}
+     * @secure
+     */
+    deleteMirrorJob: (jobId: string, params: RequestParams = {}) =>
+      this.request<void, ApiError>({
+        path: `/buckets/mirror-jobs/${encodeURIComponent(jobId)}`,
+        method: "DELETE",
+        secure: true,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name PauseMirrorJob
+     * @summary Pause a running mirror job
+     * @request POST:/buckets/mirror-jobs/{job_id}/pause
+     * @secure
+     */
+    pauseMirrorJob: (jobId: string, params: RequestParams = {}) =>
+      this.request<MirrorJob, ApiError>({
+        path: `/buckets/mirror-jobs/${encodeURIComponent(jobId)}/pause`,
+        method: "POST",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name ResumeMirrorJob
+     * @summary Resume a paused mirror job
+     * @request POST:/buckets/mirror-jobs/{job_id}/resume
+     * @secure
+     */
+    resumeMirrorJob: (jobId: string, params: RequestParams = {}) =>
+      this.request<MirrorJob, ApiError>({
+        path: `/buckets/mirror-jobs/${encodeURIComponent(jobId)}/resume`,
+        method: "POST",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name DownloadBucketInventory
+     * @summary Download an inventory of the objects in a bucket
+     * @request GET:/buckets/{bucket_name}/inventory
+     * @secure
+     */
+    downloadBucketInventory: (
+      bucketName: string,
+      query?: {
+        prefix?: string;
+        /** one of csv, jsonl or parquet, csv by default */
+        format?: string;
+        versions?: boolean;
+        metadata?: boolean;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<File, ApiError>({
+        path: `/buckets/${encodeURIComponent(bucketName)}/inventory`,
+        method: "GET",
+        query: query,
+        secure: true,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name ExportBucketInventory
+     * @summary Write an inventory of the objects in a bucket into a destination bucket
+     * @request POST:/buckets/{bucket_name}/inventory
+     * @secure
+     */
+    exportBucketInventory: (
+      bucketName: string,
+      body: InventoryExportRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<InventoryExportResult, ApiError>({
+        path: `/buckets/${encodeURIComponent(bucketName)}/inventory`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name ExportBucketConfig
+     * @summary Export the full configuration of a bucket as a single document
+     * @request GET:/buckets/{bucket_name}/config-export
+     * @secure
+     */
+    exportBucketConfig: (bucketName: string, params: RequestParams = {}) =>
+      this.request<BucketConfigBundle, ApiError>({
+        path: `/buckets/${encodeURIComponent(bucketName)}/config-export`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name ImportBucketConfig
+     * @summary Preview or apply a bucket configuration bundle
+     * @request POST:/buckets/{bucket_name}/config-import
+     * @secure
+     */
+    importBucketConfig: (
+      bucketName: string,
+      body: BucketConfigImportRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<BucketConfigImportResponse, ApiError>({
+        path: `/buckets/${encodeURIComponent(bucketName)}/config-import`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name ListIncompleteUploads
+     * @summary Lists the incomplete multipart uploads of a bucket
+     * @request GET:/buckets/{bucket_name}/incomplete-uploads
+     * @secure
+     */
+    listIncompleteUploads: (
+      bucketName: string,
+      query?: {
+        prefix?: string;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<ListIncompleteUploadsResponse, ApiError>({
+        path: `/buckets/${encodeURIComponent(bucketName)}/incomplete-uploads`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name AbortIncompleteUpload
+     * @summary Aborts an incomplete multipart upload
+     * @request DELETE:/buckets/{bucket_name}/incomplete-uploads
+     * @secure
+     */
+    abortIncompleteUpload: (
+      bucketName: string,
+      query: {
+        key: string;
+        upload_id: string;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<void, ApiError>({
+        path: `/buckets/${encodeURIComponent(bucketName)}/incomplete-uploads`,
+        method: "DELETE",
+        query: query,
+        secure: true,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name AbortIncompleteUploads
+     * @summary Aborts the incomplete multipart uploads started before a given age
+     * @request POST:/buckets/{bucket_name}/incomplete-uploads/abort
+     * @secure
+     */
+    abortIncompleteUploads: (
+      bucketName: string,
+      body: AbortIncompleteUploadsRequest,
+      params: RequestParams = {},
+    ) =>
+      this.request<AbortIncompleteUploadsResponse, ApiError>({
+        path: `/buckets/${encodeURIComponent(bucketName)}/incomplete-uploads/abort`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name GetBucketRewind
+     * @summary Get objects in a bucket for a rewind date
+     * @request GET:/buckets/{bucket_name}/rewind/{date}
+     * @secure
+     */
+    getBucketRewind: (
+      bucketName: string,
+      date: string,
+      query?: {
+        prefix?: string;
+      },
+      params: RequestParams = {},
+    ) =>
+      this.request<RewindResponse, ApiError>({
+        path: `/buckets/${encodeURIComponent(bucketName)}/rewind/${encodeURIComponent(date)}`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name GetMaxShareLinkExp
+     * @summary Get max expiration time for share link in seconds
+     * @request GET:/buckets/max-share-exp
+     * @secure
+     */
+    getMaxShareLinkExp: (params: RequestParams = {}) =>
+      this.request<MaxShareLinkExpResponse, ApiError>({
         path: `/buckets/max-share-exp`,
         method: "GET",
         secure: true,
@@ -1060,6 +2634,25 @@ export class Api<
         ...params,
       }),
   };
+  bucketTemplates = {
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name ListBucketTemplates
+     * @summary List the bucket templates configured in the server
+     * @request GET:/bucket-templates
+     * @secure
+     */
+    listBucketTemplates: (params: RequestParams = {}) =>
+      this.request<BucketTemplateList, ApiError>({
+        path: `/bucket-templates`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+  };
   admin = {
     /**
      * No description
@@ -14,7 +14,7 @@
 // You should have received a copy of the GNU Affero General Public License
 // along with this program.  If not, see <http://www.gnu.org/licenses/>.
 
-import { ApiError, BucketObject } from "api/consoleApi";
+import { ApiError, BucketObject, MirrorJob } from "api/consoleApi";
 import { IFileInfo } from "../ObjectDetails/types";
 
 export interface BucketObjectItem {
@@ -29,11 +29,25 @@ export interface BucketObjectItem {
 }
 
 export interface WebsocketRequest {
-  mode: "objects" | "rewind" | "close" | "cancel";
+  mode:
+    | "objects"
+    | "rewind"
+    | "usage"
+    | "diff"
+    | "mirror"
+    | "rewind-diff"
+    | "close"
+    | "cancel";
   bucket_name?: string;
   prefix?: string;
   date?: string;
   request_id: number;
+  depth?: number;
+  refresh?: boolean;
+  target_bucket?: string;
+  target_prefix?: string;
+  job_id?: string;
+  date_end?: string;
 }
 
 export interface WebsocketResponse {
@@ -41,8 +55,16 @@ export interface WebsocketResponse {
   error?: WebsocketErrorResponse;
   request_end?: boolean;
   data?: ObjectResponse[];
+  usage?: PrefixUsageReport;
+  diff?: ObjectDiff[];
+  diff_summary?: ObjectDiffSummary;
+  mirror_job?: MirrorJob;
+  changes?: ObjectChange[];
+  changes_summary?: ObjectChangesSummary;
   prefix?: string;
   bucketName?: string;
+  session_expiring?: string;
+  session_expired?: boolean;
 }
 
 interface WebsocketErrorResponse {
@@ -59,6 +81,76 @@ interface ObjectResponse {
   is_latest: boolean;
 }
 
+export interface PrefixUsage {
+  prefix: string;
+  size: number;
+  objects: number;
+  versions: number;
+  delete_markers: number;
+  noncurrent_bytes: number;
+}
+
+export interface UsageHistogramBucket {
+  label: string;
+  objects: number;
+  size: number;
+}
+
+export interface PrefixUsageReport {
+  bucket_name: string;
+  prefix: string;
+  depth: number;
+  total: PrefixUsage;
+  prefixes: PrefixUsage[];
+  truncated?: boolean;
+  size_histogram: UsageHistogramBucket[];
+  age_histogram: UsageHistogramBucket[];
+  complete: boolean;
+  cached?: boolean;
+  generated_at: string;
+}
+
+export interface ObjectDiff {
+  key: string;
+  diff: "only_in_source" | "only_in_target" | "different";
+  reasons?: ("size" | "etag" | "modified")[];
+  source_size?: number;
+  target_size?: number;
+  source_etag?: string;
+  target_etag?: string;
+  source_last_modified?: string;
+  target_last_modified?: string;
+}
+
+export interface ObjectDiffSummary {
+  source_objects: number;
+  target_objects: number;
+  only_in_source: number;
+  only_in_target: number;
+  different: number;
+  identical: number;
+}
+
+export interface ObjectChange {
+  name: string;
+  change: "created" | "overwritten" | "deleted";
+  old_version_id?: string;
+  new_version_id?: string;
+  old_size?: number;
+  new_size?: number;
+  old_last_modified?: string;
+  new_last_modified?: string;
+  versions: number;
+}
+
+export interface ObjectChangesSummary {
+  objects: number;
+  created: number;
+  overwritten: number;
+  deleted: number;
+  unchanged: number;
+}
+
 export interface IRestoreLocalObjectList {
   prefix: string;
   objectInfo: BucketObject;
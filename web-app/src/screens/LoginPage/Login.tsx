@@ -73,7 +73,8 @@ const Login = () => {
   let loginComponent;
 
   switch (loginStrategy.loginStrategy) {
-    case loginStrategyType.form: {
+    case loginStrategyType.form:
+    case loginStrategyType.ldap: {
       let redirectItems: RedirectRule[] = [];
 
       if (
@@ -83,7 +84,12 @@ const Login = () => {
         redirectItems = [...loginStrategy.redirectRules].sort(redirectRules);
       }
 
-      loginComponent = <StrategyForm redirectRules={redirectItems} />;
+      loginComponent = (
+        <StrategyForm
+          redirectRules={redirectItems}
+          ldap={loginStrategy.loginStrategy === loginStrategyType.ldap}
+        />
+      );
       break;
     }
     default:
@@ -0,0 +1,84 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+import React, { useEffect, useState } from "react";
+import { useNavigate } from "react-router-dom";
+import { Button, Grid } from "mds";
+import { useAppDispatch } from "../../store";
+import { userLogged } from "../../systemSlice";
+import { getTargetPath } from "./Login";
+import { api } from "api";
+import { ApiError } from "api/consoleApi";
+import LoadingComponent from "../../common/LoadingComponent";
+
+const LoginCallback = () => {
+  const dispatch = useAppDispatch();
+  const navigate = useNavigate();
+
+  const [error, setError] = useState<string>("");
+
+  useEffect(() => {
+    const queryParams = new URLSearchParams(window.location.search);
+    const code = queryParams.get("code");
+    const state = queryParams.get("state");
+    const idpError = queryParams.get("error_description");
+
+    if (idpError) {
+      setError(idpError);
+      return;
+    }
+    if (!code || !state) {
+      setError("The identity provider didn't return an authorization code");
+      return;
+    }
+    api.login
+      .loginOauth2Auth({ code, state })
+      .then(() => {
+        // the state tells the logout which provider to end the session on
+        localStorage.setItem("auth-state", state);
+        localStorage.setItem("userLoggedIn", "true");
+        dispatch(userLogged(true));
+        navigate(getTargetPath());
+      })
+      .catch(async (res) => {
+        const err = (await res.json()) as ApiError;
+        setError(err.detailedMessage || err.message || "Login failed");
+      });
+  }, [dispatch, navigate]);
+
+  if (error === "") {
+    return <LoadingComponent />;
+  }
+
+  return (
+    <Grid container sx={{ padding: 40, textAlign: "center" }}>
+      <Grid item xs={12} sx={{ marginBottom: 20 }}>
+        <h2>Login failed</h2>
+        <div>{error}</div>
+      </Grid>
+      <Grid item xs={12}>
+        <Button
+          id="back-to-login"
+          variant="callAction"
+          label={"Back to Login"}
+          onClick={() => navigate("/login")}
+        />
+      </Grid>
+    </Grid>
+  );
+};
+
+export default LoginCallback;
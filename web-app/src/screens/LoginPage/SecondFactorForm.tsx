@@ -0,0 +1,136 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+
+import React, { Fragment } from "react";
+import { Button, Grid, InputBox, LockFilledIcon, ProgressBar } from "mds";
+import { useSelector } from "react-redux";
+import { setSecondFactor, setSecondFactorCode } from "./loginSlice";
+import { AppState, useAppDispatch } from "../../store";
+import { doSecondFactorLoginAsync } from "./loginThunks";
+
+const SecondFactorForm = () => {
+  const dispatch = useAppDispatch();
+
+  const secondFactor = useSelector(
+    (state: AppState) => state.login.secondFactor,
+  );
+  const secondFactorCode = useSelector(
+    (state: AppState) => state.login.secondFactorCode,
+  );
+  const loginSending = useSelector(
+    (state: AppState) => state.login.loginSending,
+  );
+
+  const enrollment = secondFactor?.enrollment;
+
+  const formSubmit = (e: React.FormEvent<HTMLFormElement>) => {
+    e.preventDefault();
+    dispatch(doSecondFactorLoginAsync());
+  };
+
+  return (
+    <form noValidate onSubmit={formSubmit} style={{ width: "100%" }}>
+      <Fragment>
+        <Grid container>
+          {enrollment ? (
+            <Grid item xs={12} sx={{ marginBottom: 14, fontSize: 14 }}>
+              <p>
+                A second factor is required for your account. Add it to your
+                authenticator app with{" "}
+                <a href={enrollment.provisioningUri}>this link</a> or the key{" "}
+                <code>{enrollment.secret}</code>, then enter the code it shows.
+              </p>
+              <p>
+                Keep these recovery codes somewhere safe, each of them can be
+                used once instead of a code:
+              </p>
+              <code id="recovery-codes" style={{ whiteSpace: "pre-wrap" }}>
+                {(enrollment.recoveryCodes || []).join("\n")}
+              </code>
+            </Grid>
+          ) : (
+            <Grid item xs={12} sx={{ marginBottom: 14, fontSize: 14 }}>
+              Enter the code of your authenticator app or a recovery code.
+            </Grid>
+          )}
+          <Grid item xs={12}>
+            <InputBox
+              fullWidth
+              id="secondFactorCode"
+              value={secondFactorCode}
+              onChange={(e: React.ChangeEvent<HTMLInputElement>) =>
+                dispatch(setSecondFactorCode(e.target.value))
+              }
+              placeholder={"Code"}
+              name="secondFactorCode"
+              autoComplete="one-time-code"
+              disabled={loginSending}
+              startIcon={<LockFilledIcon />}
+            />
+          </Grid>
+        </Grid>
+
+        <Grid
+          item
+          xs={12}
+          sx={{
+            textAlign: "right",
+            marginTop: 30,
+          }}
+        >
+          <Button
+            type="submit"
+            variant="callAction"
+            color="primary"
+            id="do-second-factor-login"
+            disabled={secondFactorCode === "" || loginSending}
+            label={"Verify"}
+            sx={{
+              margin: "30px 0px 8px",
+              height: 40,
+              width: "100%",
+              boxShadow: "none",
+              padding: "16px 30px",
+            }}
+            fullWidth
+          />
+          <Button
+            type="button"
+            variant="regular"
+            id="cancel-second-factor-login"
+            disabled={loginSending}
+            label={"Back"}
+            onClick={() => dispatch(setSecondFactor(null))}
+            sx={{ height: 40 }}
+            fullWidth
+          />
+        </Grid>
+        <Grid
+          item
+          xs={12}
+          sx={{
+            height: 10,
+          }}
+        >
+          {loginSending && <ProgressBar />}
+        </Grid>
+      </Fragment>
+    </form>
+  );
+};
+
+export default SecondFactorForm;
@@ -29,8 +29,15 @@ import { AppState, useAppDispatch } from "../../store";
 import { useSelector } from "react-redux";
 import { doLoginAsync } from "./loginThunks";
 import { RedirectRule } from "api/consoleApi";
+import SecondFactorForm from "./SecondFactorForm";
 
-const StrategyForm = ({ redirectRules }: { redirectRules: RedirectRule[] }) => {
+const StrategyForm = ({
+  redirectRules,
+  ldap = false,
+}: {
+  redirectRules: RedirectRule[];
+  ldap?: boolean;
+}) => {
   const dispatch = useAppDispatch();
 
   const accessKey = useSelector((state: AppState) => state.login.accessKey);
@@ -39,20 +46,44 @@ const StrategyForm = ({ redirectRules }: { redirectRules: RedirectRule[] }) => {
   const loginSending = useSelector(
     (state: AppState) => state.login.loginSending,
   );
+  const secondFactor = useSelector(
+    (state: AppState) => state.login.secondFactor,
+  );
 
   const formSubmit = (e: React.FormEvent<HTMLFormElement>) => {
     e.preventDefault();
     dispatch(doLoginAsync());
   };
 
+  // the credentials were accepted, the login waits for the code of the second factor
+  if (secondFactor) {
+    return <SecondFactorForm />;
+  }
+
   return (
     <React.Fragment>
+      {redirectRules.map((rule, index) => (
+        <Button
+          key={`sso-${index}`}
+          id={`sso-login-${index}`}
+          type="button"
+          variant="regular"
+          label={rule.displayName || "Login with SSO"}
+          onClick={() => {
+            if (rule.redirect) {
+              window.location.href = rule.redirect;
+            }
+          }}
+          sx={{ marginBottom: 10, height: 40 }}
+          fullWidth
+        />
+      ))}
       <form noValidate onSubmit={formSubmit} style={{ width: "100%" }}>
         <Fragment>
           <Grid
             container
             sx={{
-              marginTop: redirectRules.length > 0 ? 55 : 0,
+              marginTop: redirectRules.length > 0 ? 30 : 0,
             }}
           >
             <Grid item xs={12} sx={{ marginBottom: 14 }}>
@@ -63,7 +94,7 @@ const StrategyForm = ({ redirectRules }: { redirectRules: RedirectRule[] }) => {
                 onChange={(e: React.ChangeEvent<HTMLInputElement>) =>
                   dispatch(setAccessKey(e.target.value))
                 }
-                placeholder={"Username"}
+                placeholder={ldap ? "LDAP Username" : "Username"}
                 name="accessKey"
                 autoComplete="username"
                 disabled={loginSending}
@@ -82,7 +113,7 @@ const StrategyForm = ({ redirectRules }: { redirectRules: RedirectRule[] }) => {
                 id="secretKey"
                 autoComplete="current-password"
                 disabled={loginSending}
-                placeholder={"Password"}
+                placeholder={ldap ? "LDAP Password" : "Password"}
                 startIcon={<LockFilledIcon />}
               />
             </Grid>
@@ -17,6 +17,7 @@
 export enum loginStrategyType {
   unknown = "unknown",
   form = "form",
+  ldap = "ldap",
   redirect = "redirect",
   serviceAccount = "service-account",
   redirectServiceAccount = "redirect-service-account",
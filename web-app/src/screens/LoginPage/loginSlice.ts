@@ -15,8 +15,17 @@
 // along with this program.  If not, see <http://www.gnu.org/licenses/>.
 
 import { createSlice, PayloadAction } from "@reduxjs/toolkit";
-import { LoginDetails } from "api/consoleApi";
-import { doLoginAsync, getFetchConfigurationAsync } from "./loginThunks";
+import { LoginDetails, SecondFactorEnrollment } from "api/consoleApi";
+import {
+  doLoginAsync,
+  doSecondFactorLoginAsync,
+  getFetchConfigurationAsync,
+} from "./loginThunks";
+
+interface SecondFactorState {
+  enrollmentRequired: boolean;
+  enrollment?: SecondFactorEnrollment;
+}
 
 interface LoginState {
   accessKey: string;
@@ -26,6 +35,8 @@ interface LoginState {
   loginSending: boolean;
   loadingFetchConfiguration: boolean;
   navigateTo: string;
+  secondFactor: SecondFactorState | null;
+  secondFactorCode: string;
 }
 
 const initialState: LoginState = {
@@ -39,6 +50,8 @@ const initialState: LoginState = {
   loadingFetchConfiguration: true,
   backgroundAnimation: false,
   navigateTo: "",
+  secondFactor: null,
+  secondFactorCode: "",
 };
 
 const loginSlice = createSlice({
@@ -54,6 +67,16 @@ const loginSlice = createSlice({
     setNavigateTo: (state, action: PayloadAction<string>) => {
       state.navigateTo = action.payload;
     },
+    setSecondFactor: (
+      state,
+      action: PayloadAction<SecondFactorState | null>,
+    ) => {
+      state.secondFactor = action.payload;
+      state.secondFactorCode = "";
+    },
+    setSecondFactorCode: (state, action: PayloadAction<string>) => {
+      state.secondFactorCode = action.payload;
+    },
     resetForm: (state) => initialState,
   },
   extraReducers: (builder) => {
@@ -79,12 +102,27 @@ const loginSlice = createSlice({
       })
       .addCase(doLoginAsync.fulfilled, (state, action) => {
         state.loginSending = false;
+      })
+      .addCase(doSecondFactorLoginAsync.pending, (state, action) => {
+        state.loginSending = true;
+      })
+      .addCase(doSecondFactorLoginAsync.rejected, (state, action) => {
+        state.loginSending = false;
+      })
+      .addCase(doSecondFactorLoginAsync.fulfilled, (state, action) => {
+        state.loginSending = false;
       });
   },
 });
 
 // Action creators are generated for each case reducer function
-export const { setAccessKey, setSecretKey, setNavigateTo, resetForm } =
-  loginSlice.actions;
+export const {
+  setAccessKey,
+  setSecretKey,
+  setNavigateTo,
+  setSecondFactor,
+  setSecondFactorCode,
+  resetForm,
+} = loginSlice.actions;
 
 export default loginSlice.reducer;
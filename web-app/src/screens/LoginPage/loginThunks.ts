@@ -15,13 +15,13 @@
 // along with this program.  If not, see <http://www.gnu.org/licenses/>.
 
 import { createAsyncThunk } from "@reduxjs/toolkit";
-import { AppState } from "../../store";
+import { AppDispatch, AppState } from "../../store";
 import {
   setDarkMode,
   setErrorSnackMessage,
   userLogged,
 } from "../../systemSlice";
-import { setNavigateTo } from "./loginSlice";
+import { setNavigateTo, setSecondFactor } from "./loginSlice";
 import { getTargetPath } from "./Login";
 import { api } from "api";
 import { ApiError, LoginRequest } from "api/consoleApi";
@@ -42,14 +42,37 @@ export const doLoginAsync = createAsyncThunk(
 
     return api.login
       .login(payload)
-      .then((res) => {
-        const darkModeEnabled = isDarkModeOn(); // If null, then we set the dark mode as disabled per requirement. If configuration al ready set, then we establish this configuration
+      .then(async (res) => {
+        // the credentials are valid, the session is issued once the code of the second factor is entered
+        if (res.status === 202) {
+          const enrollmentRequired = !!res.data?.enrollmentRequired;
+          let enrollment;
+          if (enrollmentRequired) {
+            enrollment = (await api.login.loginSecondFactorEnroll()).data;
+          }
+          dispatch(setSecondFactor({ enrollmentRequired, enrollment }));
+          return;
+        }
+        loggedIn(dispatch, accessKey);
+      })
+      .catch(async (res) => {
+        const err = (await res.json()) as ApiError;
+        dispatch(setErrorSnackMessage(errorToHandler(err)));
+        return rejectWithValue(false);
+      });
+  },
+);
+
+export const doSecondFactorLoginAsync = createAsyncThunk(
+  "login/doSecondFactorLoginAsync",
+  async (_, { getState, rejectWithValue, dispatch }) => {
+    const state = getState() as AppState;
 
-        // We set the state in redux
-        dispatch(userLogged(true));
-        localStorage.setItem("userLoggedIn", accessKey);
-        dispatch(setNavigateTo(getTargetPath()));
-        dispatch(setDarkMode(!!darkModeEnabled));
+    return api.login
+      .loginSecondFactor({ code: state.login.secondFactorCode })
+      .then(() => {
+        dispatch(setSecondFactor(null));
+        loggedIn(dispatch, state.login.accessKey);
       })
       .catch(async (res) => {
         const err = (await res.json()) as ApiError;
@@ -58,6 +81,16 @@ export const doLoginAsync = createAsyncThunk(
       });
   },
 );
+
+const loggedIn = (dispatch: AppDispatch, accessKey: string) => {
+  const darkModeEnabled = isDarkModeOn(); // If null, then we set the dark mode as disabled per requirement. If configuration al ready set, then we establish this configuration
+
+  // We set the state in redux
+  dispatch(userLogged(true));
+  localStorage.setItem("userLoggedIn", accessKey);
+  dispatch(setNavigateTo(getTargetPath()));
+  dispatch(setDarkMode(!!darkModeEnabled));
+};
 export const getFetchConfigurationAsync = createAsyncThunk(
   "login/getFetchConfigurationAsync",
   async (_, { dispatch, rejectWithValue }) => {
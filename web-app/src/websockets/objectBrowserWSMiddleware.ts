@@ -35,7 +35,7 @@ import {
   WebsocketResponse,
 } from "../screens/Console/Buckets/ListBuckets/Objects/ListObjects/types";
 import { permissionItems } from "../screens/Console/Buckets/ListBuckets/Objects/utils";
-import { setErrorSnackMessage } from "../systemSlice";
+import { setErrorSnackMessage, setSnackBarMessage } from "../systemSlice";
 
 let wsInFlight: boolean = false;
 let currentRequestID: number = 0;
@@ -93,6 +93,21 @@ export const objectBrowserWSMiddleware = (
           const response: WebsocketResponse = JSON.parse(
             message.data.toString(),
           );
+
+          // Idle sessions are warned before they expire, once they do we reload to go back to the login
+          if (response.session_expired) {
+            window.location.reload();
+            return;
+          }
+          if (response.session_expiring) {
+            const expiresAt = new Date(response.session_expiring);
+            dispatch(
+              setSnackBarMessage(
+                `Your session expires at ${expiresAt.toLocaleTimeString()} unless you keep using the console`,
+              ),
+            );
+            return;
+          }
           if (currentRequestID === response.request_id) {
             // If response is not from current request, we can omit
             if (response.request_id !== currentRequestID) {